@@ -0,0 +1,14 @@
+package main
+
+import "github.com/spf13/cobra"
+
+func newChecksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checks",
+		Short: "Describes the checks osm-health runs",
+		Long:  `Describes the checks osm-health runs`,
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(newChecksListCmd())
+	return cmd
+}
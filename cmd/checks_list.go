@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openservicemesh/osm-health/pkg/printer"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+const checksListDesc = `
+Lists the checks osm-health runs, with their IDs, tags and what they verify.
+The IDs and tags select the checks any command runs with --checks, --skip-checks
+and --tags, which also filter this list.
+`
+
+const checksListExample = `$ osm-health checks list
+$ osm-health checks list --tags envoy,certs`
+
+func newChecksListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the checks osm-health runs",
+		Example: checksListExample,
+		Long:    checksListDesc,
+		Args:    cobra.NoArgs,
+		Run: func(_ *cobra.Command, _ []string) {
+			printer.PrintChecks(runner.Selected()...)
+		},
+	}
+	return cmd
+}
@@ -10,33 +10,48 @@ import (
 )
 
 const connectivityPodToPodDesc = `
-Checks connectivity between two Kubernetes pods
+Checks connectivity between two Kubernetes pods.
+
+With --all-replicas, the source Envoy's endpoints, the inbound listener and the certificates are also
+checked for every ready replica behind the destination's services, and printed as a table per replica.
 `
 
 const connectivityPodToPodExample = `$ osm-health connectivity pod-to-pod source-namespace/source-pod destination-namespace/destination-pod`
 
 func newConnectivityPodToPodCmd() *cobra.Command {
-	return &cobra.Command{
+	var allReplicas bool
+
+	cmd := &cobra.Command{
 		Use:     "pod-to-pod source-namespace/source-pod destination-namespace/destination-pod",
 		Short:   "Checks connectivity between Kubernetes pods",
 		Example: connectivityPodToPodExample,
 		Long:    connectivityPodToPodDesc,
 		Args:    cli.ExactArgsWithError(2, errors.New("requires 2 arguments: source-namespace/source-pod destination-namespace/destination-pod")),
-		RunE: func(_ *cobra.Command, args []string) error {
-			srcPod, err := pod.FromString(args[0])
+		RunE: func(cmd *cobra.Command, args []string) error {
+			srcPod, err := pod.FromString(cmd.Context(), args[0])
 			if err != nil {
 				return errors.New("invalid source-namespace/source-pod")
 			}
 
-			dstPod, err := pod.FromString(args[1])
+			dstPod, err := pod.FromString(cmd.Context(), args[1])
 			if err != nil {
 				return errors.New("invalid destination-namespace/destination-pod")
 			}
 
+			logOptions, err := settings.LogOptions()
+			if err != nil {
+				return err
+			}
+
 			osmControlPlaneNamespace := settings.Namespace()
 
-			connectivity.PodToPod(srcPod, dstPod, osmControlPlaneNamespace)
+			connectivity.PodToPod(cmd.Context(), srcPod, dstPod, osmControlPlaneNamespace, logOptions, settings.MeshConfigTimeout(), allReplicas)
 			return nil
 		},
 	}
+
+	f := cmd.Flags()
+	f.BoolVar(&allReplicas, "all-replicas", false, "also check the source EDS, inbound listener and certificates of every ready replica behind the destination's services")
+
+	return cmd
 }
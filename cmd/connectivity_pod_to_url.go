@@ -24,8 +24,8 @@ func newConnectivityPodToURLCmd() *cobra.Command {
 		Example: connectivityPodToURLExample,
 		Long:    connectivityPodToURLDesc,
 		Args:    cli.ExactArgsWithError(2, errors.New("requires 2 arguments: source-namespace/source-pod destination-url")),
-		RunE: func(_ *cobra.Command, args []string) error {
-			srcPod, err := pod.FromString(args[0])
+		RunE: func(cmd *cobra.Command, args []string) error {
+			srcPod, err := pod.FromString(cmd.Context(), args[0])
 			if err != nil {
 				return errors.New("invalid source-namespace/source-pod")
 			}
@@ -35,7 +35,7 @@ func newConnectivityPodToURLCmd() *cobra.Command {
 				return errors.New("invalid destination-url")
 			}
 
-			connectivity.PodToURL(srcPod, dstURL, settings.Namespace())
+			connectivity.PodToURL(cmd.Context(), srcPod, dstURL, settings.Namespace())
 			return nil
 		},
 	}
@@ -18,9 +18,13 @@ func newControlPlaneStatusCmd(actionConfig *action.Configuration) *cobra.Command
 		Example: controlPlaneStatusExample,
 		Long:    `Checks the status of the osm control plane`,
 		Args:    cobra.NoArgs,
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logOptions, err := settings.LogOptions()
+			if err != nil {
+				return err
+			}
 			osmControlPlaneNamespace := settings.Namespace()
-			return osm.ControlPlaneStatus(osmControlPlaneNamespace, localPort, actionConfig)
+			return osm.ControlPlaneStatus(cmd.Context(), osmControlPlaneNamespace, localPort, actionConfig, logOptions)
 		},
 	}
 
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/openservicemesh/osm-health/pkg/cli"
+	"github.com/openservicemesh/osm-health/pkg/printer"
+)
+
+const diffDesc = `
+Compares the outcomes of the checks of two runs written with --output json, such as before and after
+an upgrade of the mesh, and prints the checks that regressed, improved, changed, or only ran once.
+When a file holds several runs of a command, the latest one is compared.
+`
+
+const diffExample = `$ osm-health control-plane status --output json --output-file before.json
+$ osm-health control-plane status --output json --output-file after.json
+$ osm-health diff before.json after.json`
+
+func newDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "diff result1.json result2.json",
+		Short:   "Compares the outcomes of the checks of two runs",
+		Example: diffExample,
+		Long:    diffDesc,
+		Args:    cli.ExactArgsWithError(2, errors.New("requires 2 arguments: result1.json result2.json")),
+		RunE: func(_ *cobra.Command, args []string) error {
+			before, err := printer.LoadResults(args[0])
+			if err != nil {
+				return err
+			}
+
+			after, err := printer.LoadResults(args[1])
+			if err != nil {
+				return err
+			}
+
+			printer.PrintResultsDiff(printer.DiffResults(before, after))
+			return nil
+		},
+	}
+
+	return cmd
+}
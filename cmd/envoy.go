@@ -0,0 +1,21 @@
+package main
+
+import "github.com/spf13/cobra"
+
+const envoyDesc = `
+Inspects the Envoy configuration of pods in the mesh
+`
+
+func newEnvoyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "envoy",
+		Short: "Inspects the Envoy configuration of pods in the mesh",
+		Long:  envoyDesc,
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(
+		newEnvoyTraceCmd(),
+		newEnvoyDiffCmd(),
+	)
+	return cmd
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/openservicemesh/osm-health/pkg/cli"
+	"github.com/openservicemesh/osm-health/pkg/envoy"
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
+)
+
+const envoyDiffDesc = `
+Compares the Envoy configuration of two Kubernetes pods, such as two replicas of which only one works.
+The listeners, filter chains, routes, clusters, endpoints and secrets of each pod's Envoy are normalized
+and the entries that one pod has and the other lacks are printed.
+`
+
+const envoyDiffExample = `$ osm-health envoy diff bookstore/bookstore-v1-5f7d8b9c4-abc34 bookstore/bookstore-v1-5f7d8b9c4-def56`
+
+func newEnvoyDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "diff namespace-a/pod-a namespace-b/pod-b",
+		Short:   "Compares the Envoy configuration of two Kubernetes pods",
+		Example: envoyDiffExample,
+		Long:    envoyDiffDesc,
+		Args:    cli.ExactArgsWithError(2, errors.New("requires 2 arguments: namespace-a/pod-a namespace-b/pod-b")),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			podA, err := pod.FromString(cmd.Context(), args[0])
+			if err != nil {
+				return errors.New("invalid namespace-a/pod-a")
+			}
+
+			podB, err := pod.FromString(cmd.Context(), args[1])
+			if err != nil {
+				return errors.New("invalid namespace-b/pod-b")
+			}
+
+			envoy.DiffPods(cmd.Context(), podA, podB, settings.Namespace())
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/cli"
+	"github.com/openservicemesh/osm-health/pkg/envoy"
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
+)
+
+const envoyTraceDesc = `
+Traces the path a request from a Kubernetes pod would take through the pod's Envoy configuration:
+the outbound listener filter chain matched by destination IP and port, the route matched by host,
+path, method and headers, the selected clusters, their endpoints and their upstream TLS SNI.
+`
+
+const envoyTraceExample = `$ osm-health envoy trace bookbuyer/bookbuyer-7b9f8d6c5-xyz12 GET http://bookstore.bookstore:14001/books-bought -H client-app=bookbuyer --destination-pod bookstore/bookstore-v1-5f7d8b9c4-abc34`
+
+func newEnvoyTraceCmd() *cobra.Command {
+	var dstPodName string
+	var headers []string
+
+	cmd := &cobra.Command{
+		Use:     "trace source-namespace/source-pod method url",
+		Short:   "Traces a request through the Envoy configuration of a Kubernetes pod",
+		Example: envoyTraceExample,
+		Long:    envoyTraceDesc,
+		Args:    cli.ExactArgsWithError(3, errors.New("requires 3 arguments: source-namespace/source-pod method url")),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			srcPod, err := pod.FromString(cmd.Context(), args[0])
+			if err != nil {
+				return errors.New("invalid source-namespace/source-pod")
+			}
+
+			dstURL, err := url.Parse(args[2])
+			if err != nil || dstURL.Host == "" {
+				return errors.New("invalid url")
+			}
+
+			requestHeaders := make(map[string]string)
+			for _, header := range headers {
+				headerChunks := strings.SplitN(header, "=", 2)
+				if len(headerChunks) != 2 {
+					return errors.Errorf("invalid header %s; expected the format key=value", header)
+				}
+				requestHeaders[headerChunks[0]] = headerChunks[1]
+			}
+
+			var dstPod *corev1.Pod
+			if dstPodName != "" {
+				dstPod, err = pod.FromString(cmd.Context(), dstPodName)
+				if err != nil {
+					return errors.New("invalid destination-namespace/destination-pod")
+				}
+			}
+
+			envoy.TracePodRequest(cmd.Context(), srcPod, dstPod, args[1], dstURL, requestHeaders, settings.Namespace())
+			return nil
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&dstPodName, "destination-pod", "", "destination-namespace/destination-pod expected to receive the request")
+	f.StringArrayVarP(&headers, "header", "H", nil, "request header in the format key=value (can be repeated)")
+
+	return cmd
+}
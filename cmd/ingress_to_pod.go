@@ -5,6 +5,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openservicemesh/osm-health/pkg/cli"
+	"github.com/openservicemesh/osm-health/pkg/cluster"
 	"github.com/openservicemesh/osm-health/pkg/ingress"
 	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
 )
@@ -18,7 +19,7 @@ func newIngressToPodCmd() *cobra.Command {
 		Example: ingressToPodExample,
 		Long:    `Checks ingress to a given Kubernetes pod`,
 		Args:    cli.ExactArgsWithError(1, errors.New("requires 1 argument: destination-namespace/destination-pod")),
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			log.Info().Msgf("Checking Ingress to Pod %s", args[0])
 
 			client, err := pod.GetKubeClient()
@@ -26,14 +27,14 @@ func newIngressToPodCmd() *cobra.Command {
 				return err
 			}
 
-			dstPod, err := pod.FromString(args[0])
+			dstPod, err := pod.FromString(cmd.Context(), args[0])
 			if err != nil {
 				return errors.New("invalid destination-namespace/destination-pod")
 			}
 
 			osmControlPlaneNamespace := settings.Namespace()
 
-			ingress.ToDestinationPod(client, dstPod, osmControlPlaneNamespace)
+			ingress.ToDestinationPod(cmd.Context(), cluster.NewSnapshot(client, nil, nil, nil).KubeClient(), dstPod, osmControlPlaneNamespace)
 
 			return nil
 		},
@@ -1,14 +1,25 @@
 package main
 
 import (
+	"context"
 	goflag "flag"
 	"os"
+	"os/signal"
+	"syscall"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"helm.sh/helm/v3/pkg/action"
 
+	"github.com/openservicemesh/osm-health/pkg/checks"
 	"github.com/openservicemesh/osm-health/pkg/cli"
+	"github.com/openservicemesh/osm-health/pkg/cluster"
+	"github.com/openservicemesh/osm-health/pkg/diagnosis"
 	"github.com/openservicemesh/osm-health/pkg/logger"
+	osmversion "github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/plugins"
+	"github.com/openservicemesh/osm-health/pkg/printer"
+	"github.com/openservicemesh/osm-health/pkg/runner"
 	"github.com/openservicemesh/osm-health/pkg/version"
 )
 
@@ -27,6 +38,63 @@ func newRootCmd(actionConfig *action.Configuration, args []string) *cobra.Comman
 		Short:        "Check Open Service Mesh health status and debug issues",
 		Long:         globalUsage,
 		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			if capabilitiesFile := settings.VersionCapabilitiesFile(); capabilitiesFile != "" {
+				if err := osmversion.LoadCapabilitiesFile(capabilitiesFile); err != nil {
+					return err
+				}
+			}
+			for _, rulesFile := range settings.DiagnosisRulesFiles() {
+				if err := diagnosis.LoadRulesFile(rulesFile); err != nil {
+					return err
+				}
+			}
+			runner.SetCheckTimeout(settings.CheckTimeout())
+			runner.Register(checks.Catalog()...)
+			if err := plugins.Load(cmd.Context(), settings.Plugins()...); err != nil {
+				return err
+			}
+			if err := runner.SetFilter(settings.CheckFilter()); err != nil {
+				return err
+			}
+			if settings.Report() != "" {
+				if _, err := printer.ParseReportFormat(settings.Report()); err != nil {
+					return err
+				}
+			}
+			if settings.Output() != "" {
+				if _, err := printer.ParseOutputFormat(settings.Output()); err != nil {
+					return err
+				}
+			}
+			if settings.RecordSnapshot() != "" && settings.FromSnapshot() != "" {
+				return errors.New("--record-snapshot and --from-snapshot cannot be used together")
+			}
+			if snapshotPath := settings.FromSnapshot(); snapshotPath != "" {
+				replay, err := cluster.LoadReplay(snapshotPath)
+				if err != nil {
+					return err
+				}
+				cluster.SetReplay(replay)
+			}
+			if settings.RecordSnapshot() != "" {
+				cluster.SetRecorder(cluster.NewRecorder())
+			}
+			return nil
+		},
+		PersistentPostRunE: func(_ *cobra.Command, _ []string) error {
+			if settings.Report() != "" {
+				if err := saveReport(); err != nil {
+					return err
+				}
+			}
+			if settings.Output() != "" {
+				if err := saveOutput(); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
 	}
 
 	cmd.PersistentFlags().AddGoFlagSet(goflag.CommandLine)
@@ -39,6 +107,11 @@ func newRootCmd(actionConfig *action.Configuration, args []string) *cobra.Comman
 		newControlPlaneCmd(actionConfig),
 		newValidateCmd(),
 		newIngressCmd(),
+		newEnvoyCmd(),
+		newMeshCmd(),
+		newMeshConfigCmd(),
+		newChecksCmd(),
+		newDiffCmd(),
 	)
 
 	_ = flags.Parse(args)
@@ -46,6 +119,60 @@ func newRootCmd(actionConfig *action.Configuration, args []string) *cobra.Comman
 	return cmd
 }
 
+// saveSnapshot writes the cluster snapshot recorded with --record-snapshot, if any.
+func saveSnapshot() error {
+	recorder := cluster.ActiveRecorder()
+	if recorder == nil {
+		return nil
+	}
+	if err := recorder.Save(settings.RecordSnapshot()); err != nil {
+		log.Error().Err(err).Msgf("Error recording the cluster snapshot to %s", settings.RecordSnapshot())
+		return err
+	}
+	log.Info().Msgf("Recorded the cluster snapshot to %s", settings.RecordSnapshot())
+	return nil
+}
+
+// saveReport writes the report of the command that ran in the format given with --report.
+func saveReport() error {
+	format, err := printer.ParseReportFormat(settings.Report())
+	if err != nil {
+		return err
+	}
+	path := settings.ReportFile()
+	if path == "" {
+		path = printer.DefaultReportPath(format)
+	}
+	saved, err := printer.SaveReport(format, path)
+	if err != nil {
+		return err
+	}
+	if saved {
+		log.Info().Msgf("Wrote the %s report to %s", format, path)
+	}
+	return nil
+}
+
+// saveOutput writes the outcomes of the command that ran in the format given with --output.
+func saveOutput() error {
+	format, err := printer.ParseOutputFormat(settings.Output())
+	if err != nil {
+		return err
+	}
+	path := settings.OutputFile()
+	if path == "" {
+		path = printer.DefaultOutputPath(format)
+	}
+	saved, err := printer.SaveOutput(format, path)
+	if err != nil {
+		return err
+	}
+	if saved {
+		log.Info().Msgf("Wrote the %s outcomes to %s", format, path)
+	}
+	return nil
+}
+
 func initCommands() *cobra.Command {
 	actionConfig := new(action.Configuration)
 	cmd := newRootCmd(actionConfig, os.Args[1:])
@@ -64,10 +191,37 @@ func initCommands() *cobra.Command {
 func main() {
 	log.Info().Msgf("osm-health version: %s; %s; %s", version.Version, version.GitCommit, version.BuildDate)
 	cmd := initCommands()
-	if err := cmd.Execute(); err != nil {
+	if err := execute(cmd); err != nil {
 		os.Exit(1)
 	}
 }
 
+// execute runs the command with a context that is cancelled by Ctrl-C or once --timeout elapses, so that the checks
+// stop and the outcomes of the checks that completed are printed. The recorded cluster snapshot is written even when the
+// command fails, since that is when it is most useful.
+func execute(cmd *cobra.Command) (err error) {
+	defer func() {
+		if saveErr := saveSnapshot(); err == nil {
+			err = saveErr
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		// Restore the default behavior, so that a second Ctrl-C exits immediately.
+		stop()
+	}()
+
+	if timeout := settings.Timeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	return cmd.ExecuteContext(ctx)
+}
+
 func debug(format string, v ...interface{}) {
 }
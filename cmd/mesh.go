@@ -0,0 +1,14 @@
+package main
+
+import "github.com/spf13/cobra"
+
+func newMeshCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mesh",
+		Short: "Inspects the OSM meshes in the cluster",
+		Long:  `Inspects the OSM meshes in the cluster`,
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(newMeshListCmd())
+	return cmd
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openservicemesh/osm-health/pkg/osm"
+)
+
+const meshListDesc = `
+Lists every OSM control plane in the cluster, found by the osm-controller deployments
+across namespaces, with its mesh name, namespace, version and monitored namespaces.
+`
+
+const meshListExample = `$ osm-health mesh list`
+
+func newMeshListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the OSM control planes in the cluster",
+		Example: meshListExample,
+		Long:    meshListDesc,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return osm.ListMeshes(cmd.Context())
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openservicemesh/osm-health/pkg/osm/meshconfig"
+)
+
+const meshConfigDesc = `
+Validates the settings of the OSM MeshConfig: the Envoy and control plane log levels, the compatibility of the
+Envoy image with the OSM version, the certificate validity durations and the proxy config resync interval, and
+whether the tracing configuration points at a resolvable service. It also describes how permissive traffic policy
+mode, egress and feature flags affect traffic policies, and warns on risky combinations such as permissive mode
+with TrafficTargets present.
+`
+
+const meshConfigExample = `$ osm-health meshconfig --osm-namespace osm-system`
+
+func newMeshConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "meshconfig",
+		Short:   "Validates the settings of the OSM MeshConfig",
+		Example: meshConfigExample,
+		Long:    meshConfigDesc,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return meshconfig.Validate(cmd.Context(), settings.Namespace(), settings.MeshConfigTimeout())
+		},
+	}
+	return cmd
+}
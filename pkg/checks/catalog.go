@@ -0,0 +1,394 @@
+// Package checks holds the catalog of the types of checks osm-health runs, with their stable IDs, tags and docs.
+package checks
+
+import (
+	"github.com/openservicemesh/osm-health/pkg/envoy"
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/namespace"
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/podhelper"
+	"github.com/openservicemesh/osm-health/pkg/osm"
+	"github.com/openservicemesh/osm-health/pkg/osm/controller"
+	"github.com/openservicemesh/osm-health/pkg/osm/meshconfig"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm-health/pkg/smi/access"
+	"github.com/openservicemesh/osm-health/pkg/smi/split"
+)
+
+// Tags of the checks in the catalog.
+const (
+	// TagK8s is the tag of checks of Kubernetes resources such as namespaces, pods, services and endpoints.
+	TagK8s = "k8s"
+
+	// TagEnvoy is the tag of checks of the config and logs of Envoy sidecars.
+	TagEnvoy = "envoy"
+
+	// TagSMI is the tag of checks of SMI policies.
+	TagSMI = "smi"
+
+	// TagCerts is the tag of checks of certificates.
+	TagCerts = "certs"
+
+	// TagControlPlane is the tag of checks of the OSM control plane.
+	TagControlPlane = "control-plane"
+
+	// TagMeshConfig is the tag of checks of the MeshConfig.
+	TagMeshConfig = "meshconfig"
+
+	// TagHelm is the tag of checks of the osm Helm release.
+	TagHelm = "helm"
+
+	// TagLogs is the tag of checks analyzing container logs.
+	TagLogs = "logs"
+
+	// TagSlow is the tag of checks that port-forward to pods or read container logs.
+	TagSlow = "slow"
+)
+
+// Catalog returns every type of check osm-health runs.
+func Catalog() []runner.CheckInfo {
+	return []runner.CheckInfo{
+		// OSM version
+		{
+			ID:    "osm.version",
+			Tags:  []string{TagControlPlane},
+			Doc:   "osm-health knows the capabilities of the installed OSM version",
+			Check: version.ControllerVersionCheck{},
+		},
+
+		// Namespaces
+		{
+			ID:    "namespace.same-mesh",
+			Tags:  []string{TagK8s},
+			Doc:   "The namespaces of the source and destination pods are monitored by the same mesh",
+			Check: namespace.NamespacesInSameMeshCheck{},
+		},
+		{
+			ID:    "namespace.sidecar-injection",
+			Tags:  []string{TagK8s},
+			Doc:   "The namespace is enabled for sidecar injection",
+			Check: namespace.SidecarInjectionCheck{},
+		},
+		{
+			ID:    "namespace.monitored",
+			Tags:  []string{TagK8s},
+			Doc:   "The namespace is monitored by the OSM controller of the mesh",
+			Check: namespace.MonitoredCheck{},
+		},
+		{
+			ID:    "namespace.annotations",
+			Tags:  []string{TagK8s},
+			Doc:   "The OSM annotations of the namespace are supported by the OSM version and have valid values",
+			Check: namespace.AnnotationsCheck{},
+		},
+
+		// Pods
+		{
+			ID:    "pod.annotations",
+			Tags:  []string{TagK8s},
+			Doc:   "The OSM annotations of the pod are supported by the OSM version and have valid values",
+			Check: podhelper.AnnotationsCheck{},
+		},
+		{
+			ID:    "pod.containers",
+			Tags:  []string{TagK8s},
+			Doc:   "The pod has at least the application and Envoy sidecar containers",
+			Check: podhelper.MinNumContainersCheck{},
+		},
+		{
+			ID:    "pod.ready",
+			Tags:  []string{TagK8s},
+			Doc:   "The pod is running and has a true Ready condition",
+			Check: podhelper.PodReadyCheck{},
+		},
+		{
+			ID:    "pod.restarts",
+			Tags:  []string{TagK8s},
+			Doc:   "The containers of the pod, including the Envoy sidecar, are not crash looping, OOMKilled or restarting",
+			Check: podhelper.ContainerRestartsCheck{},
+		},
+		{
+			ID:    "pod.osm-init-exit-code",
+			Tags:  []string{TagK8s},
+			Doc:   "The osm-init init container of the pod exited with exit code 0",
+			Check: podhelper.OsmInitExitCodeCheck{},
+		},
+		{
+			ID:    "pod.osm-init-image",
+			Tags:  []string{TagK8s},
+			Doc:   "The pod has an osm-init init container with the image configured in the MeshConfig",
+			Check: podhelper.OsmInitContainerImageCheck{},
+		},
+		{
+			ID:    "pod.envoy-image",
+			Tags:  []string{TagK8s, TagEnvoy},
+			Doc:   "The pod has an Envoy sidecar container with the image configured in the MeshConfig",
+			Check: podhelper.EnvoySidecarImageCheck{},
+		},
+		{
+			ID:    "pod.proxy-uuid",
+			Tags:  []string{TagK8s},
+			Doc:   "The pod has a valid proxy UUID label",
+			Check: podhelper.ProxyUUIDLabelCheck{},
+		},
+		{
+			ID:    "pod.probes",
+			Tags:  []string{TagK8s},
+			Doc:   "The HTTP probes of the pod go through the Envoy sidecar, for OSM versions that rewrite them",
+			Check: podhelper.ProbesRewrittenCheck{},
+		},
+		{
+			ID:    "pod.endpoints",
+			Tags:  []string{TagK8s},
+			Doc:   "The pod is referenced by the Endpoints of a service",
+			Check: podhelper.EndpointsCheck{},
+		},
+		{
+			ID:    "pod.events",
+			Tags:  []string{TagK8s},
+			Doc:   "The pod has no warning events",
+			Check: podhelper.PodEventsCheck{},
+		},
+		{
+			ID:    "pod.service",
+			Tags:  []string{TagK8s},
+			Doc:   "The destination pod backs at least one service",
+			Check: podhelper.ServiceCheck{},
+		},
+		{
+			ID:    "pod.port-exclusion",
+			Tags:  []string{TagK8s},
+			Doc:   "Port exclusion annotations do not exclude the destination service ports from the Envoy sidecars",
+			Check: podhelper.PortExclusionCheck{},
+		},
+		{
+			ID:    "pod.outbound-interception",
+			Tags:  []string{TagK8s},
+			Doc:   "Outbound traffic of the source pod to the destination services is redirected to its Envoy sidecar",
+			Check: podhelper.OutboundInterceptionCheck{},
+		},
+		{
+			ID:    "pod.osm-init-logs",
+			Tags:  []string{TagK8s, TagLogs, TagSlow},
+			Doc:   "The logs of the osm-init container of the pod have no errors",
+			Check: podhelper.NoBadOsmInitLogsCheck{},
+		},
+
+		// Envoy
+		{
+			ID:    "envoy.logs",
+			Tags:  []string{TagEnvoy, TagLogs, TagSlow},
+			Doc:   "The logs of the Envoy sidecar of the pod have no known error signatures",
+			Check: envoy.BadLogsCheck{},
+		},
+		{
+			ID:    "envoy.endpoints",
+			Tags:  []string{TagEnvoy, TagSlow},
+			Doc:   "The Envoy of the source pod has endpoints, including one for the destination pod",
+			Check: envoy.DestinationEndpointCheck{},
+		},
+		{
+			ID:    "envoy.route-domain",
+			Tags:  []string{TagEnvoy, TagSlow},
+			Doc:   "The Envoy has a dynamic route config domain for the destination service or host",
+			Check: envoy.RouteDomainCheck{},
+		},
+		{
+			ID:    "envoy.listener",
+			Tags:  []string{TagEnvoy, TagSlow},
+			Doc:   "The Envoy of the source pod has an outbound listener and the Envoy of the destination pod an inbound listener",
+			Check: envoy.ListenerCheck{},
+		},
+		{
+			ID:    "envoy.probe-listeners",
+			Tags:  []string{TagEnvoy, TagSlow},
+			Doc:   "The Envoy has a listener on the port of each probe rewritten to go through it",
+			Check: envoy.ProbeListenersCheck{},
+		},
+		{
+			ID:    "envoy.cluster",
+			Tags:  []string{TagEnvoy, TagSlow},
+			Doc:   "The Envoy of the source pod has a cluster for a service of the destination pod",
+			Check: envoy.ClusterCheck{},
+		},
+		{
+			ID:    "envoy.certificate",
+			Tags:  []string{TagEnvoy, TagCerts, TagSlow},
+			Doc:   "The Envoy has the root and service certificates the destination pod needs",
+			Check: envoy.HasValidEnvoyCertificateCheck{},
+		},
+		{
+			ID:    "envoy.certificate-age",
+			Tags:  []string{TagEnvoy, TagCerts, TagSlow},
+			Doc:   "The Envoy has renewed its service certificate within the service certificate validity duration",
+			Check: envoy.ServiceCertificateAgeCheck{},
+		},
+		{
+			ID:    "envoy.dynamic-warming",
+			Tags:  []string{TagEnvoy, TagCerts, TagSlow},
+			Doc:   "The Envoy has no secrets stuck in dynamic warming",
+			Check: envoy.DynamicWarmingCheck{},
+		},
+		{
+			ID:    "envoy.filter-chain",
+			Tags:  []string{TagEnvoy, TagSMI, TagSlow},
+			Doc:   "The Envoys of the source and destination pods have filter chains for the destination service",
+			Check: envoy.ListenerFilterCheck{},
+		},
+		{
+			ID:    "envoy.http-route-match",
+			Tags:  []string{TagEnvoy, TagSMI, TagSlow},
+			Doc:   "The HTTPRouteGroup matches referenced by TrafficTargets are programmed as Envoy routes",
+			Check: envoy.HTTPRouteMatchCheck{},
+		},
+		{
+			ID:    "envoy.weighted-clusters",
+			Tags:  []string{TagEnvoy, TagSMI, TagSlow},
+			Doc:   "The Envoy of the source pod splits traffic with the weights of the TrafficSplits of the destination services",
+			Check: envoy.TrafficSplitWeightedClustersCheck{},
+		},
+
+		// SMI
+		{
+			ID:    "smi.trafficsplit",
+			Tags:  []string{TagSMI},
+			Doc:   "Reports the TrafficSplits the destination pod participates in",
+			Check: split.TrafficSplitCheck{},
+		},
+		{
+			ID:    "smi.trafficsplit.root-service",
+			Tags:  []string{TagSMI, TagK8s},
+			Doc:   "The root service of every TrafficSplit exists and does not select the pods of its backends",
+			Check: split.TrafficSplitRootServiceCheck{},
+		},
+		{
+			ID:    "smi.trafficsplit.backends",
+			Tags:  []string{TagSMI, TagK8s},
+			Doc:   "Every backend service of the TrafficSplits exists and has ready endpoints",
+			Check: split.TrafficSplitBackendsCheck{},
+		},
+		{
+			ID:    "smi.trafficsplit.weights",
+			Tags:  []string{TagSMI},
+			Doc:   "The backend weights of every TrafficSplit are valid",
+			Check: split.TrafficSplitWeightsCheck{},
+		},
+		{
+			ID:    "smi.trafficsplit.matches",
+			Tags:  []string{TagSMI},
+			Doc:   "The matches of every TrafficSplit reference existing HTTPRouteGroups",
+			Check: split.TrafficSplitMatchesCheck{},
+		},
+		{
+			ID:    "smi.traffictarget",
+			Tags:  []string{TagSMI},
+			Doc:   "A TrafficTarget allows the source pod to communicate with the destination pod",
+			Check: access.TrafficTargetCheck{},
+		},
+		{
+			ID:    "smi.routes.validity",
+			Tags:  []string{TagSMI},
+			Doc:   "The TrafficTargets between the pods reference route kinds supported by the OSM version",
+			Check: access.RoutesValidityCheck{},
+		},
+		{
+			ID:    "smi.routes.existence",
+			Tags:  []string{TagSMI},
+			Doc:   "The routes referenced by the TrafficTargets between the pods exist",
+			Check: access.RoutesExistenceCheck{},
+		},
+
+		// OSM control plane
+		{
+			ID:    "control-plane.deployment",
+			Tags:  []string{TagControlPlane, TagK8s},
+			Doc:   "The OSM control plane deployments exist and are ready",
+			Check: osm.DeploymentReadinessCheck{},
+		},
+		{
+			ID:    "control-plane.webhook",
+			Tags:  []string{TagControlPlane, TagCerts},
+			Doc:   "The sidecar injector and resource validator webhooks have valid CA bundles and select the monitored namespaces",
+			Check: osm.WebhookConfigurationCheck{},
+		},
+		{
+			ID:    "control-plane.crds",
+			Tags:  []string{TagControlPlane, TagK8s},
+			Doc:   "The CRDs are installed at the versions the OSM version expects",
+			Check: osm.CRDVersionsCheck{},
+		},
+		{
+			ID:    "control-plane.logs",
+			Tags:  []string{TagControlPlane, TagLogs, TagSlow},
+			Doc:   "The logs of the osm-controller and osm-injector pods have no errors",
+			Check: osm.NoBadOsmPodLogsCheck{},
+		},
+		{
+			ID:    "control-plane.health",
+			Tags:  []string{TagControlPlane, TagSlow},
+			Doc:   "The health endpoints of the osm-controller HTTP server report healthy",
+			Check: controller.HTTPServerHealthEndpointsCheck{},
+		},
+		{
+			ID:    "control-plane.proxy-connections",
+			Tags:  []string{TagControlPlane, TagSlow},
+			Doc:   "The osm-controller metrics report the number of connected proxies",
+			Check: controller.HTTPServerProxyConnectionMetricsCheck{},
+		},
+		{
+			ID:    "helm.release",
+			Tags:  []string{TagControlPlane, TagHelm},
+			Doc:   "The osm Helm release exists and is deployed",
+			Check: osm.HelmReleaseStatusCheck{},
+		},
+		{
+			ID:    "helm.chart-version",
+			Tags:  []string{TagControlPlane, TagHelm},
+			Doc:   "The osm chart version matches the version of the running control plane",
+			Check: osm.HelmChartVersionCheck{},
+		},
+		{
+			ID:    "helm.values-drift",
+			Tags:  []string{TagControlPlane, TagHelm, TagMeshConfig},
+			Doc:   "The values of the osm Helm release match the live MeshConfig and deployments",
+			Check: osm.HelmValuesDriftCheck{},
+		},
+
+		// MeshConfig
+		{
+			ID:    "meshconfig.exists",
+			Tags:  []string{TagMeshConfig, TagControlPlane},
+			Doc:   "The MeshConfig exists in the control plane namespace",
+			Check: osm.MeshConfigExistsCheck{},
+		},
+		{
+			ID:    "meshconfig.log-level",
+			Tags:  []string{TagMeshConfig},
+			Doc:   "The Envoy and OSM log levels of the MeshConfig are valid",
+			Check: meshconfig.LogLevelCheck{},
+		},
+		{
+			ID:    "meshconfig.envoy-image",
+			Tags:  []string{TagMeshConfig, TagEnvoy},
+			Doc:   "The Envoy image of the MeshConfig is a version supported by the OSM version",
+			Check: meshconfig.EnvoyImageCheck{},
+		},
+		{
+			ID:    "meshconfig.durations",
+			Tags:  []string{TagMeshConfig, TagCerts},
+			Doc:   "The certificate validity durations and proxy config resync interval of the MeshConfig are sensible",
+			Check: meshconfig.DurationsCheck{},
+		},
+		{
+			ID:    "meshconfig.tracing",
+			Tags:  []string{TagMeshConfig},
+			Doc:   "The tracing address of the MeshConfig resolves to a service exposing the tracing port",
+			Check: meshconfig.TracingCheck{},
+		},
+		{
+			ID:    "meshconfig.traffic-policy",
+			Tags:  []string{TagMeshConfig, TagSMI},
+			Doc:   "Describes how permissive mode, egress and feature flags affect traffic policies",
+			Check: meshconfig.TrafficPolicyCheck{},
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package checks
+
+import (
+	"reflect"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestCatalog(t *testing.T) {
+	assert := tassert.New(t)
+	ids := map[string]bool{}
+	types := map[reflect.Type]bool{}
+	for _, info := range Catalog() {
+		assert.NotEmpty(info.ID)
+		assert.False(ids[info.ID], "duplicate check ID %s", info.ID)
+		ids[info.ID] = true
+
+		checkType := reflect.TypeOf(info.Check)
+		assert.False(types[checkType], "check type %s is registered more than once", checkType)
+		types[checkType] = true
+
+		assert.NotEmpty(info.Tags, "check %s has no tags", info.ID)
+		assert.NotEmpty(info.Doc, "check %s has no doc", info.ID)
+	}
+}
@@ -2,11 +2,18 @@ package cli
 
 import (
 	"os"
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/spf13/pflag"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/logs"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	"github.com/openservicemesh/osm-health/pkg/runner"
 )
 
 const (
@@ -16,14 +23,35 @@ const (
 
 // EnvSettings describes all CLI environment settings
 type EnvSettings struct {
-	namespace string
-	config    *genericclioptions.ConfigFlags
+	namespace               string
+	versionCapabilitiesFile string
+	diagnosisRulesFiles     []string
+	plugins                 []string
+	logTailLines            int64
+	logSince                time.Duration
+	logAllowlist            []string
+	meshConfigTimeout       time.Duration
+	timeout                 time.Duration
+	checkTimeout            time.Duration
+	checks                  []string
+	skipChecks              []string
+	tags                    []string
+	recordSnapshot          string
+	fromSnapshot            string
+	report                  string
+	reportFile              string
+	output                  string
+	outputFile              string
+	config                  *genericclioptions.ConfigFlags
 }
 
 // New relevant environment variables set and returns EnvSettings
 func New() *EnvSettings {
 	env := &EnvSettings{
-		namespace: envOr(osmNamespaceEnvVar, defaultOSMNamespace),
+		namespace:         envOr(osmNamespaceEnvVar, defaultOSMNamespace),
+		logTailLines:      logs.DefaultTailLines,
+		meshConfigTimeout: config.DefaultReadTimeout,
+		checkTimeout:      runner.DefaultCheckTimeout,
 	}
 
 	// bind to kubernetes config flags
@@ -43,6 +71,24 @@ func envOr(name, defaultVal string) string {
 // AddFlags binds flags to the given flagset.
 func (s *EnvSettings) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.namespace, "osm-namespace", s.namespace, "namespace for osm control plane")
+	fs.Int64Var(&s.logTailLines, "log-tail-lines", s.logTailLines, "number of most recent container log lines to analyze; 0 or less analyzes all the lines")
+	fs.DurationVar(&s.logSince, "since", s.logSince, "only analyze container logs newer than a relative duration like 5s, 2m, or 3h")
+	fs.StringArrayVar(&s.logAllowlist, "log-allowlist", s.logAllowlist, "regular expression matching benign container log lines to ignore (can be repeated)")
+	fs.DurationVar(&s.timeout, "timeout", s.timeout, "how long the checks of a command may run in total before the remaining checks are skipped; 0 disables the timeout")
+	fs.DurationVar(&s.checkTimeout, "check-timeout", s.checkTimeout, "how long each check may run before it times out; 0 disables the timeout")
+	fs.StringSliceVar(&s.checks, "checks", s.checks, "comma-separated IDs of the checks to run, or patterns like envoy.*; run 'osm-health checks list' to list the checks")
+	fs.StringSliceVar(&s.skipChecks, "skip-checks", s.skipChecks, "comma-separated IDs of the checks not to run, or patterns like smi.*")
+	fs.StringSliceVar(&s.tags, "tags", s.tags, "comma-separated tags of the checks to run, such as envoy,smi; a check runs when it has any of the tags")
+	fs.DurationVar(&s.meshConfigTimeout, "meshconfig-timeout", s.meshConfigTimeout, "how long to retry reading the MeshConfig before checks that depend on it fail")
+	fs.StringVar(&s.recordSnapshot, "record-snapshot", s.recordSnapshot, "record the cluster state read by the checks to a directory, or to a tarball when the path ends in .tar.gz or .tgz")
+	fs.StringVar(&s.fromSnapshot, "from-snapshot", s.fromSnapshot, "run the checks against a cluster snapshot saved with --record-snapshot instead of the cluster")
+	fs.StringVar(&s.report, "report", s.report, "also write the outcomes of the checks as a self-contained report: html or markdown")
+	fs.StringVar(&s.reportFile, "report-file", s.reportFile, "path of the report written with --report (default osm-health-report.html or osm-health-report.md)")
+	fs.StringVar(&s.output, "output", s.output, "also write the outcomes of the checks in a format for CI pipelines: junit or json")
+	fs.StringVar(&s.outputFile, "output-file", s.outputFile, "path of the file written with --output, to which the outcomes of each command are appended (default osm-health-junit.xml or osm-health-results.json)")
+	fs.StringVar(&s.versionCapabilitiesFile, "version-capabilities-file", s.versionCapabilitiesFile, "YAML file describing the capabilities of OSM versions unknown to osm-health")
+	fs.StringArrayVar(&s.diagnosisRulesFiles, "rules-file", s.diagnosisRulesFiles, "YAML file of additional diagnosis rules (can be repeated)")
+	fs.StringArrayVar(&s.plugins, "plugin", s.plugins, "executable providing additional checks that are run alongside the built-in checks (can be repeated)")
 }
 
 // RESTClientGetter gets the kubeconfig from EnvSettings
@@ -57,3 +103,88 @@ func (s *EnvSettings) Namespace() common.MeshNamespace {
 	}
 	return "default"
 }
+
+// VersionCapabilitiesFile gets the path of the user-supplied OSM version capabilities file
+func (s *EnvSettings) VersionCapabilitiesFile() string {
+	return s.versionCapabilitiesFile
+}
+
+// DiagnosisRulesFiles gets the paths of the user-supplied diagnosis rules files
+func (s *EnvSettings) DiagnosisRulesFiles() []string {
+	return s.diagnosisRulesFiles
+}
+
+// Plugins gets the paths of the user-supplied plugin executables
+func (s *EnvSettings) Plugins() []string {
+	return s.plugins
+}
+
+// MeshConfigTimeout gets how long to retry reading the MeshConfig
+func (s *EnvSettings) MeshConfigTimeout() time.Duration {
+	return s.meshConfigTimeout
+}
+
+// Timeout gets how long the checks of a command may run in total
+func (s *EnvSettings) Timeout() time.Duration {
+	return s.timeout
+}
+
+// CheckTimeout gets how long each check may run
+func (s *EnvSettings) CheckTimeout() time.Duration {
+	return s.checkTimeout
+}
+
+// CheckFilter gets the filter selecting the checks to run
+func (s *EnvSettings) CheckFilter() runner.Filter {
+	return runner.Filter{
+		Checks:     s.checks,
+		SkipChecks: s.skipChecks,
+		Tags:       s.tags,
+	}
+}
+
+// RecordSnapshot gets the path to record the cluster state read by the checks to
+func (s *EnvSettings) RecordSnapshot() string {
+	return s.recordSnapshot
+}
+
+// FromSnapshot gets the path of the cluster snapshot to run the checks against
+func (s *EnvSettings) FromSnapshot() string {
+	return s.fromSnapshot
+}
+
+// Report gets the format of the report to write, or an empty string when no report is written
+func (s *EnvSettings) Report() string {
+	return s.report
+}
+
+// ReportFile gets the path of the report to write, or an empty string for the default path of its format
+func (s *EnvSettings) ReportFile() string {
+	return s.reportFile
+}
+
+// Output gets the format of the outcomes to write for CI pipelines, or an empty string when they are not written
+func (s *EnvSettings) Output() string {
+	return s.output
+}
+
+// OutputFile gets the path of the outcomes to write for CI pipelines, or an empty string for the default path of their format
+func (s *EnvSettings) OutputFile() string {
+	return s.outputFile
+}
+
+// LogOptions gets the options for analyzing container logs
+func (s *EnvSettings) LogOptions() (logs.Options, error) {
+	opts := logs.Options{
+		TailLines: s.logTailLines,
+		Since:     s.logSince,
+	}
+	for _, pattern := range s.logAllowlist {
+		allowed, err := regexp.Compile(pattern)
+		if err != nil {
+			return logs.Options{}, errors.Wrapf(err, "invalid --log-allowlist pattern %q", pattern)
+		}
+		opts.Allowlist = append(opts.Allowlist, allowed)
+	}
+	return opts, nil
+}
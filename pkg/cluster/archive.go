@@ -0,0 +1,182 @@
+package cluster
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// archive is the set of files of a recorded cluster snapshot, keyed by their slash-separated path in the snapshot.
+type archive struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+func newArchive() *archive {
+	return &archive{files: make(map[string][]byte)}
+}
+
+// put adds a file to the archive, replacing any file previously recorded at the same path.
+func (a *archive) put(name string, data []byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.files[name] = data
+}
+
+// putLongest adds a file to the archive, unless a longer file was previously recorded at the same path.
+func (a *archive) putLongest(name string, data []byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.files[name]) <= len(data) {
+		a.files[name] = data
+	}
+}
+
+// get returns the file recorded at the given path.
+func (a *archive) get(name string) ([]byte, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	data, ok := a.files[name]
+	return data, ok
+}
+
+// list returns the sorted paths of the files under the given directory.
+func (a *archive) list(dir string) []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	var names []string
+	for name := range a.files {
+		if strings.HasPrefix(name, dir+"/") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isTarball returns whether the snapshot at the given path is a gzipped tarball rather than a directory.
+func isTarball(snapshotPath string) bool {
+	return strings.HasSuffix(snapshotPath, ".tar.gz") || strings.HasSuffix(snapshotPath, ".tgz")
+}
+
+// write saves the archive to a directory, or to a gzipped tarball when the path ends in .tar.gz or .tgz.
+func (a *archive) write(snapshotPath string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var names []string
+	for name := range a.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if !isTarball(snapshotPath) {
+		for _, name := range names {
+			filename := filepath.Join(snapshotPath, filepath.FromSlash(name))
+			if err := os.MkdirAll(filepath.Dir(filename), 0750); err != nil {
+				return errors.Wrapf(err, "unable to create directory for %s", filename)
+			}
+			if err := ioutil.WriteFile(filename, a.files[name], 0600); err != nil {
+				return errors.Wrapf(err, "unable to write %s", filename)
+			}
+		}
+		return nil
+	}
+
+	f, err := os.Create(filepath.Clean(snapshotPath))
+	if err != nil {
+		return errors.Wrapf(err, "unable to create %s", snapshotPath)
+	}
+	gzipWriter := gzip.NewWriter(f)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, name := range names {
+		header := &tar.Header{
+			Name: name,
+			Mode: 0600,
+			Size: int64(len(a.files[name])),
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			_ = f.Close()
+			return errors.Wrapf(err, "unable to write %s to %s", name, snapshotPath)
+		}
+		if _, err := tarWriter.Write(a.files[name]); err != nil {
+			_ = f.Close()
+			return errors.Wrapf(err, "unable to write %s to %s", name, snapshotPath)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		_ = f.Close()
+		return errors.Wrapf(err, "unable to write %s", snapshotPath)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		_ = f.Close()
+		return errors.Wrapf(err, "unable to write %s", snapshotPath)
+	}
+	return f.Close()
+}
+
+// readArchive reads a snapshot saved to a directory or gzipped tarball.
+func readArchive(snapshotPath string) (*archive, error) {
+	a := newArchive()
+
+	if !isTarball(snapshotPath) {
+		err := filepath.Walk(snapshotPath, func(filename string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			data, err := ioutil.ReadFile(filepath.Clean(filename))
+			if err != nil {
+				return err
+			}
+			name, err := filepath.Rel(snapshotPath, filename)
+			if err != nil {
+				return err
+			}
+			a.files[filepath.ToSlash(name)] = data
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read cluster snapshot %s", snapshotPath)
+		}
+		return a, nil
+	}
+
+	f, err := os.Open(filepath.Clean(snapshotPath))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read cluster snapshot %s", snapshotPath)
+	}
+	defer f.Close() //nolint: errcheck,gosec
+
+	gzipReader, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read cluster snapshot %s", snapshotPath)
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read cluster snapshot %s", snapshotPath)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := ioutil.ReadAll(tarReader)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read %s from cluster snapshot %s", header.Name, snapshotPath)
+		}
+		a.files[path.Clean(header.Name)] = data
+	}
+	return a, nil
+}
@@ -0,0 +1,11 @@
+package cluster
+
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
+
+var (
+	// ErrEnvoyConfigNotRecorded denotes that a replayed cluster snapshot has no Envoy config dump for a pod.
+	ErrEnvoyConfigNotRecorded = outcomes.NewError("ENVOY_CONFIG_NOT_RECORDED", "Envoy config of the pod is not in the cluster snapshot")
+
+	// ErrHTTPResponseNotRecorded denotes that a replayed cluster snapshot has no response of the HTTP server of a pod to a path.
+	ErrHTTPResponseNotRecorded = outcomes.NewError("HTTP_RESPONSE_NOT_RECORDED", "HTTP response of the pod is not in the cluster snapshot")
+)
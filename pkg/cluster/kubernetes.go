@@ -0,0 +1,211 @@
+package cluster
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// kubeClient is a Kubernetes clientset whose core/v1 reads go through the snapshot.
+type kubeClient struct {
+	kubernetes.Interface
+	snapshot *Snapshot
+}
+
+// CoreV1 implements kubernetes.Interface
+func (c kubeClient) CoreV1() corev1client.CoreV1Interface {
+	return coreV1Client{CoreV1Interface: c.Interface.CoreV1(), snapshot: c.snapshot}
+}
+
+type coreV1Client struct {
+	corev1client.CoreV1Interface
+	snapshot *Snapshot
+}
+
+// Pods implements corev1client.CoreV1Interface
+func (c coreV1Client) Pods(namespace string) corev1client.PodInterface {
+	return podClient{PodInterface: c.CoreV1Interface.Pods(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+// Services implements corev1client.CoreV1Interface
+func (c coreV1Client) Services(namespace string) corev1client.ServiceInterface {
+	return serviceClient{ServiceInterface: c.CoreV1Interface.Services(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+// Endpoints implements corev1client.CoreV1Interface
+func (c coreV1Client) Endpoints(namespace string) corev1client.EndpointsInterface {
+	return endpointsClient{EndpointsInterface: c.CoreV1Interface.Endpoints(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+// Namespaces implements corev1client.CoreV1Interface
+func (c coreV1Client) Namespaces() corev1client.NamespaceInterface {
+	return namespaceClient{NamespaceInterface: c.CoreV1Interface.Namespaces(), snapshot: c.snapshot}
+}
+
+// Events implements corev1client.CoreV1Interface
+func (c coreV1Client) Events(namespace string) corev1client.EventInterface {
+	return eventClient{EventInterface: c.CoreV1Interface.Events(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+type podClient struct {
+	corev1client.PodInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements corev1client.PodInterface
+func (c podClient) List(ctx context.Context, opts metav1.ListOptions) (*corev1.PodList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("pods", c.namespace, opts), func() (interface{}, error) {
+		return c.PodInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*corev1.PodList).DeepCopy(), nil
+}
+
+// Get implements corev1client.PodInterface
+func (c podClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.Pod, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(corev1.Resource("pods"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("pods", c.namespace, name), func() (interface{}, error) {
+		return c.PodInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*corev1.Pod).DeepCopy(), nil
+}
+
+type serviceClient struct {
+	corev1client.ServiceInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements corev1client.ServiceInterface
+func (c serviceClient) List(ctx context.Context, opts metav1.ListOptions) (*corev1.ServiceList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("services", c.namespace, opts), func() (interface{}, error) {
+		return c.ServiceInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*corev1.ServiceList).DeepCopy(), nil
+}
+
+// Get implements corev1client.ServiceInterface
+func (c serviceClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.Service, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(corev1.Resource("services"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("services", c.namespace, name), func() (interface{}, error) {
+		return c.ServiceInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*corev1.Service).DeepCopy(), nil
+}
+
+type endpointsClient struct {
+	corev1client.EndpointsInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements corev1client.EndpointsInterface
+func (c endpointsClient) List(ctx context.Context, opts metav1.ListOptions) (*corev1.EndpointsList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("endpoints", c.namespace, opts), func() (interface{}, error) {
+		return c.EndpointsInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*corev1.EndpointsList).DeepCopy(), nil
+}
+
+// Get implements corev1client.EndpointsInterface
+func (c endpointsClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.Endpoints, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(corev1.Resource("endpoints"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("endpoints", c.namespace, name), func() (interface{}, error) {
+		return c.EndpointsInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*corev1.Endpoints).DeepCopy(), nil
+}
+
+type namespaceClient struct {
+	corev1client.NamespaceInterface
+	snapshot *Snapshot
+}
+
+// List implements corev1client.NamespaceInterface
+func (c namespaceClient) List(ctx context.Context, opts metav1.ListOptions) (*corev1.NamespaceList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("namespaces", "", opts), func() (interface{}, error) {
+		return c.NamespaceInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*corev1.NamespaceList).DeepCopy(), nil
+}
+
+// Get implements corev1client.NamespaceInterface
+func (c namespaceClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.Namespace, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(corev1.Resource("namespaces"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("namespaces", "", name), func() (interface{}, error) {
+		return c.NamespaceInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*corev1.Namespace).DeepCopy(), nil
+}
+
+type eventClient struct {
+	corev1client.EventInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements corev1client.EventInterface
+func (c eventClient) List(ctx context.Context, opts metav1.ListOptions) (*corev1.EventList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("events", c.namespace, opts), func() (interface{}, error) {
+		return c.EventInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*corev1.EventList).DeepCopy(), nil
+}
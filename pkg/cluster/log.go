@@ -0,0 +1,5 @@
+package cluster
+
+import "github.com/openservicemesh/osm-health/pkg/logger"
+
+var log = logger.New("cluster")
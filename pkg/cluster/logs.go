@@ -0,0 +1,118 @@
+package cluster
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	restclient "k8s.io/client-go/rest"
+	fakerest "k8s.io/client-go/rest/fake"
+)
+
+const logsDir = "logs"
+
+// podLogsPath returns the path of the logs of a container, or of its previous instance, in a snapshot.
+func podLogsPath(namespace string, podName string, containerName string, previous bool) string {
+	name := containerName
+	if previous {
+		name += ".previous"
+	}
+	return path.Join(logsDir, namespace, podName, fmt.Sprintf("%s.log", name))
+}
+
+// parsePodLogsURLPath returns the namespace and name of the pod whose logs are read at the given URL path
+// of the API server, which may be prefixed when the API server is reached through a proxy.
+func parsePodLogsURLPath(urlPath string) (namespace string, podName string, ok bool) {
+	// .../api/v1/namespaces/<namespace>/pods/<pod>/log
+	parts := strings.Split(strings.Trim(urlPath, "/"), "/")
+	if len(parts) < 7 {
+		return "", "", false
+	}
+	parts = parts[len(parts)-7:]
+	if parts[0] != "api" || parts[2] != "namespaces" || parts[4] != "pods" || parts[6] != "log" {
+		return "", "", false
+	}
+	return parts[3], parts[5], true
+}
+
+// recordPodLogs records the logs of a container read with the given query parameters. Checks read the tail of
+// a log as well as the whole of it, so the longest read of each log is kept and the tail is cut from it on replay.
+func (r *Recorder) recordPodLogs(namespace string, podName string, query url.Values, logs []byte) {
+	previous := query.Get("previous") == "true"
+	r.archive.putLongest(podLogsPath(namespace, podName, query.Get("container"), previous), logs)
+}
+
+// replayKubeClient is a Kubernetes clientset serving the container logs recorded in a snapshot, which
+// fake clientsets do not serve.
+type replayKubeClient struct {
+	kubernetes.Interface
+	archive *archive
+}
+
+// CoreV1 implements kubernetes.Interface
+func (c replayKubeClient) CoreV1() corev1client.CoreV1Interface {
+	return replayCoreV1Client{CoreV1Interface: c.Interface.CoreV1(), archive: c.archive}
+}
+
+type replayCoreV1Client struct {
+	corev1client.CoreV1Interface
+	archive *archive
+}
+
+// Pods implements corev1client.CoreV1Interface
+func (c replayCoreV1Client) Pods(namespace string) corev1client.PodInterface {
+	return replayPodClient{PodInterface: c.CoreV1Interface.Pods(namespace), archive: c.archive, namespace: namespace}
+}
+
+type replayPodClient struct {
+	corev1client.PodInterface
+	archive   *archive
+	namespace string
+}
+
+// GetLogs implements corev1client.PodInterface
+func (c replayPodClient) GetLogs(name string, opts *corev1.PodLogOptions) *restclient.Request {
+	logs, recorded := c.archive.get(podLogsPath(c.namespace, name, opts.Container, opts.Previous))
+	fakeClient := &fakerest.RESTClient{
+		Client: fakerest.CreateHTTPClient(func(*http.Request) (*http.Response, error) {
+			if !recorded {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body: ioutil.NopCloser(strings.NewReader(fmt.Sprintf("logs of container %s of pod %s/%s are not in the cluster snapshot",
+						opts.Container, c.namespace, name))),
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader(tailLines(logs, opts.TailLines))),
+			}, nil
+		}),
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		GroupVersion:         corev1.SchemeGroupVersion,
+		VersionedAPIPath:     fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/log", c.namespace, name),
+	}
+	return fakeClient.Request()
+}
+
+// tailLines returns the last n lines of the logs, or all of them when n is not set.
+func tailLines(logs []byte, n *int64) []byte {
+	if n == nil || *n < 0 {
+		return logs
+	}
+	lines := bytes.SplitAfter(logs, []byte("\n"))
+	if len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	if int64(len(lines)) > *n {
+		lines = lines[int64(len(lines))-*n:]
+	}
+	return bytes.Join(lines, nil)
+}
@@ -0,0 +1,182 @@
+package cluster
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+)
+
+const (
+	objectsDir   = "objects"
+	discoveryDir = "discovery"
+	envoyDir     = "envoy"
+	httpDir      = "http"
+)
+
+// activeRecorder records what the clients of this process read, when set.
+var activeRecorder *Recorder
+
+// SetRecorder makes the clients created from now on record what they read to the given Recorder.
+func SetRecorder(recorder *Recorder) {
+	activeRecorder = recorder
+}
+
+// ActiveRecorder returns the Recorder the clients of this process record to, or nil when they do not record.
+func ActiveRecorder() *Recorder {
+	return activeRecorder
+}
+
+// Recorder records every Kubernetes object, SMI resource, MeshConfig, Helm release, container log, Envoy config dump and
+// response of a control plane HTTP server read from the cluster,
+// so that the checks can be run again later against the same state of the cluster with a Replay.
+type Recorder struct {
+	archive *archive
+}
+
+// NewRecorder returns an empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{archive: newArchive()}
+}
+
+// Save writes what was recorded to a directory, or to a gzipped tarball when the path ends in .tar.gz or .tgz.
+func (r *Recorder) Save(snapshotPath string) error {
+	return r.archive.write(snapshotPath)
+}
+
+// RecordEnvoyConfig records the config dump of the Envoy sidecar of the given pod.
+func (r *Recorder) RecordEnvoyConfig(namespace string, podName string, configDump []byte) {
+	r.archive.put(envoyConfigPath(namespace, podName), configDump)
+}
+
+// HTTPResponse is the response of the HTTP server of a pod, such as the health and metrics endpoints of osm-controller.
+type HTTPResponse struct {
+	StatusCode int    `json:"statusCode"`
+	Body       string `json:"body"`
+}
+
+// RecordHTTPResponse records the response of the HTTP server of the given pod to a GET of the given path.
+func (r *Recorder) RecordHTTPResponse(namespace string, podName string, urlPath string, resp HTTPResponse) {
+	data, err := json.MarshalIndent(resp, "", "  ")
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to record the response of pod %s/%s to GET %s", namespace, podName, urlPath)
+		return
+	}
+	r.archive.put(httpResponsePath(namespace, podName, urlPath), data)
+}
+
+// WrapTransport returns a RoundTripper recording the objects and logs in the responses of the API server to reads.
+// It is meant to be set as the WrapTransport of a rest.Config, so that every clientset created from it records.
+func (r *Recorder) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return recordingRoundTripper{recorder: r, next: rt}
+}
+
+// recordingRoundTripper records the JSON objects and container logs in the responses to GET requests.
+type recordingRoundTripper struct {
+	recorder *Recorder
+	next     http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil || req.Method != http.MethodGet || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	query := req.URL.Query()
+	// Watches and followed logs are streamed, so they are not recorded.
+	if query.Get("watch") == "true" || query.Get("follow") == "true" {
+		return resp, nil
+	}
+	namespace, podName, isPodLogs := parsePodLogsURLPath(req.URL.Path)
+	if !isPodLogs && !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		return resp, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if isPodLogs {
+		rt.recorder.recordPodLogs(namespace, podName, query, body)
+		return resp, nil
+	}
+	if err := rt.recorder.recordResponse(body); err != nil {
+		log.Warn().Err(err).Msgf("Unable to record the response to GET %s", req.URL.Path)
+	}
+	return resp, nil
+}
+
+// recordResponse records the object, list items or discovery information in the body of a response.
+func (r *Recorder) recordResponse(body []byte) error {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return err
+	}
+	apiVersion, _ := obj["apiVersion"].(string)
+	kind, _ := obj["kind"].(string)
+
+	if kind == "APIResourceList" {
+		groupVersion, _ := obj["groupVersion"].(string)
+		r.archive.put(path.Join(discoveryDir, strings.ReplaceAll(groupVersion, "/", "_")+".json"), body)
+		return nil
+	}
+
+	items, isList := obj["items"].([]interface{})
+	if !isList || !strings.HasSuffix(kind, "List") {
+		return r.recordObject(obj)
+	}
+	// The items of a list do not carry their own type.
+	for _, item := range items {
+		itemObj, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		itemObj["apiVersion"] = apiVersion
+		itemObj["kind"] = strings.TrimSuffix(kind, "List")
+		if err := r.recordObject(itemObj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordObject records a single object, ignoring responses such as statuses that are not stored objects.
+func (r *Recorder) recordObject(obj map[string]interface{}) error {
+	apiVersion, _ := obj["apiVersion"].(string)
+	kind, _ := obj["kind"].(string)
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	if apiVersion == "" || kind == "" || name == "" {
+		return nil
+	}
+	namespace, _ := metadata["namespace"].(string)
+
+	data, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+	r.archive.put(objectPath(apiVersion, kind, namespace, name), data)
+	return nil
+}
+
+// objectPath returns the path of an object in a snapshot; cluster-scoped objects have no namespace directory.
+func objectPath(apiVersion string, kind string, namespace string, name string) string {
+	return path.Join(objectsDir, apiVersion, kind, namespace, fmt.Sprintf("%s.json", name))
+}
+
+// envoyConfigPath returns the path of the Envoy config dump of a pod in a snapshot.
+func envoyConfigPath(namespace string, podName string) string {
+	return path.Join(envoyDir, namespace, fmt.Sprintf("%s.json", podName))
+}
+
+// httpResponsePath returns the path in a snapshot of the response of the HTTP server of a pod to a GET of urlPath.
+func httpResponsePath(namespace string, podName string, urlPath string) string {
+	return path.Join(httpDir, namespace, podName, fmt.Sprintf("%s.json", strings.Trim(urlPath, "/")))
+}
@@ -0,0 +1,239 @@
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	smiAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned"
+	fakeAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned/fake"
+	smiSpecClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned"
+	fakeSpecClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned/fake"
+	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	fakeSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/fake"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	configClient "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned"
+	fakeConfigClient "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned/fake"
+)
+
+const (
+	smiAccessGroup = "access.smi-spec.io"
+	smiSpecsGroup  = "specs.smi-spec.io"
+	smiSplitGroup  = "split.smi-spec.io"
+	osmConfigGroup = "config.openservicemesh.io"
+)
+
+// activeReplay serves the clients of this process from a recorded cluster snapshot, when set.
+var activeReplay *Replay
+
+// SetReplay makes the clients created from now on read from the given Replay instead of the cluster.
+func SetReplay(replay *Replay) {
+	activeReplay = replay
+}
+
+// ActiveReplay returns the Replay the clients of this process read from, or nil when they read from the cluster.
+func ActiveReplay() *Replay {
+	return activeReplay
+}
+
+// Replay serves a cluster snapshot recorded by a Recorder through fake clientsets, so that checks can be run
+// offline against the state of the cluster at the time of the recording.
+type Replay struct {
+	kubeClient   *fake.Clientset
+	configClient *fakeConfigClient.Clientset
+	accessClient *fakeAccessClient.Clientset
+	specClient   *fakeSpecClient.Clientset
+	splitClient  *fakeSplitClient.Clientset
+	archive      *archive
+}
+
+// LoadReplay reads a cluster snapshot saved by Recorder.Save to a directory or gzipped tarball.
+func LoadReplay(snapshotPath string) (*Replay, error) {
+	a, err := readArchive(snapshotPath)
+	if err != nil {
+		return nil, err
+	}
+	return newReplay(a)
+}
+
+// newReplay loads the objects of the archive into fake clientsets.
+func newReplay(a *archive) (*Replay, error) {
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		fake.AddToScheme,
+		fakeConfigClient.AddToScheme,
+		fakeAccessClient.AddToScheme,
+		fakeSpecClient.AddToScheme,
+		fakeSplitClient.AddToScheme,
+	} {
+		if err := addToScheme(scheme); err != nil {
+			return nil, err
+		}
+	}
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	var kubeObjects, configObjects, accessObjects, specObjects, splitObjects []runtime.Object
+	for _, name := range a.list(objectsDir) {
+		data, _ := a.get(name)
+		obj, gvk, err := decoder.Decode(data, nil, nil)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping %s of the cluster snapshot", name)
+			continue
+		}
+		switch gvk.Group {
+		case osmConfigGroup:
+			configObjects = append(configObjects, obj)
+		case smiAccessGroup:
+			accessObjects = append(accessObjects, obj)
+		case smiSpecsGroup:
+			specObjects = append(specObjects, obj)
+		case smiSplitGroup:
+			splitObjects = append(splitObjects, obj)
+		default:
+			kubeObjects = append(kubeObjects, obj)
+		}
+	}
+
+	replay := &Replay{
+		kubeClient:   fake.NewSimpleClientset(kubeObjects...),
+		configClient: fakeConfigClient.NewSimpleClientset(configObjects...),
+		accessClient: fakeAccessClient.NewSimpleClientset(accessObjects...),
+		specClient:   fakeSpecClient.NewSimpleClientset(specObjects...),
+		splitClient:  fakeSplitClient.NewSimpleClientset(splitObjects...),
+		archive:      a,
+	}
+	replay.kubeClient.PrependReactor("list", "*", filterByFieldSelector(replay.kubeClient.Tracker()))
+	replay.configClient.PrependReactor("list", "*", filterByFieldSelector(replay.configClient.Tracker()))
+	replay.accessClient.PrependReactor("list", "*", filterByFieldSelector(replay.accessClient.Tracker()))
+	replay.specClient.PrependReactor("list", "*", filterByFieldSelector(replay.specClient.Tracker()))
+	replay.splitClient.PrependReactor("list", "*", filterByFieldSelector(replay.splitClient.Tracker()))
+
+	// The fake discovery client serves the API groups and resources recorded from the discovery API.
+	for _, name := range a.list(discoveryDir) {
+		data, _ := a.get(name)
+		resources := &metav1.APIResourceList{}
+		if err := json.Unmarshal(data, resources); err != nil {
+			log.Warn().Err(err).Msgf("Skipping %s of the cluster snapshot", name)
+			continue
+		}
+		replay.kubeClient.Resources = append(replay.kubeClient.Resources, resources)
+	}
+
+	return replay, nil
+}
+
+// KubeClient returns a Kubernetes clientset serving the recorded Kubernetes objects and container logs.
+func (r *Replay) KubeClient() kubernetes.Interface {
+	return replayKubeClient{Interface: r.kubeClient, archive: r.archive}
+}
+
+// ConfigClient returns an OSM config clientset serving the recorded MeshConfig.
+func (r *Replay) ConfigClient() configClient.Interface {
+	return r.configClient
+}
+
+// AccessClient returns an SMI access clientset serving the recorded TrafficTargets.
+func (r *Replay) AccessClient() smiAccessClient.Interface {
+	return r.accessClient
+}
+
+// SpecClient returns an SMI specs clientset serving the recorded HTTPRouteGroups and TCPRoutes.
+func (r *Replay) SpecClient() smiSpecClient.Interface {
+	return r.specClient
+}
+
+// SplitClient returns an SMI split clientset serving the recorded TrafficSplits.
+func (r *Replay) SplitClient() smiSplitClient.Interface {
+	return r.splitClient
+}
+
+// EnvoyConfig returns the recorded config dump of the Envoy sidecar of the given pod.
+func (r *Replay) EnvoyConfig(namespace string, podName string) ([]byte, error) {
+	configDump, ok := r.archive.get(envoyConfigPath(namespace, podName))
+	if !ok {
+		return nil, errors.Wrapf(ErrEnvoyConfigNotRecorded, "pod %s/%s", namespace, podName)
+	}
+	return configDump, nil
+}
+
+// HTTPResponse returns the recorded response of the HTTP server of the given pod to a GET of the given path.
+func (r *Replay) HTTPResponse(namespace string, podName string, urlPath string) (HTTPResponse, error) {
+	var resp HTTPResponse
+	data, ok := r.archive.get(httpResponsePath(namespace, podName, urlPath))
+	if !ok {
+		return resp, errors.Wrapf(ErrHTTPResponseNotRecorded, "GET %s of pod %s/%s", urlPath, namespace, podName)
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return resp, errors.Wrapf(err, "unable to read the recorded response to GET %s of pod %s/%s", urlPath, namespace, podName)
+	}
+	return resp, nil
+}
+
+// filterByFieldSelector returns a reactor serving lists restricted by a field selector, which fake clientsets ignore.
+func filterByFieldSelector(tracker k8stesting.ObjectTracker) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		listAction, ok := action.(k8stesting.ListActionImpl)
+		if !ok {
+			return false, nil, nil
+		}
+		fieldSelector := listAction.GetListRestrictions().Fields
+		if fieldSelector == nil || fieldSelector.Empty() {
+			return false, nil, nil
+		}
+
+		list, err := tracker.List(listAction.GetResource(), listAction.GetKind(), listAction.GetNamespace())
+		if err != nil {
+			return true, nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return true, nil, err
+		}
+		var matching []runtime.Object
+		for _, item := range items {
+			fieldValues, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+			if err != nil {
+				return true, nil, err
+			}
+			if matchesFieldSelector(fieldSelector, fieldValues) {
+				matching = append(matching, item)
+			}
+		}
+		if err := meta.SetList(list, matching); err != nil {
+			return true, nil, err
+		}
+		return true, list, nil
+	}
+}
+
+// matchesFieldSelector returns whether the fields of an object match every requirement of a field selector.
+func matchesFieldSelector(fieldSelector fields.Selector, fieldValues map[string]interface{}) bool {
+	for _, requirement := range fieldSelector.Requirements() {
+		var value string
+		if field, found, err := unstructured.NestedFieldNoCopy(fieldValues, strings.Split(requirement.Field, ".")...); err == nil && found {
+			value = fmt.Sprint(field)
+		}
+		switch requirement.Operator {
+		case selection.Equals, selection.DoubleEquals:
+			if value != requirement.Value {
+				return false
+			}
+		case selection.NotEquals:
+			if value == requirement.Value {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,181 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	accessV1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
+	smiAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	configClient "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned"
+)
+
+// newAPIServer returns a server answering the reads of the test with the given responses, keyed by URL path.
+// String responses are served as plain text, like container logs.
+func newAPIServer(t *testing.T, responses map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if logs, isLogs := response.(string); isLogs {
+			w.Header().Set("Content-Type", "text/plain")
+			if _, err := w.Write([]byte(logs)); err != nil {
+				t.Error(err)
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Error(err)
+		}
+	}))
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	ts := newAPIServer(t, map[string]interface{}{
+		"/api/v1/namespaces/bookstore/pods": corev1.PodList{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PodList"},
+			Items: []corev1.Pod{
+				{ObjectMeta: metav1.ObjectMeta{Name: "bookstore-v1", Namespace: "bookstore"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "bookstore-v2", Namespace: "bookstore"}},
+			},
+		},
+		"/api/v1/namespaces/bookstore/events": corev1.EventList{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "EventList"},
+			Items: []corev1.Event{
+				{
+					ObjectMeta:     metav1.ObjectMeta{Name: "bookstore-v1.1", Namespace: "bookstore"},
+					InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "bookstore-v1"},
+					Type:           corev1.EventTypeWarning,
+				},
+				{
+					ObjectMeta:     metav1.ObjectMeta{Name: "bookstore-v1.2", Namespace: "bookstore"},
+					InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "bookstore-v1"},
+					Type:           corev1.EventTypeNormal,
+				},
+			},
+		},
+		"/apis/config.openservicemesh.io/v1alpha1/namespaces/osm-system/meshconfigs/osm-mesh-config": configv1alpha1.MeshConfig{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "config.openservicemesh.io/v1alpha1", Kind: "MeshConfig"},
+			ObjectMeta: metav1.ObjectMeta{Name: "osm-mesh-config", Namespace: "osm-system"},
+			Spec: configv1alpha1.MeshConfigSpec{
+				Traffic: configv1alpha1.TrafficSpec{EnablePermissiveTrafficPolicyMode: true},
+			},
+		},
+		"/apis/access.smi-spec.io/v1alpha3/traffictargets": accessV1alpha3.TrafficTargetList{
+			TypeMeta: metav1.TypeMeta{APIVersion: "access.smi-spec.io/v1alpha3", Kind: "TrafficTargetList"},
+			Items: []accessV1alpha3.TrafficTarget{
+				{ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "bookstore"}},
+			},
+		},
+		"/api/v1/namespaces/bookstore/pods/bookstore-v1/log": "line 1\nline 2\nline 3\n",
+		"/apis/apps/v1": metav1.APIResourceList{
+			TypeMeta:     metav1.TypeMeta{APIVersion: "v1", Kind: "APIResourceList"},
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{{Name: "deployments", Namespaced: true, Kind: "Deployment"}},
+		},
+	})
+	defer ts.Close()
+
+	recorder := NewRecorder()
+	restConfig := &restclient.Config{Host: ts.URL, WrapTransport: recorder.WrapTransport}
+	kubeClient := kubernetes.NewForConfigOrDie(restConfig)
+	osmConfigClient := configClient.NewForConfigOrDie(restConfig)
+	accessClient := smiAccessClient.NewForConfigOrDie(restConfig)
+
+	_, err := kubeClient.CoreV1().Pods("bookstore").List(context.TODO(), metav1.ListOptions{})
+	tassert.Nil(t, err)
+	_, err = kubeClient.CoreV1().Events("bookstore").List(context.TODO(), metav1.ListOptions{})
+	tassert.Nil(t, err)
+	_, err = osmConfigClient.ConfigV1alpha1().MeshConfigs("osm-system").Get(context.TODO(), "osm-mesh-config", metav1.GetOptions{})
+	tassert.Nil(t, err)
+	_, err = accessClient.AccessV1alpha3().TrafficTargets(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	tassert.Nil(t, err)
+	_, err = kubeClient.Discovery().ServerResourcesForGroupVersion("apps/v1")
+	tassert.Nil(t, err)
+	// Failed reads are not recorded
+	_, err = kubeClient.CoreV1().Services("bookstore").Get(context.TODO(), "bookstore", metav1.GetOptions{})
+	tassert.True(t, apierrors.IsNotFound(err))
+	recorder.RecordEnvoyConfig("bookstore", "bookstore-v1", []byte(`{"configs": []}`))
+	// The longest read of a log is kept.
+	tail := int64(1)
+	_, err = kubeClient.CoreV1().Pods("bookstore").GetLogs("bookstore-v1", &corev1.PodLogOptions{Container: "envoy"}).DoRaw(context.TODO())
+	tassert.Nil(t, err)
+	_, err = kubeClient.CoreV1().Pods("bookstore").GetLogs("bookstore-v1", &corev1.PodLogOptions{Container: "envoy", TailLines: &tail}).DoRaw(context.TODO())
+	tassert.Nil(t, err)
+
+	for _, snapshotPath := range []string{
+		filepath.Join(t.TempDir(), "snapshot"),
+		filepath.Join(t.TempDir(), "snapshot.tar.gz"),
+	} {
+		t.Run(filepath.Base(snapshotPath), func(t *testing.T) {
+			assert := tassert.New(t)
+			assert.Nil(recorder.Save(snapshotPath))
+
+			replay, err := LoadReplay(snapshotPath)
+			assert.Nil(err)
+
+			pods, err := replay.KubeClient().CoreV1().Pods("bookstore").List(context.TODO(), metav1.ListOptions{})
+			assert.Nil(err)
+			assert.Len(pods.Items, 2)
+
+			pod, err := replay.KubeClient().CoreV1().Pods("bookstore").Get(context.TODO(), "bookstore-v2", metav1.GetOptions{})
+			assert.Nil(err)
+			assert.Equal("bookstore-v2", pod.Name)
+
+			events, err := replay.KubeClient().CoreV1().Events("bookstore").List(context.TODO(), metav1.ListOptions{
+				FieldSelector: "type!=Normal,involvedObject.kind=Pod,involvedObject.name=bookstore-v1",
+			})
+			assert.Nil(err)
+			assert.Len(events.Items, 1)
+			assert.Equal("bookstore-v1.1", events.Items[0].Name)
+
+			meshConfig, err := replay.ConfigClient().ConfigV1alpha1().MeshConfigs("osm-system").Get(context.TODO(), "osm-mesh-config", metav1.GetOptions{})
+			assert.Nil(err)
+			assert.True(meshConfig.Spec.Traffic.EnablePermissiveTrafficPolicyMode)
+
+			trafficTargets, err := replay.AccessClient().AccessV1alpha3().TrafficTargets("bookstore").List(context.TODO(), metav1.ListOptions{})
+			assert.Nil(err)
+			assert.Len(trafficTargets.Items, 1)
+
+			_, err = replay.KubeClient().CoreV1().Services("bookstore").Get(context.TODO(), "bookstore", metav1.GetOptions{})
+			assert.True(apierrors.IsNotFound(err))
+
+			resources, err := replay.KubeClient().Discovery().ServerResourcesForGroupVersion("apps/v1")
+			assert.Nil(err)
+			assert.Equal("deployments", resources.APIResources[0].Name)
+
+			configDump, err := replay.EnvoyConfig("bookstore", "bookstore-v1")
+			assert.Nil(err)
+			assert.Equal(`{"configs": []}`, string(configDump))
+
+			_, err = replay.EnvoyConfig("bookstore", "bookstore-v2")
+			assert.ErrorIs(err, ErrEnvoyConfigNotRecorded)
+
+			logs, err := replay.KubeClient().CoreV1().Pods("bookstore").GetLogs("bookstore-v1", &corev1.PodLogOptions{Container: "envoy"}).DoRaw(context.TODO())
+			assert.Nil(err)
+			assert.Equal("line 1\nline 2\nline 3\n", string(logs))
+
+			tailLines := int64(2)
+			logs, err = replay.KubeClient().CoreV1().Pods("bookstore").GetLogs("bookstore-v1", &corev1.PodLogOptions{Container: "envoy", TailLines: &tailLines}).DoRaw(context.TODO())
+			assert.Nil(err)
+			assert.Equal("line 2\nline 3\n", string(logs))
+
+			_, err = replay.KubeClient().CoreV1().Pods("bookstore").GetLogs("bookstore-v1", &corev1.PodLogOptions{Container: "envoy", Previous: true}).DoRaw(context.TODO())
+			assert.True(apierrors.IsNotFound(err))
+		})
+	}
+}
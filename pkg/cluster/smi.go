@@ -0,0 +1,575 @@
+package cluster
+
+import (
+	"context"
+
+	accessv1alpha2 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha2"
+	accessv1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
+	specsv1alpha2 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha2"
+	specsv1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha3"
+	specsv1alpha4 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+	splitv1alpha2 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
+	splitv1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha3"
+	splitv1alpha4 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
+	smiAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned"
+	accessv1alpha2client "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned/typed/access/v1alpha2"
+	accessv1alpha3client "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned/typed/access/v1alpha3"
+	smiSpecClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned"
+	specsv1alpha2client "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned/typed/specs/v1alpha2"
+	specsv1alpha3client "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned/typed/specs/v1alpha3"
+	specsv1alpha4client "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned/typed/specs/v1alpha4"
+	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	splitv1alpha2client "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/typed/split/v1alpha2"
+	splitv1alpha3client "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/typed/split/v1alpha3"
+	splitv1alpha4client "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/typed/split/v1alpha4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// accessClient is an SMI access clientset whose reads go through the snapshot.
+type accessClient struct {
+	smiAccessClient.Interface
+	snapshot *Snapshot
+}
+
+// AccessV1alpha2 implements smiAccessClient.Interface
+func (c accessClient) AccessV1alpha2() accessv1alpha2client.AccessV1alpha2Interface {
+	return accessV1alpha2Client{AccessV1alpha2Interface: c.Interface.AccessV1alpha2(), snapshot: c.snapshot}
+}
+
+// AccessV1alpha3 implements smiAccessClient.Interface
+func (c accessClient) AccessV1alpha3() accessv1alpha3client.AccessV1alpha3Interface {
+	return accessV1alpha3Client{AccessV1alpha3Interface: c.Interface.AccessV1alpha3(), snapshot: c.snapshot}
+}
+
+type accessV1alpha2Client struct {
+	accessv1alpha2client.AccessV1alpha2Interface
+	snapshot *Snapshot
+}
+
+// TrafficTargets implements accessv1alpha2client.AccessV1alpha2Interface
+func (c accessV1alpha2Client) TrafficTargets(namespace string) accessv1alpha2client.TrafficTargetInterface {
+	return trafficTargetV1alpha2Client{TrafficTargetInterface: c.AccessV1alpha2Interface.TrafficTargets(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+type accessV1alpha3Client struct {
+	accessv1alpha3client.AccessV1alpha3Interface
+	snapshot *Snapshot
+}
+
+// TrafficTargets implements accessv1alpha3client.AccessV1alpha3Interface
+func (c accessV1alpha3Client) TrafficTargets(namespace string) accessv1alpha3client.TrafficTargetInterface {
+	return trafficTargetV1alpha3Client{TrafficTargetInterface: c.AccessV1alpha3Interface.TrafficTargets(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+type trafficTargetV1alpha2Client struct {
+	accessv1alpha2client.TrafficTargetInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements accessv1alpha2client.TrafficTargetInterface
+func (c trafficTargetV1alpha2Client) List(ctx context.Context, opts metav1.ListOptions) (*accessv1alpha2.TrafficTargetList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("traffictargets.access.smi-spec.io/v1alpha2", c.namespace, opts), func() (interface{}, error) {
+		return c.TrafficTargetInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*accessv1alpha2.TrafficTargetList).DeepCopy(), nil
+}
+
+// Get implements accessv1alpha2client.TrafficTargetInterface
+func (c trafficTargetV1alpha2Client) Get(ctx context.Context, name string, opts metav1.GetOptions) (*accessv1alpha2.TrafficTarget, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(accessv1alpha2.Resource("traffictargets"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("traffictargets.access.smi-spec.io/v1alpha2", c.namespace, name), func() (interface{}, error) {
+		return c.TrafficTargetInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*accessv1alpha2.TrafficTarget).DeepCopy(), nil
+}
+
+type trafficTargetV1alpha3Client struct {
+	accessv1alpha3client.TrafficTargetInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements accessv1alpha3client.TrafficTargetInterface
+func (c trafficTargetV1alpha3Client) List(ctx context.Context, opts metav1.ListOptions) (*accessv1alpha3.TrafficTargetList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("traffictargets.access.smi-spec.io/v1alpha3", c.namespace, opts), func() (interface{}, error) {
+		return c.TrafficTargetInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*accessv1alpha3.TrafficTargetList).DeepCopy(), nil
+}
+
+// Get implements accessv1alpha3client.TrafficTargetInterface
+func (c trafficTargetV1alpha3Client) Get(ctx context.Context, name string, opts metav1.GetOptions) (*accessv1alpha3.TrafficTarget, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(accessv1alpha3.Resource("traffictargets"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("traffictargets.access.smi-spec.io/v1alpha3", c.namespace, name), func() (interface{}, error) {
+		return c.TrafficTargetInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*accessv1alpha3.TrafficTarget).DeepCopy(), nil
+}
+
+// specsClient is an SMI specs clientset whose reads go through the snapshot.
+type specsClient struct {
+	smiSpecClient.Interface
+	snapshot *Snapshot
+}
+
+// SpecsV1alpha2 implements smiSpecClient.Interface
+func (c specsClient) SpecsV1alpha2() specsv1alpha2client.SpecsV1alpha2Interface {
+	return specsV1alpha2Client{SpecsV1alpha2Interface: c.Interface.SpecsV1alpha2(), snapshot: c.snapshot}
+}
+
+// SpecsV1alpha3 implements smiSpecClient.Interface
+func (c specsClient) SpecsV1alpha3() specsv1alpha3client.SpecsV1alpha3Interface {
+	return specsV1alpha3Client{SpecsV1alpha3Interface: c.Interface.SpecsV1alpha3(), snapshot: c.snapshot}
+}
+
+// SpecsV1alpha4 implements smiSpecClient.Interface
+func (c specsClient) SpecsV1alpha4() specsv1alpha4client.SpecsV1alpha4Interface {
+	return specsV1alpha4Client{SpecsV1alpha4Interface: c.Interface.SpecsV1alpha4(), snapshot: c.snapshot}
+}
+
+type specsV1alpha2Client struct {
+	specsv1alpha2client.SpecsV1alpha2Interface
+	snapshot *Snapshot
+}
+
+// HTTPRouteGroups implements specsv1alpha2client.SpecsV1alpha2Interface
+func (c specsV1alpha2Client) HTTPRouteGroups(namespace string) specsv1alpha2client.HTTPRouteGroupInterface {
+	return httpRouteGroupV1alpha2Client{HTTPRouteGroupInterface: c.SpecsV1alpha2Interface.HTTPRouteGroups(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+// TCPRoutes implements specsv1alpha2client.SpecsV1alpha2Interface
+func (c specsV1alpha2Client) TCPRoutes(namespace string) specsv1alpha2client.TCPRouteInterface {
+	return tcpRouteV1alpha2Client{TCPRouteInterface: c.SpecsV1alpha2Interface.TCPRoutes(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+type specsV1alpha3Client struct {
+	specsv1alpha3client.SpecsV1alpha3Interface
+	snapshot *Snapshot
+}
+
+// HTTPRouteGroups implements specsv1alpha3client.SpecsV1alpha3Interface
+func (c specsV1alpha3Client) HTTPRouteGroups(namespace string) specsv1alpha3client.HTTPRouteGroupInterface {
+	return httpRouteGroupV1alpha3Client{HTTPRouteGroupInterface: c.SpecsV1alpha3Interface.HTTPRouteGroups(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+// TCPRoutes implements specsv1alpha3client.SpecsV1alpha3Interface
+func (c specsV1alpha3Client) TCPRoutes(namespace string) specsv1alpha3client.TCPRouteInterface {
+	return tcpRouteV1alpha3Client{TCPRouteInterface: c.SpecsV1alpha3Interface.TCPRoutes(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+type specsV1alpha4Client struct {
+	specsv1alpha4client.SpecsV1alpha4Interface
+	snapshot *Snapshot
+}
+
+// HTTPRouteGroups implements specsv1alpha4client.SpecsV1alpha4Interface
+func (c specsV1alpha4Client) HTTPRouteGroups(namespace string) specsv1alpha4client.HTTPRouteGroupInterface {
+	return httpRouteGroupV1alpha4Client{HTTPRouteGroupInterface: c.SpecsV1alpha4Interface.HTTPRouteGroups(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+// TCPRoutes implements specsv1alpha4client.SpecsV1alpha4Interface
+func (c specsV1alpha4Client) TCPRoutes(namespace string) specsv1alpha4client.TCPRouteInterface {
+	return tcpRouteV1alpha4Client{TCPRouteInterface: c.SpecsV1alpha4Interface.TCPRoutes(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+type httpRouteGroupV1alpha2Client struct {
+	specsv1alpha2client.HTTPRouteGroupInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements specsv1alpha2client.HTTPRouteGroupInterface
+func (c httpRouteGroupV1alpha2Client) List(ctx context.Context, opts metav1.ListOptions) (*specsv1alpha2.HTTPRouteGroupList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("httproutegroups.specs.smi-spec.io/v1alpha2", c.namespace, opts), func() (interface{}, error) {
+		return c.HTTPRouteGroupInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*specsv1alpha2.HTTPRouteGroupList).DeepCopy(), nil
+}
+
+// Get implements specsv1alpha2client.HTTPRouteGroupInterface
+func (c httpRouteGroupV1alpha2Client) Get(ctx context.Context, name string, opts metav1.GetOptions) (*specsv1alpha2.HTTPRouteGroup, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(specsv1alpha2.Resource("httproutegroups"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("httproutegroups.specs.smi-spec.io/v1alpha2", c.namespace, name), func() (interface{}, error) {
+		return c.HTTPRouteGroupInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*specsv1alpha2.HTTPRouteGroup).DeepCopy(), nil
+}
+
+type tcpRouteV1alpha2Client struct {
+	specsv1alpha2client.TCPRouteInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements specsv1alpha2client.TCPRouteInterface
+func (c tcpRouteV1alpha2Client) List(ctx context.Context, opts metav1.ListOptions) (*specsv1alpha2.TCPRouteList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("tcproutes.specs.smi-spec.io/v1alpha2", c.namespace, opts), func() (interface{}, error) {
+		return c.TCPRouteInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*specsv1alpha2.TCPRouteList).DeepCopy(), nil
+}
+
+// Get implements specsv1alpha2client.TCPRouteInterface
+func (c tcpRouteV1alpha2Client) Get(ctx context.Context, name string, opts metav1.GetOptions) (*specsv1alpha2.TCPRoute, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(specsv1alpha2.Resource("tcproutes"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("tcproutes.specs.smi-spec.io/v1alpha2", c.namespace, name), func() (interface{}, error) {
+		return c.TCPRouteInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*specsv1alpha2.TCPRoute).DeepCopy(), nil
+}
+
+type httpRouteGroupV1alpha3Client struct {
+	specsv1alpha3client.HTTPRouteGroupInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements specsv1alpha3client.HTTPRouteGroupInterface
+func (c httpRouteGroupV1alpha3Client) List(ctx context.Context, opts metav1.ListOptions) (*specsv1alpha3.HTTPRouteGroupList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("httproutegroups.specs.smi-spec.io/v1alpha3", c.namespace, opts), func() (interface{}, error) {
+		return c.HTTPRouteGroupInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*specsv1alpha3.HTTPRouteGroupList).DeepCopy(), nil
+}
+
+// Get implements specsv1alpha3client.HTTPRouteGroupInterface
+func (c httpRouteGroupV1alpha3Client) Get(ctx context.Context, name string, opts metav1.GetOptions) (*specsv1alpha3.HTTPRouteGroup, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(specsv1alpha3.Resource("httproutegroups"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("httproutegroups.specs.smi-spec.io/v1alpha3", c.namespace, name), func() (interface{}, error) {
+		return c.HTTPRouteGroupInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*specsv1alpha3.HTTPRouteGroup).DeepCopy(), nil
+}
+
+type tcpRouteV1alpha3Client struct {
+	specsv1alpha3client.TCPRouteInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements specsv1alpha3client.TCPRouteInterface
+func (c tcpRouteV1alpha3Client) List(ctx context.Context, opts metav1.ListOptions) (*specsv1alpha3.TCPRouteList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("tcproutes.specs.smi-spec.io/v1alpha3", c.namespace, opts), func() (interface{}, error) {
+		return c.TCPRouteInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*specsv1alpha3.TCPRouteList).DeepCopy(), nil
+}
+
+// Get implements specsv1alpha3client.TCPRouteInterface
+func (c tcpRouteV1alpha3Client) Get(ctx context.Context, name string, opts metav1.GetOptions) (*specsv1alpha3.TCPRoute, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(specsv1alpha3.Resource("tcproutes"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("tcproutes.specs.smi-spec.io/v1alpha3", c.namespace, name), func() (interface{}, error) {
+		return c.TCPRouteInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*specsv1alpha3.TCPRoute).DeepCopy(), nil
+}
+
+type httpRouteGroupV1alpha4Client struct {
+	specsv1alpha4client.HTTPRouteGroupInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements specsv1alpha4client.HTTPRouteGroupInterface
+func (c httpRouteGroupV1alpha4Client) List(ctx context.Context, opts metav1.ListOptions) (*specsv1alpha4.HTTPRouteGroupList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("httproutegroups.specs.smi-spec.io/v1alpha4", c.namespace, opts), func() (interface{}, error) {
+		return c.HTTPRouteGroupInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*specsv1alpha4.HTTPRouteGroupList).DeepCopy(), nil
+}
+
+// Get implements specsv1alpha4client.HTTPRouteGroupInterface
+func (c httpRouteGroupV1alpha4Client) Get(ctx context.Context, name string, opts metav1.GetOptions) (*specsv1alpha4.HTTPRouteGroup, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(specsv1alpha4.Resource("httproutegroups"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("httproutegroups.specs.smi-spec.io/v1alpha4", c.namespace, name), func() (interface{}, error) {
+		return c.HTTPRouteGroupInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*specsv1alpha4.HTTPRouteGroup).DeepCopy(), nil
+}
+
+type tcpRouteV1alpha4Client struct {
+	specsv1alpha4client.TCPRouteInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements specsv1alpha4client.TCPRouteInterface
+func (c tcpRouteV1alpha4Client) List(ctx context.Context, opts metav1.ListOptions) (*specsv1alpha4.TCPRouteList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("tcproutes.specs.smi-spec.io/v1alpha4", c.namespace, opts), func() (interface{}, error) {
+		return c.TCPRouteInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*specsv1alpha4.TCPRouteList).DeepCopy(), nil
+}
+
+// Get implements specsv1alpha4client.TCPRouteInterface
+func (c tcpRouteV1alpha4Client) Get(ctx context.Context, name string, opts metav1.GetOptions) (*specsv1alpha4.TCPRoute, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(specsv1alpha4.Resource("tcproutes"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("tcproutes.specs.smi-spec.io/v1alpha4", c.namespace, name), func() (interface{}, error) {
+		return c.TCPRouteInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*specsv1alpha4.TCPRoute).DeepCopy(), nil
+}
+
+// splitClient is an SMI split clientset whose reads go through the snapshot.
+type splitClient struct {
+	smiSplitClient.Interface
+	snapshot *Snapshot
+}
+
+// SplitV1alpha2 implements smiSplitClient.Interface
+func (c splitClient) SplitV1alpha2() splitv1alpha2client.SplitV1alpha2Interface {
+	return splitV1alpha2Client{SplitV1alpha2Interface: c.Interface.SplitV1alpha2(), snapshot: c.snapshot}
+}
+
+// SplitV1alpha3 implements smiSplitClient.Interface
+func (c splitClient) SplitV1alpha3() splitv1alpha3client.SplitV1alpha3Interface {
+	return splitV1alpha3Client{SplitV1alpha3Interface: c.Interface.SplitV1alpha3(), snapshot: c.snapshot}
+}
+
+// SplitV1alpha4 implements smiSplitClient.Interface
+func (c splitClient) SplitV1alpha4() splitv1alpha4client.SplitV1alpha4Interface {
+	return splitV1alpha4Client{SplitV1alpha4Interface: c.Interface.SplitV1alpha4(), snapshot: c.snapshot}
+}
+
+type splitV1alpha2Client struct {
+	splitv1alpha2client.SplitV1alpha2Interface
+	snapshot *Snapshot
+}
+
+// TrafficSplits implements splitv1alpha2client.SplitV1alpha2Interface
+func (c splitV1alpha2Client) TrafficSplits(namespace string) splitv1alpha2client.TrafficSplitInterface {
+	return trafficSplitV1alpha2Client{TrafficSplitInterface: c.SplitV1alpha2Interface.TrafficSplits(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+type splitV1alpha3Client struct {
+	splitv1alpha3client.SplitV1alpha3Interface
+	snapshot *Snapshot
+}
+
+// TrafficSplits implements splitv1alpha3client.SplitV1alpha3Interface
+func (c splitV1alpha3Client) TrafficSplits(namespace string) splitv1alpha3client.TrafficSplitInterface {
+	return trafficSplitV1alpha3Client{TrafficSplitInterface: c.SplitV1alpha3Interface.TrafficSplits(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+type splitV1alpha4Client struct {
+	splitv1alpha4client.SplitV1alpha4Interface
+	snapshot *Snapshot
+}
+
+// TrafficSplits implements splitv1alpha4client.SplitV1alpha4Interface
+func (c splitV1alpha4Client) TrafficSplits(namespace string) splitv1alpha4client.TrafficSplitInterface {
+	return trafficSplitV1alpha4Client{TrafficSplitInterface: c.SplitV1alpha4Interface.TrafficSplits(namespace), snapshot: c.snapshot, namespace: namespace}
+}
+
+type trafficSplitV1alpha2Client struct {
+	splitv1alpha2client.TrafficSplitInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements splitv1alpha2client.TrafficSplitInterface
+func (c trafficSplitV1alpha2Client) List(ctx context.Context, opts metav1.ListOptions) (*splitv1alpha2.TrafficSplitList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("trafficsplits.split.smi-spec.io/v1alpha2", c.namespace, opts), func() (interface{}, error) {
+		return c.TrafficSplitInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*splitv1alpha2.TrafficSplitList).DeepCopy(), nil
+}
+
+// Get implements splitv1alpha2client.TrafficSplitInterface
+func (c trafficSplitV1alpha2Client) Get(ctx context.Context, name string, opts metav1.GetOptions) (*splitv1alpha2.TrafficSplit, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(splitv1alpha2.Resource("trafficsplits"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("trafficsplits.split.smi-spec.io/v1alpha2", c.namespace, name), func() (interface{}, error) {
+		return c.TrafficSplitInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*splitv1alpha2.TrafficSplit).DeepCopy(), nil
+}
+
+type trafficSplitV1alpha3Client struct {
+	splitv1alpha3client.TrafficSplitInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements splitv1alpha3client.TrafficSplitInterface
+func (c trafficSplitV1alpha3Client) List(ctx context.Context, opts metav1.ListOptions) (*splitv1alpha3.TrafficSplitList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("trafficsplits.split.smi-spec.io/v1alpha3", c.namespace, opts), func() (interface{}, error) {
+		return c.TrafficSplitInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*splitv1alpha3.TrafficSplitList).DeepCopy(), nil
+}
+
+// Get implements splitv1alpha3client.TrafficSplitInterface
+func (c trafficSplitV1alpha3Client) Get(ctx context.Context, name string, opts metav1.GetOptions) (*splitv1alpha3.TrafficSplit, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(splitv1alpha3.Resource("trafficsplits"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("trafficsplits.split.smi-spec.io/v1alpha3", c.namespace, name), func() (interface{}, error) {
+		return c.TrafficSplitInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*splitv1alpha3.TrafficSplit).DeepCopy(), nil
+}
+
+type trafficSplitV1alpha4Client struct {
+	splitv1alpha4client.TrafficSplitInterface
+	snapshot  *Snapshot
+	namespace string
+}
+
+// List implements splitv1alpha4client.TrafficSplitInterface
+func (c trafficSplitV1alpha4Client) List(ctx context.Context, opts metav1.ListOptions) (*splitv1alpha4.TrafficSplitList, error) {
+	list, err := c.snapshot.memoize(ctx, listKey("trafficsplits.split.smi-spec.io/v1alpha4", c.namespace, opts), func() (interface{}, error) {
+		return c.TrafficSplitInterface.List(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*splitv1alpha4.TrafficSplitList).DeepCopy(), nil
+}
+
+// Get implements splitv1alpha4client.TrafficSplitInterface
+func (c trafficSplitV1alpha4Client) Get(ctx context.Context, name string, opts metav1.GetOptions) (*splitv1alpha4.TrafficSplit, error) {
+	if list, err := c.List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range list.Items {
+			if list.Items[i].Name == name {
+				return &list.Items[i], nil
+			}
+		}
+		return nil, notFound(splitv1alpha4.Resource("trafficsplits"), name)
+	}
+	item, err := c.snapshot.memoize(ctx, getKey("trafficsplits.split.smi-spec.io/v1alpha4", c.namespace, name), func() (interface{}, error) {
+		return c.TrafficSplitInterface.Get(ctx, name, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item.(*splitv1alpha4.TrafficSplit).DeepCopy(), nil
+}
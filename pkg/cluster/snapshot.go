@@ -0,0 +1,125 @@
+// Package cluster provides a per-run snapshot of the cluster shared by the checks of a command.
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	smiAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned"
+	smiSpecClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned"
+	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Snapshot is a per-run view of the cluster. The clients it returns read pods, services, endpoints, namespaces,
+// events and SMI resources lazily: each list is read from the API server the first time a check asks for it and
+// memoized for the rest of the run, and single objects are looked up in the memoized list of their namespace.
+// This reduces the load on the API server of large clusters and gives every check the same view of the cluster,
+// so that the results of checks cannot contradict each other.
+type Snapshot struct {
+	kubeClient   kubernetes.Interface
+	accessClient smiAccessClient.Interface
+	specClient   smiSpecClient.Interface
+	splitClient  smiSplitClient.Interface
+
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+// entry is a memoized result of reading from the API server.
+type entry struct {
+	// lock is held while the entry is read
+	lock  chan struct{}
+	done  bool
+	value interface{}
+	err   error
+}
+
+// NewSnapshot returns a Snapshot that reads from the given clients. Clients may be nil when a command does not need them.
+func NewSnapshot(kubeClient kubernetes.Interface, accessClient smiAccessClient.Interface, specClient smiSpecClient.Interface, splitClient smiSplitClient.Interface) *Snapshot {
+	return &Snapshot{
+		kubeClient:   kubeClient,
+		accessClient: accessClient,
+		specClient:   specClient,
+		splitClient:  splitClient,
+		entries:      make(map[string]*entry),
+	}
+}
+
+// KubeClient returns a Kubernetes clientset that reads pods, services, endpoints, namespaces and events from the snapshot.
+func (s *Snapshot) KubeClient() kubernetes.Interface {
+	if s.kubeClient == nil {
+		return nil
+	}
+	return kubeClient{Interface: s.kubeClient, snapshot: s}
+}
+
+// AccessClient returns an SMI access clientset that reads TrafficTargets from the snapshot.
+func (s *Snapshot) AccessClient() smiAccessClient.Interface {
+	if s.accessClient == nil {
+		return nil
+	}
+	return accessClient{Interface: s.accessClient, snapshot: s}
+}
+
+// SpecClient returns an SMI specs clientset that reads HTTPRouteGroups and TCPRoutes from the snapshot.
+func (s *Snapshot) SpecClient() smiSpecClient.Interface {
+	if s.specClient == nil {
+		return nil
+	}
+	return specsClient{Interface: s.specClient, snapshot: s}
+}
+
+// SplitClient returns an SMI split clientset that reads TrafficSplits from the snapshot.
+func (s *Snapshot) SplitClient() smiSplitClient.Interface {
+	if s.splitClient == nil {
+		return nil
+	}
+	return splitClient{Interface: s.splitClient, snapshot: s}
+}
+
+// memoize returns the result of read for the given key, calling read only the first time the key is requested.
+// A read cut short because the context of its caller is done is not memoized, so that the next caller retries it.
+func (s *Snapshot) memoize(ctx context.Context, key string, read func() (interface{}, error)) (interface{}, error) {
+	s.mu.Lock()
+	e, ok := s.entries[key]
+	if !ok {
+		e = &entry{lock: make(chan struct{}, 1)}
+		s.entries[key] = e
+	}
+	s.mu.Unlock()
+
+	// Wait for a concurrent read of the same key, unless the caller gives up first.
+	select {
+	case e.lock <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-e.lock }()
+
+	if !e.done {
+		log.Trace().Msgf("Reading %s", key)
+		e.value, e.err = read()
+		e.done = ctx.Err() == nil
+	}
+	return e.value, e.err
+}
+
+// listKey identifies a list of resources in a namespace read with the given options.
+func listKey(resource string, namespace string, opts metav1.ListOptions) string {
+	return fmt.Sprintf("list %s/%s?labelSelector=%s&fieldSelector=%s", resource, namespace, opts.LabelSelector, opts.FieldSelector)
+}
+
+// getKey identifies a single resource read directly from the API server.
+func getKey(resource string, namespace string, name string) string {
+	return fmt.Sprintf("get %s/%s/%s", resource, namespace, name)
+}
+
+// notFound returns the error the API server would return for an object missing from the snapshot.
+func notFound(resource schema.GroupResource, name string) error {
+	return apierrors.NewNotFound(resource, name)
+}
@@ -0,0 +1,104 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	accessV1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
+	fakeAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned/fake"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// countActions counts the API requests with the given verb and resource made through a fake clientset.
+func countActions(fakeClient *k8stesting.Fake, verb string, resource string) *int {
+	count := new(int)
+	fakeClient.PrependReactor(verb, resource, func(k8stesting.Action) (bool, runtime.Object, error) {
+		*count++
+		return false, nil, nil
+	})
+	return count
+}
+
+func TestSnapshotMemoizesLists(t *testing.T) {
+	assert := tassert.New(t)
+	fakeClient := fake.NewSimpleClientset(
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "bookstore"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "bookbuyer", Namespace: "bookbuyer"}},
+	)
+	lists := countActions(&fakeClient.Fake, "list", "services")
+	gets := countActions(&fakeClient.Fake, "get", "services")
+
+	client := NewSnapshot(fakeClient, nil, nil, nil).KubeClient()
+
+	for i := 0; i < 3; i++ {
+		services, err := client.CoreV1().Services("bookstore").List(context.TODO(), metav1.ListOptions{})
+		assert.Nil(err)
+		assert.Len(services.Items, 1)
+	}
+	assert.Equal(1, *lists)
+
+	service, err := client.CoreV1().Services("bookstore").Get(context.TODO(), "bookstore", metav1.GetOptions{})
+	assert.Nil(err)
+	assert.Equal("bookstore", service.Name)
+
+	_, err = client.CoreV1().Services("bookstore").Get(context.TODO(), "bookthief", metav1.GetOptions{})
+	assert.True(apierrors.IsNotFound(err))
+	assert.Equal(1, *lists)
+	assert.Equal(0, *gets)
+
+	// Every namespace is listed separately
+	_, err = client.CoreV1().Services("bookbuyer").List(context.TODO(), metav1.ListOptions{})
+	assert.Nil(err)
+	assert.Equal(2, *lists)
+}
+
+func TestSnapshotReturnsCopies(t *testing.T) {
+	assert := tassert.New(t)
+	fakeClient := fake.NewSimpleClientset(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "bookstore"}})
+	client := NewSnapshot(fakeClient, nil, nil, nil).KubeClient()
+
+	pod, err := client.CoreV1().Pods("bookstore").Get(context.TODO(), "bookstore", metav1.GetOptions{})
+	assert.Nil(err)
+	pod.Labels = map[string]string{"app": "bookthief"}
+
+	pod, err = client.CoreV1().Pods("bookstore").Get(context.TODO(), "bookstore", metav1.GetOptions{})
+	assert.Nil(err)
+	assert.Empty(pod.Labels)
+}
+
+func TestSnapshotGetFallsBackWhenListingFails(t *testing.T) {
+	assert := tassert.New(t)
+	fakeClient := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "bookstore"}})
+	fakeClient.PrependReactor("list", "namespaces", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(corev1.Resource("namespaces"), "", nil)
+	})
+	gets := countActions(&fakeClient.Fake, "get", "namespaces")
+
+	client := NewSnapshot(fakeClient, nil, nil, nil).KubeClient()
+	for i := 0; i < 2; i++ {
+		ns, err := client.CoreV1().Namespaces().Get(context.TODO(), "bookstore", metav1.GetOptions{})
+		assert.Nil(err)
+		assert.Equal("bookstore", ns.Name)
+	}
+	assert.Equal(1, *gets)
+}
+
+func TestSnapshotMemoizesSMIResources(t *testing.T) {
+	assert := tassert.New(t)
+	fakeClient := fakeAccessClient.NewSimpleClientset(&accessV1alpha3.TrafficTarget{ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "bookstore"}})
+	lists := countActions(&fakeClient.Fake, "list", "traffictargets")
+
+	accessClient := NewSnapshot(nil, fakeClient, nil, nil).AccessClient()
+	for i := 0; i < 2; i++ {
+		trafficTargets, err := accessClient.AccessV1alpha3().TrafficTargets("bookstore").List(context.TODO(), metav1.ListOptions{})
+		assert.Nil(err)
+		assert.Len(trafficTargets.Items, 1)
+	}
+	assert.Equal(1, *lists)
+}
@@ -0,0 +1,42 @@
+package outcomes
+
+import "errors"
+
+// ErrorCode identifies why a check failed, such as ENVOY_LISTENER_MISSING, so that other tools can key off it
+// instead of parsing error messages.
+type ErrorCode string
+
+const (
+	// ErrorCodeUnknown is the code of failures whose error does not carry an ErrorCode.
+	ErrorCodeUnknown ErrorCode = "UNKNOWN"
+)
+
+// codedError is an error carrying an ErrorCode.
+type codedError struct {
+	code ErrorCode
+	msg  string
+}
+
+func (e *codedError) Error() string {
+	return e.msg
+}
+
+// ErrorCode returns the code of the error.
+func (e *codedError) ErrorCode() ErrorCode {
+	return e.code
+}
+
+// NewError returns an error with the given code and message. It is meant to declare sentinel errors: errors wrapping
+// the returned error keep its code, and errors.Is matches them against it.
+func NewError(code ErrorCode, msg string) error {
+	return &codedError{code: code, msg: msg}
+}
+
+// ErrorCodeOf returns the code of the first error carrying one in the chain of err, or ErrorCodeUnknown.
+func ErrorCodeOf(err error) ErrorCode {
+	var coded interface{ ErrorCode() ErrorCode }
+	if errors.As(err, &coded) {
+		return coded.ErrorCode()
+	}
+	return ErrorCodeUnknown
+}
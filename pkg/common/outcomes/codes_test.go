@@ -0,0 +1,59 @@
+package outcomes
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestErrorCodeOf(t *testing.T) {
+	errListenerMissing := NewError("ENVOY_LISTENER_MISSING", "envoy listener missing")
+
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode ErrorCode
+	}{
+		{
+			name:         "coded error",
+			err:          errListenerMissing,
+			expectedCode: "ENVOY_LISTENER_MISSING",
+		},
+		{
+			name:         "coded error wrapped with github.com/pkg/errors",
+			err:          pkgerrors.Wrapf(errListenerMissing, "pod %s", "bookstore/bookstore"),
+			expectedCode: "ENVOY_LISTENER_MISSING",
+		},
+		{
+			name:         "coded error wrapped with fmt.Errorf",
+			err:          fmt.Errorf("check timed out: %w", errListenerMissing),
+			expectedCode: "ENVOY_LISTENER_MISSING",
+		},
+		{
+			name:         "error without a code",
+			err:          errors.New("envoy listener missing"),
+			expectedCode: ErrorCodeUnknown,
+		},
+		{
+			name:         "no error",
+			expectedCode: ErrorCodeUnknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			assert.Equal(test.expectedCode, ErrorCodeOf(test.err))
+		})
+	}
+}
+
+func TestFailSeverity(t *testing.T) {
+	assert := tassert.New(t)
+	assert.Equal(SeverityError, Fail{}.GetSeverity())
+	assert.Equal(SeverityCritical, Fail{Severity: SeverityCritical}.GetSeverity())
+	assert.Equal("critical", SeverityCritical.String())
+}
@@ -3,4 +3,7 @@ package outcomes
 const (
 	// NoDiagnosticInfo is used when a check does not have diagnostic information to show.
 	NoDiagnosticInfo = ""
+
+	// NoErrorCode is the error code of outcomes that are not failures.
+	NoErrorCode ErrorCode = ""
 )
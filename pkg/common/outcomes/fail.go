@@ -7,8 +7,16 @@ import (
 var _ Outcome = (*Fail)(nil)
 
 // Fail is the check outcome for checks that fail or encounter errors.
+// The error code of the outcome is the code carried by Error, see NewError.
 type Fail struct {
 	Error error
+
+	// Severity defaults to SeverityError when not set.
+	Severity Severity
+	Details  map[string]interface{}
+
+	// Snippets holds the configuration relevant to the failure, such as the Envoy listener missing a filter chain.
+	Snippets []Snippet
 }
 
 // GetOutcomeType implements outcomes.Outcome.
@@ -27,3 +35,21 @@ func (o Fail) GetDiagnostics() string {
 func (o Fail) GetError() error {
 	return o.Error
 }
+
+// GetErrorCode implements outcomes.Outcome.
+func (o Fail) GetErrorCode() ErrorCode {
+	return ErrorCodeOf(o.Error)
+}
+
+// GetSeverity implements outcomes.Outcome.
+func (o Fail) GetSeverity() Severity {
+	if o.Severity == SeverityNone {
+		return SeverityError
+	}
+	return o.Severity
+}
+
+// GetDetails implements outcomes.Outcome.
+func (o Fail) GetDetails() map[string]interface{} {
+	return o.Details
+}
@@ -11,6 +11,7 @@ var _ Outcome = (*Info)(nil)
 // Ex: check whether a pod participates in an SMI TrafficSplit or not, if yes - output the name of the TrafficSplit
 type Info struct {
 	Diagnostics string
+	Details     map[string]interface{}
 }
 
 // GetOutcomeType implements outcomes.Outcome.
@@ -27,3 +28,18 @@ func (o Info) GetDiagnostics() string {
 func (o Info) GetError() error {
 	return nil
 }
+
+// GetErrorCode implements outcomes.Outcome.
+func (o Info) GetErrorCode() ErrorCode {
+	return NoErrorCode
+}
+
+// GetSeverity implements outcomes.Outcome.
+func (o Info) GetSeverity() Severity {
+	return SeverityInfo
+}
+
+// GetDetails implements outcomes.Outcome.
+func (o Info) GetDetails() map[string]interface{} {
+	return o.Details
+}
@@ -6,9 +6,10 @@ import (
 
 var _ Outcome = (*Pass)(nil)
 
-// Pass is for check outcomes that are successful and do not have diagnostic information to show.
+// Pass is for check outcomes that are successful. Diagnostics optionally summarize what the check found.
 type Pass struct {
-	Msg string
+	Diagnostics string
+	Details     map[string]interface{}
 }
 
 // GetOutcomeType implements outcomes.Outcome.
@@ -18,10 +19,25 @@ func (Pass) GetOutcomeType() string {
 
 // GetDiagnostics implements outcomes.Outcome.
 func (o Pass) GetDiagnostics() string {
-	return o.Msg
+	return o.Diagnostics
 }
 
 // GetError implements outcomes.Outcome.
 func (o Pass) GetError() error {
 	return nil
 }
+
+// GetErrorCode implements outcomes.Outcome.
+func (o Pass) GetErrorCode() ErrorCode {
+	return NoErrorCode
+}
+
+// GetSeverity implements outcomes.Outcome.
+func (o Pass) GetSeverity() Severity {
+	return SeverityNone
+}
+
+// GetDetails implements outcomes.Outcome.
+func (o Pass) GetDetails() map[string]interface{} {
+	return o.Details
+}
@@ -0,0 +1,46 @@
+package outcomes
+
+// Severity ranks how much attention the outcome of a check needs, from SeverityNone to SeverityCritical.
+type Severity int
+
+const (
+	// SeverityNone is the severity of outcomes that need no attention, such as passed or skipped checks.
+	SeverityNone Severity = iota
+
+	// SeverityInfo is the severity of outcomes that only give information about the mesh.
+	SeverityInfo
+
+	// SeverityWarning is the severity of outcomes that may lead to unexpected behavior, or that are inconclusive.
+	SeverityWarning
+
+	// SeverityError is the severity of failed checks, unless the check sets a different one.
+	SeverityError
+
+	// SeverityCritical is the severity of failed checks that break the mesh as a whole, such as a control plane outage.
+	SeverityCritical
+)
+
+var severityNames = map[Severity]string{
+	SeverityNone:     "none",
+	SeverityInfo:     "info",
+	SeverityWarning:  "warning",
+	SeverityError:    "error",
+	SeverityCritical: "critical",
+}
+
+func (s Severity) String() string {
+	if name, ok := severityNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseSeverity returns the Severity with the given name, such as warning or critical.
+func ParseSeverity(name string) (Severity, bool) {
+	for severity, severityName := range severityNames {
+		if severityName == name {
+			return severity, true
+		}
+	}
+	return SeverityNone, false
+}
@@ -0,0 +1,43 @@
+package outcomes
+
+import (
+	"github.com/fatih/color"
+)
+
+var _ Outcome = (*Skipped)(nil)
+
+// Skipped is the check outcome for checks that do not apply, and says why.
+// Ex: SMI access policy checks when OSM is in permissive traffic policy mode
+type Skipped struct {
+	Reason string
+}
+
+// GetOutcomeType implements outcomes.Outcome.
+func (Skipped) GetOutcomeType() string {
+	return color.CyanString("Skipped")
+}
+
+// GetDiagnostics implements outcomes.Outcome.
+func (o Skipped) GetDiagnostics() string {
+	return o.Reason
+}
+
+// GetError implements outcomes.Outcome.
+func (o Skipped) GetError() error {
+	return nil
+}
+
+// GetErrorCode implements outcomes.Outcome.
+func (o Skipped) GetErrorCode() ErrorCode {
+	return NoErrorCode
+}
+
+// GetSeverity implements outcomes.Outcome.
+func (o Skipped) GetSeverity() Severity {
+	return SeverityNone
+}
+
+// GetDetails implements outcomes.Outcome.
+func (o Skipped) GetDetails() map[string]interface{} {
+	return nil
+}
@@ -0,0 +1,11 @@
+package outcomes
+
+// Snippet is an excerpt of the configuration a failed check inspected, such as the JSON of an Envoy listener, shown
+// in reports next to the failure.
+type Snippet struct {
+	// Title says what the snippet is, such as "Outbound listener of bookbuyer/bookbuyer-7d5b4c9f4-x2x8q".
+	Title string
+
+	// Content is the excerpt, usually JSON.
+	Content string
+}
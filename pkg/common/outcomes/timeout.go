@@ -0,0 +1,43 @@
+package outcomes
+
+import (
+	"github.com/fatih/color"
+)
+
+var _ Outcome = (*Timeout)(nil)
+
+// Timeout is the check outcome for checks that did not complete within their timeout, or that were interrupted.
+// Unlike a Fail, it says nothing about the mesh: the check should be run again, e.g. with a longer --check-timeout.
+type Timeout struct {
+	Error error
+}
+
+// GetOutcomeType implements outcomes.Outcome.
+func (Timeout) GetOutcomeType() string {
+	return color.MagentaString("Timeout")
+}
+
+// GetDiagnostics implements outcomes.Outcome.
+func (o Timeout) GetDiagnostics() string {
+	return NoDiagnosticInfo
+}
+
+// GetError implements outcomes.Outcome.
+func (o Timeout) GetError() error {
+	return o.Error
+}
+
+// GetErrorCode implements outcomes.Outcome.
+func (o Timeout) GetErrorCode() ErrorCode {
+	return ErrorCodeOf(o.Error)
+}
+
+// GetSeverity implements outcomes.Outcome.
+func (o Timeout) GetSeverity() Severity {
+	return SeverityWarning
+}
+
+// GetDetails implements outcomes.Outcome.
+func (o Timeout) GetDetails() map[string]interface{} {
+	return nil
+}
@@ -2,7 +2,7 @@ package outcomes
 
 // Outcome is the printable context returned from a check (common.Runnable).
 type Outcome interface {
-	// GetOutcomeType returns the type of the check outcome: pass/fail/info/unknown.
+	// GetOutcomeType returns the type of the check outcome: pass/fail/info/warning/skipped/timeout/unknown.
 	GetOutcomeType() string
 
 	// GetDiagnostics returns detailed diagnostics that were dynamically-generated during the check.
@@ -11,4 +11,14 @@ type Outcome interface {
 
 	// GetError returns the error which common.Runnable{}.Run() returned.
 	GetError() error
+
+	// GetErrorCode returns the code identifying why the check failed, or NoErrorCode when it did not fail.
+	GetErrorCode() ErrorCode
+
+	// GetSeverity returns how much attention the outcome needs.
+	GetSeverity() Severity
+
+	// GetDetails returns machine-readable details of the outcome, such as the names a check expected and found,
+	// or nil when there are none.
+	GetDetails() map[string]interface{}
 }
@@ -2,8 +2,14 @@ package outcomes
 
 var _ Outcome = (*Unknown)(nil)
 
-// Unknown is the outcome type that occurs when the check did not have a conclusive result
-type Unknown struct{}
+// unknownReason is the diagnostics of an Unknown outcome that does not say why the check was inconclusive.
+const unknownReason = "Unknown outcome - this check may be running into issues"
+
+// Unknown is the outcome type that occurs when the check did not have a conclusive result.
+// Reason optionally says why the check could not conclude.
+type Unknown struct {
+	Reason string
+}
 
 // GetOutcomeType implements outcomes.Outcome.
 func (Unknown) GetOutcomeType() string {
@@ -12,10 +18,28 @@ func (Unknown) GetOutcomeType() string {
 
 // GetDiagnostics implements outcomes.Outcome.
 func (o Unknown) GetDiagnostics() string {
-	return "Unknown outcome - this check may be running into issues"
+	if o.Reason == "" {
+		return unknownReason
+	}
+	return o.Reason
 }
 
 // GetError implements outcomes.Outcome.
 func (o Unknown) GetError() error {
 	return nil
 }
+
+// GetErrorCode implements outcomes.Outcome.
+func (o Unknown) GetErrorCode() ErrorCode {
+	return NoErrorCode
+}
+
+// GetSeverity implements outcomes.Outcome.
+func (o Unknown) GetSeverity() Severity {
+	return SeverityWarning
+}
+
+// GetDetails implements outcomes.Outcome.
+func (o Unknown) GetDetails() map[string]interface{} {
+	return nil
+}
@@ -0,0 +1,45 @@
+package outcomes
+
+import (
+	"github.com/fatih/color"
+)
+
+var _ Outcome = (*Warning)(nil)
+
+// Warning is the check outcome for checks that did not fail, but found something the user should be aware of
+// because it may lead to unexpected behavior.
+// Ex: the installed OSM version is not known to osm-health, or a certificate expires soon
+type Warning struct {
+	Diagnostics string
+	Details     map[string]interface{}
+}
+
+// GetOutcomeType implements outcomes.Outcome.
+func (Warning) GetOutcomeType() string {
+	return color.YellowString("Warning")
+}
+
+// GetDiagnostics implements outcomes.Outcome.
+func (o Warning) GetDiagnostics() string {
+	return o.Diagnostics
+}
+
+// GetError implements outcomes.Outcome.
+func (o Warning) GetError() error {
+	return nil
+}
+
+// GetErrorCode implements outcomes.Outcome.
+func (o Warning) GetErrorCode() ErrorCode {
+	return NoErrorCode
+}
+
+// GetSeverity implements outcomes.Outcome.
+func (o Warning) GetSeverity() Severity {
+	return SeverityWarning
+}
+
+// GetDetails implements outcomes.Outcome.
+func (o Warning) GetDetails() map[string]interface{} {
+	return o.Details
+}
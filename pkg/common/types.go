@@ -1,5 +1,11 @@
 package common
 
+import (
+	"time"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
 // MeshName is the type for the name of a mesh.
 type MeshName string
 
@@ -16,15 +22,42 @@ func (mns MeshNamespace) String() string {
 
 // Printable is the printable context around a check (common.Runnable).
 type Printable struct {
+	// CheckID holds the stable ID of the type of the check in the registry, such as envoy.cluster
+	CheckID string
+
+	// CheckType holds the name of the type of the check, such as DynamicWarmingCheck
+	CheckType string
+
 	// CheckDescription holds the description of a check, such as describing what the check does (common.Runnable)
 	CheckDescription string
 
-	// Type holds the type of the check outcome, such as success, fail, info or unknown
+	// Type holds the type of the check outcome, such as success, fail, info, skipped or unknown
 	Type string
 
+	// Severity ranks how much attention the outcome of the check needs
+	Severity outcomes.Severity
+
 	// Diagnostics holds detailed diagnostics that were dynamically-generated during the check
 	Diagnostics string
 
 	// Error is the error which common.Runnable{}.Run() may return
 	Error error
+
+	// ErrorCode identifies why the check failed, such as ENVOY_LISTENER_MISSING, and is empty when it did not fail
+	ErrorCode outcomes.ErrorCode
+
+	// Details holds machine-readable details of the outcome, such as the names a check expected and found
+	Details map[string]interface{}
+
+	// Suggestion holds how to fix the issue found by the check, when the check provides one
+	Suggestion string
+
+	// Snippets holds the configuration relevant to a failure, such as the Envoy listener the check inspected
+	Snippets []outcomes.Snippet
+
+	// Duration holds how long the check ran
+	Duration time.Duration
+
+	// Outcome holds the outcome of the check
+	Outcome outcomes.Outcome
 }
@@ -1,25 +1,35 @@
 package connectivity
 
 import (
-	smiAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned"
-	smiSpecClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned"
-	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	"context"
+	"fmt"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
 
+	"github.com/openservicemesh/osm-health/pkg/cluster"
 	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/diagnosis"
 	"github.com/openservicemesh/osm-health/pkg/envoy"
 	"github.com/openservicemesh/osm-health/pkg/kubernetes/namespace"
 	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
 	"github.com/openservicemesh/osm-health/pkg/kubernetes/podhelper"
+	"github.com/openservicemesh/osm-health/pkg/logs"
+	"github.com/openservicemesh/osm-health/pkg/osm"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
 	"github.com/openservicemesh/osm-health/pkg/osm/utils"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/plugins"
 	"github.com/openservicemesh/osm-health/pkg/printer"
 	"github.com/openservicemesh/osm-health/pkg/runner"
 	"github.com/openservicemesh/osm-health/pkg/smi/access"
 	"github.com/openservicemesh/osm-health/pkg/smi/split"
 )
 
-// PodToPod tests the connectivity between a source and destination pods.
-func PodToPod(srcPod *corev1.Pod, dstPod *corev1.Pod, osmControlPlaneNamespace common.MeshNamespace) {
+// PodToPod tests the connectivity between a source and destination pods. When allReplicas is set, the Envoy checks
+// that depend on the destination pod also run against every ready replica behind the destination's services.
+func PodToPod(ctx context.Context, srcPod *corev1.Pod, dstPod *corev1.Pod, osmControlPlaneNamespace common.MeshNamespace, logOptions logs.Options, meshConfigTimeout time.Duration, allReplicas bool) {
 	log.Info().Msgf("Testing connectivity from %s/%s to %s/%s", srcPod.Namespace, srcPod.Name, dstPod.Namespace, dstPod.Name)
 
 	client, err := pod.GetKubeClient()
@@ -27,29 +37,32 @@ func PodToPod(srcPod *corev1.Pod, dstPod *corev1.Pod, osmControlPlaneNamespace c
 		log.Error().Err(err).Msg("Error creating Kubernetes client")
 	}
 
-	meshInfo, err := utils.GetMeshInfo(client, osmControlPlaneNamespace)
+	splitClient, err := pod.GetSMISplitClient()
 	if err != nil {
-		log.Err(err).Msg("Error getting OSM info")
+		log.Error().Err(err).Msg("Error initializing SMI split client")
 	}
 
-	kubeConfig, err := pod.GetKubeConfig()
+	accessClient, err := pod.GetSMIAccessClient()
 	if err != nil {
-		log.Error().Err(err).Msg("Error getting Kubernetes config")
+		log.Err(err).Msg("Error initializing SMI access client")
 	}
 
-	splitClient, err := smiSplitClient.NewForConfig(kubeConfig)
+	specClient, err := pod.GetSMISpecClient()
 	if err != nil {
-		log.Error().Err(err).Msg("Error initializing SMI split client")
+		log.Err(err).Msg("Error initializing SMI spec client")
 	}
 
-	accessClient, err := smiAccessClient.NewForConfig(kubeConfig)
-	if err != nil {
-		log.Err(err).Msg("Error initializing SMI access client")
-	}
+	// All checks share one view of the cluster
+	snapshot := cluster.NewSnapshot(client, accessClient, specClient, splitClient)
+	client = snapshot.KubeClient()
+	accessClient = snapshot.AccessClient()
+	specClient = snapshot.SpecClient()
+	splitClient = snapshot.SplitClient()
 
-	specClient, err := smiSpecClient.NewForConfig(kubeConfig)
+	meshInfo, err := utils.GetMeshInfoForPod(ctx, client, srcPod.Namespace, osmControlPlaneNamespace)
 	if err != nil {
-		log.Err(err).Msg("Error initializing SMI spec client")
+		log.Err(err).Msg("Error getting OSM info")
+		meshInfo = &utils.MeshInfo{}
 	}
 
 	var srcConfigGetter, dstConfigGetter envoy.ConfigGetter
@@ -64,9 +77,16 @@ func PodToPod(srcPod *corev1.Pod, dstPod *corev1.Pod, osmControlPlaneNamespace c
 		log.Error().Err(err).Msgf("Error creating ConfigGetter for pod %s/%s", dstPod.Namespace, dstPod.Name)
 	}
 
-	configurator := pod.GetOsmConfigurator(meshInfo.Namespace)
+	configClient, err := pod.GetOsmConfigClient()
+	if err != nil {
+		log.Error().Err(err).Msg("Error creating OSM config client")
+	}
+	meshConfig := config.ReadSnapshot(ctx, configClient, meshInfo.Namespace, meshConfigTimeout)
 
 	checks := []runner.Runnable{
+		// Check that osm-health knows the capabilities of the installed OSM version
+		version.NewControllerVersionCheck(meshInfo.DetectedOSMVersion, meshInfo.OSMVersion),
+
 		// Check that pod namespaces are in the same mesh
 		namespace.NewNamespacesInSameMeshCheck(client, srcPod.Namespace, dstPod.Namespace),
 
@@ -75,15 +95,31 @@ func PodToPod(srcPod *corev1.Pod, dstPod *corev1.Pod, osmControlPlaneNamespace c
 		namespace.NewSidecarInjectionCheck(client, dstPod.Namespace),
 		namespace.NewMonitoredCheck(client, srcPod.Namespace, meshInfo.Name),
 		namespace.NewMonitoredCheck(client, dstPod.Namespace, meshInfo.Name),
+		namespace.NewAnnotationsCheck(client, srcPod.Namespace, meshInfo.OSMVersion),
+		namespace.NewAnnotationsCheck(client, dstPod.Namespace, meshInfo.OSMVersion),
+		podhelper.NewAnnotationsCheck(srcPod, meshInfo.OSMVersion),
+		podhelper.NewAnnotationsCheck(dstPod, meshInfo.OSMVersion),
 		podhelper.NewMinNumContainersCheck(srcPod, 2),
 		podhelper.NewMinNumContainersCheck(dstPod, 2),
-		podhelper.NewOsmContainerImageCheck(configurator, srcPod),
-		podhelper.NewOsmContainerImageCheck(configurator, dstPod),
-		podhelper.NewEnvoySidecarImageCheck(configurator, srcPod),
-		podhelper.NewEnvoySidecarImageCheck(configurator, dstPod),
+		podhelper.NewOsmContainerImageCheck(meshConfig, srcPod),
+		podhelper.NewOsmContainerImageCheck(meshConfig, dstPod),
+		podhelper.NewEnvoySidecarImageCheck(meshConfig, srcPod),
+		podhelper.NewEnvoySidecarImageCheck(meshConfig, dstPod),
 		podhelper.NewProxyUUIDLabelCheck(srcPod),
 		podhelper.NewProxyUUIDLabelCheck(dstPod),
 
+		// Check the runtime state of the pods and their containers
+		podhelper.NewPodReadyCheck(srcPod),
+		podhelper.NewPodReadyCheck(dstPod),
+		podhelper.NewContainerRestartsCheck(srcPod),
+		podhelper.NewContainerRestartsCheck(dstPod),
+		podhelper.NewOsmInitExitCodeCheck(srcPod),
+		podhelper.NewOsmInitExitCodeCheck(dstPod),
+
+		// HTTP probes must go through the Envoy sidecars for OSM versions that rewrite them
+		podhelper.NewProbesRewrittenCheck(srcPod, meshInfo.OSMVersion),
+		podhelper.NewProbesRewrittenCheck(dstPod, meshInfo.OSMVersion),
+
 		podhelper.NewEndpointsCheck(client, dstPod),
 
 		// Check pods for bad events
@@ -91,16 +127,26 @@ func PodToPod(srcPod *corev1.Pod, dstPod *corev1.Pod, osmControlPlaneNamespace c
 		podhelper.NewPodEventsCheck(client, dstPod),
 
 		// Check envoy logs
-		envoy.NewBadLogsCheck(client, srcPod),
-		envoy.NewBadLogsCheck(client, dstPod),
+		envoy.NewBadLogsCheck(client, srcPod, logOptions),
+		envoy.NewBadLogsCheck(client, dstPod, logOptions),
 
 		// Check osm-init logs
-		podhelper.HasNoBadOsmInitLogsCheck(client, srcPod),
-		podhelper.HasNoBadOsmInitLogsCheck(client, dstPod),
+		podhelper.HasNoBadOsmInitLogsCheck(client, srcPod, logOptions),
+		podhelper.HasNoBadOsmInitLogsCheck(client, dstPod, logOptions),
+
+		// Check OSM control plane logs
+		osm.HasNoBadOsmControllerLogsCheck(client, meshInfo.Namespace, logOptions),
+		osm.HasNoBadOsmInjectorLogsCheck(client, meshInfo.Namespace, logOptions),
 
 		// The destination pod must have at least one service.
 		podhelper.NewServiceCheck(client, dstPod),
 
+		// Port exclusion annotations must not exclude the destination service ports from the Envoy sidecars.
+		podhelper.NewPortExclusionCheck(client, srcPod, dstPod),
+
+		// The source pod's outbound traffic to the destination services must be intercepted by the source Envoy.
+		podhelper.NewOutboundInterceptionCheck(client, meshConfig, srcPod, dstPod),
+
 		// The source Envoy must have at least one endpoint for the destination Envoy.
 		envoy.NewDestinationEndpointCheck(srcConfigGetter),
 
@@ -108,10 +154,10 @@ func PodToPod(srcPod *corev1.Pod, dstPod *corev1.Pod, osmControlPlaneNamespace c
 		envoy.NewSpecificEndpointCheck(srcConfigGetter, dstPod),
 
 		// Check whether the source Pod has an outbound dynamic route config domain that matches the destination Pod.
-		envoy.NewOutboundRouteDomainPodCheck(client, srcConfigGetter, dstPod),
+		envoy.NewOutboundRouteDomainPodCheck(ctx, client, srcConfigGetter, dstPod),
 
 		// Check whether the destination Pod has an inbound dynamic route config domain that matches the source Pod.
-		envoy.NewInboundRouteDomainPodCheck(client, dstConfigGetter, srcPod),
+		envoy.NewInboundRouteDomainPodCheck(ctx, client, dstConfigGetter, srcPod),
 
 		// Source Envoy must have Outbound listener
 		envoy.NewOutboundListenerCheck(srcConfigGetter, meshInfo.OSMVersion),
@@ -119,6 +165,10 @@ func PodToPod(srcPod *corev1.Pod, dstPod *corev1.Pod, osmControlPlaneNamespace c
 		// Destination Envoy must have Inbound listener
 		envoy.NewInboundListenerCheck(dstConfigGetter, meshInfo.OSMVersion),
 
+		// Rewritten probe ports must match the Envoy probe listeners
+		envoy.NewProbeListenersCheck(srcConfigGetter, srcPod, meshInfo.OSMVersion),
+		envoy.NewProbeListenersCheck(dstConfigGetter, dstPod, meshInfo.OSMVersion),
+
 		// Source Envoy must define a cluster for the destination
 		envoy.NewClusterCheck(client, srcConfigGetter, dstPod),
 
@@ -134,14 +184,92 @@ func PodToPod(srcPod *corev1.Pod, dstPod *corev1.Pod, osmControlPlaneNamespace c
 
 		// Run SMI checks
 		split.NewTrafficSplitCheck(meshInfo.OSMVersion, client, dstPod, splitClient),
-		access.NewTrafficTargetCheck(meshInfo.OSMVersion, configurator, srcPod, dstPod, accessClient),
-		access.NewRoutesValidityCheck(meshInfo.OSMVersion, configurator, srcPod, dstPod, accessClient),
-		access.NewRoutesExistenceCheck(meshInfo.OSMVersion, configurator, srcPod, dstPod, accessClient, specClient),
+		split.NewTrafficSplitRootServiceCheck(meshInfo.OSMVersion, client, dstPod, splitClient),
+		split.NewTrafficSplitBackendsCheck(meshInfo.OSMVersion, client, dstPod, splitClient),
+		split.NewTrafficSplitWeightsCheck(meshInfo.OSMVersion, client, dstPod, splitClient),
+		split.NewTrafficSplitMatchesCheck(meshInfo.OSMVersion, client, dstPod, splitClient, specClient),
+		access.NewTrafficTargetCheck(meshInfo.OSMVersion, meshConfig, srcPod, dstPod, accessClient),
+		access.NewRoutesValidityCheck(meshInfo.OSMVersion, meshConfig, srcPod, dstPod, accessClient),
+		access.NewRoutesExistenceCheck(meshInfo.OSMVersion, meshConfig, srcPod, dstPod, accessClient, specClient),
 
 		// Check whether the source and destination envoys have filter chains that match the destination service.
-		envoy.NewListenerFilterCheck(srcConfigGetter, dstConfigGetter, meshInfo.OSMVersion, configurator, srcPod, dstPod, accessClient, client),
+		envoy.NewListenerFilterCheck(srcConfigGetter, dstConfigGetter, meshInfo.OSMVersion, meshConfig, srcPod, dstPod, accessClient, client),
+
+		// Check whether the HTTPRouteGroup matches referenced by TrafficTargets are programmed as routes in the source and destination envoys.
+		envoy.NewOutboundHTTPRouteMatchCheck(srcConfigGetter, meshInfo.OSMVersion, meshConfig, srcPod, dstPod, accessClient, specClient, client),
+		envoy.NewInboundHTTPRouteMatchCheck(dstConfigGetter, meshInfo.OSMVersion, meshConfig, srcPod, dstPod, accessClient, specClient, client),
+
+		// Check whether the source envoy splits traffic to the destination's services with the weights of their traffic splits.
+		envoy.NewTrafficSplitWeightedClustersCheck(srcConfigGetter, meshInfo.OSMVersion, dstPod, client, splitClient),
+	}
+	checks = append(checks, plugins.Checks(plugins.Target{
+		Command:      "connectivity pod-to-pod",
+		OSMNamespace: meshInfo.Namespace.String(),
+		MeshName:     meshInfo.Name.String(),
+		Pods: []plugins.Pod{
+			{Namespace: srcPod.Namespace, Name: srcPod.Name, Role: plugins.RoleSource},
+			{Namespace: dstPod.Namespace, Name: dstPod.Name, Role: plugins.RoleDestination},
+		},
+	})...)
+
+	outcomes := runner.Run(ctx, checks...)
+
+	var replicas []printer.Replica
+	allOutcomes := outcomes
+	if allReplicas {
+		replicas = checkReplicas(ctx, client, srcConfigGetter, dstPod, meshInfo.OSMVersion, meshConfig)
+		for _, replica := range replicas {
+			allOutcomes = append(allOutcomes, replica.Printables...)
+		}
 	}
 
-	outcomes := runner.Run(checks...)
+	diagnoses := diagnosis.Diagnose(diagnosis.Rules(), allOutcomes...)
 	printer.Print(outcomes...)
+	if allReplicas {
+		printer.PrintReplicas(replicas...)
+	}
+	printer.PrintDiagnoses(diagnoses...)
+	printer.Record(printer.Report{
+		Command:    "connectivity pod-to-pod",
+		Mesh:       meshInfo,
+		Printables: allOutcomes,
+		Diagnoses:  diagnoses,
+	})
+}
+
+// checkReplicas runs the source EDS, inbound listener and certificate checks against every ready replica behind the
+// services of the destination pod, so that a replica missing from the source Envoy or with stale certificates stands
+// out from the others.
+func checkReplicas(ctx context.Context, client kubernetes.Interface, srcConfigGetter envoy.ConfigGetter, dstPod *corev1.Pod, osmVersion version.ControllerVersion, meshConfig config.MeshConfigGetter) []printer.Replica {
+	dstReplicas, err := pod.GetReadyReplicas(ctx, client, dstPod)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error listing the replicas of pod %s/%s", dstPod.Namespace, dstPod.Name)
+		return nil
+	}
+
+	var replicas []printer.Replica
+	for _, replica := range dstReplicas {
+		replicaConfigGetter, err := envoy.GetEnvoyConfigGetterForPod(replica, osmVersion)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error creating ConfigGetter for pod %s/%s", replica.Namespace, replica.Name)
+		}
+
+		replicas = append(replicas, printer.Replica{
+			Pod: fmt.Sprintf("%s/%s", replica.Namespace, replica.Name),
+			IP:  replica.Status.PodIP,
+			Printables: runner.Run(ctx,
+				// The source Envoy must have an endpoint for the replica
+				envoy.NewSpecificEndpointCheck(srcConfigGetter, replica),
+
+				// The replica Envoy must have an inbound listener
+				envoy.NewInboundListenerCheck(replicaConfigGetter, osmVersion),
+
+				// The replica Envoy must have current certificates
+				envoy.HasInboundRootCertificate(client, replicaConfigGetter, replica),
+				envoy.HasServiceCertificate(client, replicaConfigGetter, replica),
+				envoy.NewServiceCertificateAgeCheck(replicaConfigGetter, replica, meshConfig),
+			),
+		})
+	}
+	return replicas
 }
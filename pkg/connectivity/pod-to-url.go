@@ -1,20 +1,24 @@
 package connectivity
 
 import (
+	"context"
 	"net/url"
 
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/diagnosis"
 	"github.com/openservicemesh/osm-health/pkg/envoy"
 	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
 	"github.com/openservicemesh/osm-health/pkg/osm/utils"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/plugins"
 	"github.com/openservicemesh/osm-health/pkg/printer"
 	"github.com/openservicemesh/osm-health/pkg/runner"
 )
 
 // PodToURL tests the connectivity between a source pod and destination url.
-func PodToURL(srcPod *corev1.Pod, destinationURL *url.URL, osmControlPlaneNamespace common.MeshNamespace) {
+func PodToURL(ctx context.Context, srcPod *corev1.Pod, destinationURL *url.URL, osmControlPlaneNamespace common.MeshNamespace) {
 	log.Info().Msgf("Testing connectivity from %s/%s to %s", srcPod.Namespace, srcPod.Name, destinationURL)
 
 	client, err := pod.GetKubeClient()
@@ -22,9 +26,10 @@ func PodToURL(srcPod *corev1.Pod, destinationURL *url.URL, osmControlPlaneNamesp
 		log.Error().Err(err).Msg("Error creating Kubernetes client")
 	}
 
-	meshInfo, err := utils.GetMeshInfo(client, osmControlPlaneNamespace)
+	meshInfo, err := utils.GetMeshInfoForPod(ctx, client, srcPod.Namespace, osmControlPlaneNamespace)
 	if err != nil {
 		log.Error().Err(err).Msg("Error getting OSM info")
+		meshInfo = &utils.MeshInfo{}
 	}
 
 	srcConfigGetter, err := envoy.GetEnvoyConfigGetterForPod(srcPod, meshInfo.OSMVersion)
@@ -32,10 +37,30 @@ func PodToURL(srcPod *corev1.Pod, destinationURL *url.URL, osmControlPlaneNamesp
 		log.Error().Err(err).Msgf("Error creating ConfigGetter for pod %s/%s", srcPod.Namespace, srcPod.Name)
 	}
 
-	outcomes := runner.Run(
+	checks := []runner.Runnable{
+		// Check that osm-health knows the capabilities of the installed OSM version
+		version.NewControllerVersionCheck(meshInfo.DetectedOSMVersion, meshInfo.OSMVersion),
+
 		// Check whether the source Pod has an outbound dynamic route config domain that matches the destination URL.
 		envoy.NewOutboundRouteDomainHostCheck(srcConfigGetter, destinationURL.Host),
-	)
+	}
+	checks = append(checks, plugins.Checks(plugins.Target{
+		Command:      "connectivity pod-to-url",
+		OSMNamespace: meshInfo.Namespace.String(),
+		MeshName:     meshInfo.Name.String(),
+		Pods:         []plugins.Pod{{Namespace: srcPod.Namespace, Name: srcPod.Name, Role: plugins.RoleSource}},
+		URL:          destinationURL.String(),
+	})...)
+
+	outcomes := runner.Run(ctx, checks...)
 
+	diagnoses := diagnosis.Diagnose(diagnosis.Rules(), outcomes...)
 	printer.Print(outcomes...)
+	printer.PrintDiagnoses(diagnoses...)
+	printer.Record(printer.Report{
+		Command:    "connectivity pod-to-url",
+		Mesh:       meshInfo,
+		Printables: outcomes,
+		Diagnoses:  diagnoses,
+	})
 }
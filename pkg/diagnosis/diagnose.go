@@ -0,0 +1,151 @@
+package diagnosis
+
+import (
+	"math"
+	"sort"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+const (
+	outcomePass    = "pass"
+	outcomeFail    = "fail"
+	outcomeWarning = "warning"
+	outcomeInfo    = "info"
+	outcomeTimeout = "timeout"
+	outcomeSkipped = "skipped"
+	outcomeUnknown = "unknown"
+)
+
+// Diagnose matches the rules against the full set of check results of a run and returns the diagnoses of the
+// rules that apply, ranked by confidence.
+func Diagnose(rules []Rule, printables ...common.Printable) []Diagnosis {
+	var diagnoses []Diagnosis
+	for _, rule := range rules {
+		if diagnosis, ok := rule.diagnose(printables); ok {
+			diagnoses = append(diagnoses, diagnosis)
+		}
+	}
+
+	sort.SliceStable(diagnoses, func(i, j int) bool {
+		return diagnoses[i].Confidence > diagnoses[j].Confidence
+	})
+	return diagnoses
+}
+
+func (r Rule) diagnose(printables []common.Printable) (Diagnosis, bool) {
+	diagnosis := Diagnosis{
+		RuleID:     r.ID,
+		Title:      r.Title,
+		Confidence: r.Confidence,
+	}
+
+	for _, condition := range r.When {
+		matched := condition.matching(printables)
+		if len(matched) == 0 {
+			return Diagnosis{}, false
+		}
+		diagnosis.Evidence = appendUnique(diagnosis.Evidence, matched...)
+	}
+
+	for _, condition := range r.Unless {
+		if len(condition.matching(printables)) > 0 {
+			return Diagnosis{}, false
+		}
+	}
+
+	for _, evidence := range r.Evidence {
+		matched := evidence.matching(printables)
+		if len(matched) == 0 {
+			continue
+		}
+		diagnosis.Confidence += evidence.Weight
+		diagnosis.Evidence = appendUnique(diagnosis.Evidence, matched...)
+	}
+	diagnosis.Confidence = math.Max(0, math.Min(1, diagnosis.Confidence))
+
+	for _, cause := range r.Causes {
+		diagnosis.Causes = append(diagnosis.Causes, RankedCause{
+			Cause:      cause,
+			Confidence: diagnosis.Confidence * cause.Likelihood,
+		})
+	}
+	sort.SliceStable(diagnosis.Causes, func(i, j int) bool {
+		return diagnosis.Causes[i].Confidence > diagnosis.Causes[j].Confidence
+	})
+
+	return diagnosis, true
+}
+
+// matching returns the descriptions of the checks whose results match the condition.
+func (c Condition) matching(printables []common.Printable) []string {
+	var matched []string
+	for _, printable := range printables {
+		if c.matches(printable) {
+			matched = append(matched, printable.CheckDescription)
+		}
+	}
+	return matched
+}
+
+func (c Condition) matches(printable common.Printable) bool {
+	if c.Check != "" && c.Check != printable.CheckType {
+		return false
+	}
+	if c.Outcome != "" && c.Outcome != outcomeName(printable.Outcome) {
+		return false
+	}
+	if c.Code != "" && c.Code != string(printable.ErrorCode) {
+		return false
+	}
+	if c.descriptionRegex != nil && !c.descriptionRegex.MatchString(printable.CheckDescription) {
+		return false
+	}
+	if c.messageRegex != nil {
+		message := printable.Diagnostics
+		if printable.Error != nil {
+			message = printable.Error.Error() + "\n" + message
+		}
+		if !c.messageRegex.MatchString(message) {
+			return false
+		}
+	}
+	return true
+}
+
+// outcomeName returns the name used by rule conditions for the outcome.
+func outcomeName(outcome outcomes.Outcome) string {
+	switch outcome.(type) {
+	case outcomes.Pass:
+		return outcomePass
+	case outcomes.Fail:
+		return outcomeFail
+	case outcomes.Warning:
+		return outcomeWarning
+	case outcomes.Info:
+		return outcomeInfo
+	case outcomes.Timeout:
+		return outcomeTimeout
+	case outcomes.Skipped:
+		return outcomeSkipped
+	default:
+		return outcomeUnknown
+	}
+}
+
+func appendUnique(slice []string, values ...string) []string {
+	for _, value := range values {
+		found := false
+		for _, existing := range slice {
+			if existing == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			slice = append(slice, value)
+		}
+	}
+	return slice
+}
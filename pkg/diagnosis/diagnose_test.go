@@ -0,0 +1,149 @@
+package diagnosis
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+func printable(checkType string, description string, outcome outcomes.Outcome) common.Printable {
+	return common.Printable{
+		CheckType:        checkType,
+		CheckDescription: description,
+		Diagnostics:      outcome.GetDiagnostics(),
+		Error:            outcome.GetError(),
+		ErrorCode:        outcome.GetErrorCode(),
+		Outcome:          outcome,
+	}
+}
+
+func TestDiagnoseBuiltInRules(t *testing.T) {
+	dynamicWarming := printable("DynamicWarmingCheck", "Checking whether bookbuyer has dynamic warming issues",
+		outcomes.Fail{Error: errors.New("possible dynamic warming issue")})
+	serviceCert := printable("HasValidEnvoyCertificateCheck", "Checking whether bookbuyer is configured with a service-cert envoy secret",
+		outcomes.Fail{Error: errors.New("no secrets listed in the Envoy config")})
+	injectorLogs := printable("NoBadOsmPodLogsCheck", "Checking whether namespace osm-system has errors or warnings in the logs of osm-injector pods (container: osm-injector)",
+		outcomes.Fail{Error: errors.New("[osm.certificate-invalid] x509: certificate signed by unknown authority")})
+	trafficTargetMissing := printable("TrafficTargetCheck", "Checking whether there is a TrafficTarget",
+		outcomes.Info{Diagnostics: "Pod 'bookbuyer/bookbuyer' is not allowed to communicate to pod 'bookstore/bookstore' via any SMI TrafficTarget policy\n"})
+	trafficTargetMismatch := printable("TrafficTargetCheck", "Checking whether there is a TrafficTarget",
+		outcomes.Info{Diagnostics: trafficTargetMissing.Diagnostics + "TrafficTarget(s) in namespace 'bookstore' reference service accounts 'bookbuyer/bookbuyer', 'bookstore/bookstore', but pod 'bookbuyer/bookbuyer' uses service account 'default'\n"})
+	pass := printable("DynamicWarmingCheck", "Checking whether bookstore has dynamic warming issues", outcomes.Pass{})
+
+	tests := []struct {
+		name               string
+		printables         []common.Printable
+		expectedRuleIDs    []string
+		expectedConfidence float64
+		expectedEvidence   int
+	}{
+		{
+			name:       "no rule applies to passing checks",
+			printables: []common.Printable{pass},
+		},
+		{
+			name:               "dynamic warming alone",
+			printables:         []common.Printable{pass, dynamicWarming},
+			expectedRuleIDs:    []string{"envoy-dynamic-warming-certificate"},
+			expectedConfidence: 0.4,
+			expectedEvidence:   1,
+		},
+		{
+			name:               "dynamic warming with a missing service certificate and injector CA errors",
+			printables:         []common.Printable{dynamicWarming, serviceCert, injectorLogs},
+			expectedRuleIDs:    []string{"envoy-dynamic-warming-certificate"},
+			expectedConfidence: 0.85,
+			expectedEvidence:   3,
+		},
+		{
+			name:               "missing traffic target",
+			printables:         []common.Printable{trafficTargetMissing},
+			expectedRuleIDs:    []string{"smi-traffic-target-missing"},
+			expectedConfidence: 0.7,
+			expectedEvidence:   1,
+		},
+		{
+			name:               "traffic target referencing other service accounts",
+			printables:         []common.Printable{trafficTargetMismatch},
+			expectedRuleIDs:    []string{"smi-traffic-target-service-account-mismatch"},
+			expectedConfidence: 0.7,
+			expectedEvidence:   1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			diagnoses := Diagnose(Rules(), test.printables...)
+
+			var ruleIDs []string
+			for _, d := range diagnoses {
+				ruleIDs = append(ruleIDs, d.RuleID)
+			}
+			assert.Equal(test.expectedRuleIDs, ruleIDs)
+			if len(diagnoses) == 0 {
+				return
+			}
+			assert.InDelta(test.expectedConfidence, diagnoses[0].Confidence, 0.001)
+			assert.Len(diagnoses[0].Evidence, test.expectedEvidence)
+		})
+	}
+}
+
+func TestDiagnoseRanksCauses(t *testing.T) {
+	assert := tassert.New(t)
+	rules, err := ParseRules([]byte(`
+rules:
+  - id: low
+    title: Low confidence
+    confidence: 0.2
+    when: [{outcome: fail}]
+    causes: [{cause: only, likelihood: 1}]
+  - id: high
+    title: High confidence
+    confidence: 0.5
+    when: [{check: FooCheck, outcome: FAIL}]
+    evidence:
+      - message: timeout
+        weight: 0.8
+    causes:
+      - cause: unlikely
+        likelihood: 0.1
+      - cause: likely
+        likelihood: 0.9
+`))
+	assert.Nil(err)
+
+	diagnoses := Diagnose(rules, printable("FooCheck", "foo", outcomes.Fail{Error: errors.New("request timeout")}))
+	assert.Len(diagnoses, 2)
+	assert.Equal("high", diagnoses[0].RuleID)
+	assert.Equal(1.0, diagnoses[0].Confidence)
+	assert.Equal("likely", diagnoses[0].Causes[0].Cause.Cause)
+	assert.InDelta(0.9, diagnoses[0].Causes[0].Confidence, 0.001)
+	assert.Equal("unlikely", diagnoses[0].Causes[1].Cause.Cause)
+	assert.Equal("low", diagnoses[1].RuleID)
+}
+
+func TestDiagnoseMatchesErrorCode(t *testing.T) {
+	assert := tassert.New(t)
+	rules, err := ParseRules([]byte(`
+rules:
+  - id: listener-missing
+    title: Listener missing
+    confidence: 0.5
+    when: [{code: ENVOY_LISTENER_MISSING}]
+    causes: [{cause: only, likelihood: 1}]
+`))
+	assert.Nil(err)
+
+	errListenerMissing := outcomes.NewError("ENVOY_LISTENER_MISSING", "envoy listener missing")
+	diagnoses := Diagnose(rules, printable("EnvoyListenerCheck", "foo", outcomes.Fail{Error: errors.Wrap(errListenerMissing, "pod bookstore/bookstore")}))
+	assert.Len(diagnoses, 1)
+
+	diagnoses = Diagnose(rules, printable("EnvoyListenerCheck", "foo", outcomes.Fail{Error: errors.New("envoy listener missing")}))
+	assert.Empty(diagnoses)
+}
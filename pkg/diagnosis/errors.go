@@ -0,0 +1,11 @@
+package diagnosis
+
+import "errors"
+
+var (
+	// ErrRulesFileInvalid is returned when a diagnosis rules file cannot be read or parsed.
+	ErrRulesFileInvalid = errors.New("invalid diagnosis rules file")
+
+	// ErrRuleInvalid is returned when a diagnosis rule is missing required fields or has a malformed condition.
+	ErrRuleInvalid = errors.New("invalid diagnosis rule")
+)
@@ -0,0 +1,5 @@
+package diagnosis
+
+import "github.com/openservicemesh/osm-health/pkg/logger"
+
+var log = logger.New("diagnosis")
@@ -0,0 +1,158 @@
+package diagnosis
+
+import (
+	"embed"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+//go:embed rules/*.yaml
+var embeddedRules embed.FS
+
+// registeredRules holds the built-in rules followed by the rules loaded from user-supplied files.
+var registeredRules = mustLoadEmbeddedRules()
+
+var validOutcomes = map[string]bool{
+	outcomePass:    true,
+	outcomeFail:    true,
+	outcomeWarning: true,
+	outcomeInfo:    true,
+	outcomeTimeout: true,
+	outcomeSkipped: true,
+	outcomeUnknown: true,
+}
+
+// Rules returns the registered diagnosis rules.
+func Rules() []Rule {
+	return registeredRules
+}
+
+// LoadRulesFile reads a diagnosis rules file and registers its rules.
+// A rule replaces an already registered rule with the same ID.
+func LoadRulesFile(path string) error {
+	content, err := ioutil.ReadFile(path) // #nosec G304 -- the path is supplied by the user running osm-health
+	if err != nil {
+		return errors.Wrapf(ErrRulesFileInvalid, "%s: %s", path, err)
+	}
+
+	rules, err := ParseRules(content)
+	if err != nil {
+		return errors.Wrapf(ErrRulesFileInvalid, "%s: %s", path, err)
+	}
+
+	for _, rule := range rules {
+		registerRule(rule)
+	}
+	log.Debug().Msgf("Loaded %d diagnosis rules from %s", len(rules), path)
+	return nil
+}
+
+// ParseRules parses and validates the rules of a diagnosis rules file.
+func ParseRules(content []byte) ([]Rule, error) {
+	var rulesFile RulesFile
+	if err := yaml.UnmarshalStrict(content, &rulesFile); err != nil {
+		return nil, err
+	}
+
+	for i := range rulesFile.Rules {
+		if err := rulesFile.Rules[i].compile(); err != nil {
+			return nil, err
+		}
+	}
+	return rulesFile.Rules, nil
+}
+
+func registerRule(rule Rule) {
+	for i := range registeredRules {
+		if registeredRules[i].ID == rule.ID {
+			registeredRules[i] = rule
+			return
+		}
+	}
+	registeredRules = append(registeredRules, rule)
+}
+
+func mustLoadEmbeddedRules() []Rule {
+	files, err := embeddedRules.ReadDir("rules")
+	if err != nil {
+		panic(err)
+	}
+
+	var rules []Rule
+	for _, file := range files {
+		content, err := embeddedRules.ReadFile(path.Join("rules", file.Name()))
+		if err != nil {
+			panic(err)
+		}
+		fileRules, err := ParseRules(content)
+		if err != nil {
+			panic(errors.Wrapf(err, "built-in diagnosis rules file %s", file.Name()))
+		}
+		rules = append(rules, fileRules...)
+	}
+	return rules
+}
+
+// compile validates the rule and compiles the regular expressions of its conditions.
+func (r *Rule) compile() error {
+	if r.ID == "" {
+		return errors.Wrap(ErrRuleInvalid, "rule has no id")
+	}
+	if len(r.When) == 0 {
+		return errors.Wrapf(ErrRuleInvalid, "rule %s has no when conditions", r.ID)
+	}
+	if len(r.Causes) == 0 {
+		return errors.Wrapf(ErrRuleInvalid, "rule %s has no causes", r.ID)
+	}
+	if r.Confidence < 0 || r.Confidence > 1 {
+		return errors.Wrapf(ErrRuleInvalid, "rule %s has confidence %v outside of [0, 1]", r.ID, r.Confidence)
+	}
+	for _, cause := range r.Causes {
+		if cause.Likelihood < 0 || cause.Likelihood > 1 {
+			return errors.Wrapf(ErrRuleInvalid, "rule %s has a cause with likelihood %v outside of [0, 1]", r.ID, cause.Likelihood)
+		}
+	}
+
+	for i := range r.When {
+		if err := r.When[i].compile(r.ID); err != nil {
+			return err
+		}
+	}
+	for i := range r.Unless {
+		if err := r.Unless[i].compile(r.ID); err != nil {
+			return err
+		}
+	}
+	for i := range r.Evidence {
+		if err := r.Evidence[i].compile(r.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compile validates the condition and compiles its regular expressions.
+func (c *Condition) compile(ruleID string) error {
+	c.Outcome = strings.ToLower(c.Outcome)
+	if c.Outcome != "" && !validOutcomes[c.Outcome] {
+		return errors.Wrapf(ErrRuleInvalid, "rule %s has a condition with unknown outcome %q", ruleID, c.Outcome)
+	}
+
+	var err error
+	if c.Description != "" {
+		if c.descriptionRegex, err = regexp.Compile(c.Description); err != nil {
+			return errors.Wrapf(ErrRuleInvalid, "rule %s has a condition with invalid description pattern: %s", ruleID, err)
+		}
+	}
+	if c.Message != "" {
+		if c.messageRegex, err = regexp.Compile(c.Message); err != nil {
+			return errors.Wrapf(ErrRuleInvalid, "rule %s has a condition with invalid message pattern: %s", ruleID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package diagnosis
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestParseRules(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expectedErr error
+	}{
+		{
+			name:    "valid rule",
+			content: "rules:\n  - id: foo\n    confidence: 0.5\n    when: [{check: FooCheck}]\n    causes: [{cause: bar, likelihood: 0.5}]\n",
+		},
+		{
+			name:        "rule without when conditions",
+			content:     "rules:\n  - id: foo\n    causes: [{cause: bar, likelihood: 0.5}]\n",
+			expectedErr: ErrRuleInvalid,
+		},
+		{
+			name:        "rule without causes",
+			content:     "rules:\n  - id: foo\n    when: [{check: FooCheck}]\n",
+			expectedErr: ErrRuleInvalid,
+		},
+		{
+			name:        "unknown outcome",
+			content:     "rules:\n  - id: foo\n    when: [{outcome: broken}]\n    causes: [{cause: bar}]\n",
+			expectedErr: ErrRuleInvalid,
+		},
+		{
+			name:        "invalid message pattern",
+			content:     "rules:\n  - id: foo\n    when: [{message: \"(\"}]\n    causes: [{cause: bar}]\n",
+			expectedErr: ErrRuleInvalid,
+		},
+		{
+			name:        "likelihood out of range",
+			content:     "rules:\n  - id: foo\n    when: [{check: FooCheck}]\n    causes: [{cause: bar, likelihood: 2}]\n",
+			expectedErr: ErrRuleInvalid,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			_, err := ParseRules([]byte(test.content))
+			assert.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestBuiltInRules(t *testing.T) {
+	assert := tassert.New(t)
+	ids := make(map[string]bool)
+	for _, rule := range Rules() {
+		assert.False(ids[rule.ID], "duplicate rule id %s", rule.ID)
+		ids[rule.ID] = true
+	}
+	assert.True(ids["envoy-dynamic-warming-certificate"])
+}
+
+func TestLoadRulesFile(t *testing.T) {
+	assert := tassert.New(t)
+	builtIn := registeredRules
+	t.Cleanup(func() { registeredRules = builtIn })
+	registeredRules = append([]Rule(nil), builtIn...)
+
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	assert.Nil(ioutil.WriteFile(path, []byte(`
+rules:
+  - id: smi-traffic-target-missing
+    title: Replaced
+    when: [{check: TrafficTargetCheck}]
+    causes: [{cause: replaced, likelihood: 1}]
+  - id: custom
+    title: Custom
+    when: [{check: CustomCheck}]
+    causes: [{cause: custom, likelihood: 1}]
+`), 0600))
+
+	assert.Nil(LoadRulesFile(path))
+	assert.Len(Rules(), len(builtIn)+1)
+	for _, rule := range Rules() {
+		if rule.ID == "smi-traffic-target-missing" {
+			assert.Equal("Replaced", rule.Title)
+		}
+	}
+
+	assert.ErrorIs(LoadRulesFile(filepath.Join(t.TempDir(), "missing.yaml")), ErrRulesFileInvalid)
+	assert.Len(Rules(), len(builtIn)+1)
+}
@@ -0,0 +1,118 @@
+package diagnosis
+
+import "regexp"
+
+// RulesFile is the format of a file of diagnosis rules.
+//
+// Example:
+//
+//	rules:
+//	  - id: dynamic-warming-after-ca-rotation
+//	    title: Envoy is stuck warming a certificate after the OSM CA changed
+//	    confidence: 0.5
+//	    when:
+//	      - check: DynamicWarmingCheck
+//	        outcome: fail
+//	    evidence:
+//	      - check: NoBadOsmPodLogsCheck
+//	        description: osm-injector
+//	        message: x509
+//	        weight: 0.3
+//	    causes:
+//	      - cause: The OSM CA was rotated but the pod still holds certificates signed by the previous CA
+//	        likelihood: 0.8
+//	        suggestion: Restart the meshed pods so that they are issued certificates by the new CA
+type RulesFile struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Rule matches a pattern across the results of several checks and names the likely root causes of the pattern.
+type Rule struct {
+	// ID uniquely identifies the rule. A rule loaded later replaces a rule with the same ID.
+	ID string `json:"id"`
+
+	// Title is a human-readable summary of the problem the rule diagnoses.
+	Title string `json:"title"`
+
+	// Confidence is the confidence in the diagnosis, between 0 and 1, when only the When conditions match.
+	Confidence float64 `json:"confidence"`
+
+	// When holds the conditions that must all match a check result for the rule to apply.
+	When []Condition `json:"when"`
+
+	// Unless holds conditions that prevent the rule from applying when any of them matches a check result.
+	Unless []Condition `json:"unless,omitempty"`
+
+	// Evidence holds optional conditions that increase the confidence in the diagnosis when they match.
+	Evidence []Evidence `json:"evidence,omitempty"`
+
+	// Causes holds the likely root causes of the problem.
+	Causes []Cause `json:"causes"`
+}
+
+// Condition matches check results. Fields left empty match any check result.
+type Condition struct {
+	// Check is the name of the type of the check, such as DynamicWarmingCheck.
+	Check string `json:"check,omitempty"`
+
+	// Description is a regular expression matching the description of the check.
+	Description string `json:"description,omitempty"`
+
+	// Outcome is the outcome of the check: pass, fail, warning, info, skipped, timeout or unknown.
+	Outcome string `json:"outcome,omitempty"`
+
+	// Code is the error code of a failed check, such as ENVOY_LISTENER_MISSING.
+	Code string `json:"code,omitempty"`
+
+	// Message is a regular expression matching the error or the diagnostics of the check.
+	Message string `json:"message,omitempty"`
+
+	descriptionRegex *regexp.Regexp
+	messageRegex     *regexp.Regexp
+}
+
+// Evidence is a condition that increases the confidence in a diagnosis by Weight when it matches.
+type Evidence struct {
+	Condition `json:",inline"`
+
+	// Weight is added to the confidence of the diagnosis when the evidence matches.
+	Weight float64 `json:"weight"`
+}
+
+// Cause is a likely root cause of the problem diagnosed by a rule.
+type Cause struct {
+	// Cause describes the root cause.
+	Cause string `json:"cause"`
+
+	// Likelihood is how likely the root cause is, between 0 and 1, when the rule applies.
+	Likelihood float64 `json:"likelihood"`
+
+	// Suggestion describes how to fix the root cause.
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// Diagnosis is a rule that applied to the results of a run.
+type Diagnosis struct {
+	// RuleID is the ID of the rule that applied.
+	RuleID string
+
+	// Title is the title of the rule that applied.
+	Title string
+
+	// Confidence is the confidence in the diagnosis, between 0 and 1.
+	Confidence float64
+
+	// Evidence holds the descriptions of the checks whose results matched the rule.
+	Evidence []string
+
+	// Causes holds the likely root causes, ranked by confidence.
+	Causes []RankedCause
+}
+
+// RankedCause is a root cause with its confidence in the context of a diagnosis.
+type RankedCause struct {
+	Cause
+
+	// Confidence is the confidence of the diagnosis multiplied by the likelihood of the cause.
+	Confidence float64
+}
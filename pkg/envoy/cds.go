@@ -1,11 +1,14 @@
 package envoy
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 
@@ -27,7 +30,7 @@ type ClusterCheck struct {
 }
 
 // Run implements common.Runnable
-func (c ClusterCheck) Run() outcomes.Outcome {
+func (c ClusterCheck) Run(ctx context.Context) outcomes.Outcome {
 	if c.ConfigGetter == nil {
 		log.Error().Msg("Incorrectly initialized ConfigGetter")
 		return outcomes.Fail{Error: ErrIncorrectlyInitializedConfigGetter}
@@ -44,7 +47,7 @@ func (c ClusterCheck) Run() outcomes.Outcome {
 	// The destination Pod might back multiple services, so check that at least
 	// one of those services is listed as a cluster in the source Envoy config.
 	possibleClusterNames := map[string]struct{}{}
-	svcs, err := pod.GetMatchingServices(c.k8s, c.dstPod.Labels, c.dstPod.Namespace)
+	svcs, err := pod.GetMatchingServices(ctx, c.k8s, c.dstPod.Labels, c.dstPod.Namespace)
 	if err != nil {
 		return outcomes.Fail{Error: errors.Wrapf(err, "failed to map Pod %s/%s to Kubernetes Services", c.dstPod.Namespace, c.dstPod.Name)}
 	}
@@ -59,6 +62,7 @@ func (c ClusterCheck) Run() outcomes.Outcome {
 
 	found := false
 	var foundClusterNames []string
+	var foundClusters []proto.Message
 	for _, dynCluster := range envoyConfig.Clusters.DynamicActiveClusters {
 		var cluster clusterv3.Cluster
 		err := dynCluster.Cluster.UnmarshalTo(&cluster)
@@ -67,6 +71,7 @@ func (c ClusterCheck) Run() outcomes.Outcome {
 			continue
 		}
 		foundClusterNames = append(foundClusterNames, cluster.Name)
+		foundClusters = append(foundClusters, &cluster)
 
 		// Beginning in OSM version v0.10 onwards, the Envoy cluster name is appended with the port number of the service.
 		// cluster.Name pre v0.9 would look like "bookstore/bookstore-v1"
@@ -86,7 +91,17 @@ func (c ClusterCheck) Run() outcomes.Outcome {
 		for name := range possibleClusterNames {
 			expectedClusterNames = append(expectedClusterNames, name)
 		}
-		return outcomes.Fail{Error: fmt.Errorf("Expected a cluster named one of %v, but only found %v", expectedClusterNames, foundClusterNames)}
+		sort.Strings(expectedClusterNames)
+		return outcomes.Fail{
+			Error: errors.Wrapf(ErrEnvoyClusterMissing, "expected a cluster named one of %v, but only found %v", expectedClusterNames, foundClusterNames),
+			Details: map[string]interface{}{
+				"expectedClusters": expectedClusterNames,
+				"foundClusters":    foundClusterNames,
+			},
+			Snippets: []outcomes.Snippet{
+				newSnippet(fmt.Sprintf("Clusters of %s", c.ConfigGetter.GetObjectName()), foundClusters...),
+			},
+		}
 	}
 	return outcomes.Pass{}
 }
@@ -1,6 +1,7 @@
 package envoy
 
 import (
+	"context"
 	"testing"
 
 	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
@@ -12,6 +13,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
 )
 
 func TestEnvoyClusterChecker(t *testing.T) {
@@ -172,11 +175,16 @@ func TestEnvoyClusterChecker(t *testing.T) {
 			}
 			k8s := fake.NewSimpleClientset(objs...)
 			clusterChecker := NewClusterCheck(k8s, configGetter, test.dstPod)
-			outcome := clusterChecker.Run()
+			outcome := clusterChecker.Run(context.TODO())
 			if test.pass {
 				assert.NoError(outcome.GetError())
 			} else {
-				assert.Error(outcome.GetError())
+				assert.ErrorIs(outcome.GetError(), ErrEnvoyClusterMissing)
+				assert.Equal(outcomes.ErrorCode("ENVOY_CLUSTER_MISSING"), outcome.GetErrorCode())
+				assert.Contains(outcome.GetDetails(), "expectedClusters")
+				fail := outcome.(outcomes.Fail)
+				assert.Len(fail.Snippets, 1)
+				assert.Contains(fail.Snippets[0].Content, "mynamespace/")
 			}
 		})
 	}
@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/openservicemesh/osm-health/pkg/cluster"
 	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
 	"github.com/openservicemesh/osm-health/pkg/osm/version"
 	osmCLI "github.com/openservicemesh/osm/pkg/cli"
@@ -19,6 +20,17 @@ type ConfigGetterStruct struct {
 
 // GetConfig implements ConfigGetter interface.
 func (mcg ConfigGetterStruct) GetConfig() (*Config, error) {
+	namespace := mcg.Pod.Namespace
+	podName := mcg.Pod.Name
+
+	if replay := cluster.ActiveReplay(); replay != nil {
+		configBytes, err := replay.EnvoyConfig(namespace, podName)
+		if err != nil {
+			return nil, err
+		}
+		return ParseEnvoyConfig(configBytes)
+	}
+
 	client, err := pod.GetKubeClient()
 	if err != nil {
 		return nil, err
@@ -29,8 +41,6 @@ func (mcg ConfigGetterStruct) GetConfig() (*Config, error) {
 		return nil, err
 	}
 
-	namespace := mcg.Pod.Namespace
-	podName := mcg.Pod.Name
 	localPort, ok := version.EnvoyAdminPort[mcg.ControllerVersion]
 	if !ok {
 		return nil, errors.Errorf("unable to determine envoy admin port due to unrecognized osm-controller version: %s", mcg.ControllerVersion)
@@ -41,6 +51,10 @@ func (mcg ConfigGetterStruct) GetConfig() (*Config, error) {
 		return nil, err
 	}
 
+	if recorder := cluster.ActiveRecorder(); recorder != nil {
+		recorder.RecordEnvoyConfig(namespace, podName, configBytes)
+	}
+
 	return ParseEnvoyConfig(configBytes)
 }
 
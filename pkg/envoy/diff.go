@@ -0,0 +1,361 @@
+package envoy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/cluster"
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
+	"github.com/openservicemesh/osm-health/pkg/osm/utils"
+	"github.com/openservicemesh/osm-health/pkg/printer"
+)
+
+// ConfigKind is a kind of the Envoy config compared by DiffConfigs.
+type ConfigKind string
+
+const (
+	// ListenersKind is the kind of the names of the listeners.
+	ListenersKind ConfigKind = "listeners"
+
+	// FilterChainsKind is the kind of the names and matches of the filter chains of the listeners.
+	FilterChainsKind ConfigKind = "filter chains"
+
+	// RoutesKind is the kind of the domains and routes of the virtual hosts of the route configs.
+	RoutesKind ConfigKind = "routes"
+
+	// ClustersKind is the kind of the names of the clusters.
+	ClustersKind ConfigKind = "clusters"
+
+	// EndpointsKind is the kind of the addresses of the endpoints of the clusters.
+	EndpointsKind ConfigKind = "endpoints"
+
+	// SecretsKind is the kind of the names of the secrets.
+	SecretsKind ConfigKind = "secrets"
+)
+
+// configKinds holds the kinds of config compared by DiffConfigs, in the order of their differences.
+var configKinds = []ConfigKind{ListenersKind, FilterChainsKind, RoutesKind, ClustersKind, EndpointsKind, SecretsKind}
+
+// ConfigDifference holds the entries of a kind of config that only one of two Envoy configs has.
+type ConfigDifference struct {
+	Kind ConfigKind
+
+	// OnlyA holds the entries of the first config that the second config lacks, or has with a different content.
+	OnlyA []string
+
+	// OnlyB holds the entries of the second config that the first config lacks, or has with a different content.
+	OnlyB []string
+}
+
+// DiffPods prints what the Envoy config of each of two pods has that the Envoy config of the other lacks, such as a
+// replica that is missing the route or endpoints a working replica has.
+func DiffPods(ctx context.Context, podA *corev1.Pod, podB *corev1.Pod, osmControlPlaneNamespace common.MeshNamespace) {
+	nameA := fmt.Sprintf("%s/%s", podA.Namespace, podA.Name)
+	nameB := fmt.Sprintf("%s/%s", podB.Namespace, podB.Name)
+	log.Info().Msgf("Comparing the Envoy configs of %s and %s", nameA, nameB)
+
+	client, err := pod.GetKubeClient()
+	if err != nil {
+		log.Error().Err(err).Msg("Error creating Kubernetes client")
+		return
+	}
+	client = cluster.NewSnapshot(client, nil, nil, nil).KubeClient()
+
+	meshInfo, err := utils.GetMeshInfoForPod(ctx, client, podA.Namespace, osmControlPlaneNamespace)
+	if err != nil {
+		log.Error().Err(err).Msg("Error getting OSM info")
+		return
+	}
+
+	var configs []*Config
+	for _, p := range []*corev1.Pod{podA, podB} {
+		configGetter, err := GetEnvoyConfigGetterForPod(p, meshInfo.OSMVersion)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error creating ConfigGetter for pod %s/%s", p.Namespace, p.Name)
+			return
+		}
+		envoyConfig, err := configGetter.GetConfig()
+		if err != nil {
+			log.Error().Err(err).Msgf("Error getting Envoy config for pod %s", configGetter.GetObjectName())
+			return
+		}
+		configs = append(configs, envoyConfig)
+	}
+
+	differences, err := DiffConfigs(configs[0], configs[1])
+	if err != nil {
+		log.Error().Err(err).Msgf("Error comparing the Envoy configs of %s and %s", nameA, nameB)
+		return
+	}
+	var printables []printer.Difference
+	for _, difference := range differences {
+		printables = append(printables, printer.Difference{
+			Kind:      string(difference.Kind),
+			OnlyLeft:  difference.OnlyA,
+			OnlyRight: difference.OnlyB,
+		})
+	}
+	printer.PrintDifferences(nameA, nameB, printables...)
+}
+
+// DiffConfigs normalizes the listeners, filter chains, routes, clusters, endpoints and secrets of two Envoy configs
+// into comparable entries, such as "outbound-listener: outbound-mesh-http-filter-chain:bookstore/bookstore-v1
+// [port 14001, ips 10.0.77.207/32]" for a filter chain, and returns the entries that only one of the configs has, by
+// kind. Listeners, filter chains, clusters and secrets the configs both have under the same name are also returned
+// when their contents differ, followed by a hash of their content, such as "bookstore/bookstore-v1 (config 5f1c2a9e)".
+// Kinds without differences are left out.
+func DiffConfigs(a *Config, b *Config) ([]ConfigDifference, error) {
+	entriesA, err := normalizeConfig(a)
+	if err != nil {
+		return nil, err
+	}
+	entriesB, err := normalizeConfig(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var differences []ConfigDifference
+	for _, kind := range configKinds {
+		difference := ConfigDifference{
+			Kind:  kind,
+			OnlyA: missingFrom(entriesA[kind], entriesB[kind]),
+			OnlyB: missingFrom(entriesB[kind], entriesA[kind]),
+		}
+		if len(difference.OnlyA) > 0 || len(difference.OnlyB) > 0 {
+			differences = append(differences, difference)
+		}
+	}
+	return differences, nil
+}
+
+// missingFrom returns the sorted entries that are not in other, and the entries other has with a different content
+// followed by their content.
+func missingFrom(entries map[string]string, other map[string]string) []string {
+	var missing []string
+	for entry, content := range entries {
+		otherContent, ok := other[entry]
+		switch {
+		case !ok:
+			missing = append(missing, entry)
+		case content != otherContent:
+			missing = append(missing, fmt.Sprintf("%s (%s)", entry, content))
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// normalizeConfig returns the entries of an Envoy config by kind, mapped to a description of their content. Entries
+// of the kinds whose entries already hold all that is compared, routes and endpoints, have no content.
+func normalizeConfig(config *Config) (map[ConfigKind]map[string]string, error) {
+	entries := make(map[ConfigKind]map[string]string)
+	add := func(kind ConfigKind, entry string, content string) {
+		if entries[kind] == nil {
+			entries[kind] = make(map[string]string)
+		}
+		entries[kind][entry] = content
+	}
+
+	listeners, err := getListeners(config)
+	if err != nil {
+		return nil, err
+	}
+	for _, listener := range listeners {
+		// Filter chains are compared on their own, so that the listener only differs by the rest of its config.
+		withoutFilterChains := proto.Clone(listener).(*envoy_config_listener_v3.Listener)
+		withoutFilterChains.FilterChains = nil
+		content, err := contentHash(withoutFilterChains)
+		if err != nil {
+			return nil, err
+		}
+		add(ListenersKind, listener.GetName(), content)
+		for idx, filterChain := range listener.GetFilterChains() {
+			name := filterChain.GetName()
+			if name == "" {
+				name = fmt.Sprintf("#%d", idx)
+			}
+			if match := formatFilterChainMatch(filterChain.GetFilterChainMatch()); match != "" {
+				name = fmt.Sprintf("%s [%s]", name, match)
+			}
+			content, err := contentHash(filterChain)
+			if err != nil {
+				return nil, err
+			}
+			add(FilterChainsKind, fmt.Sprintf("%s: %s", listener.GetName(), name), content)
+		}
+	}
+
+	for _, rawRouteConfig := range config.Routes.GetDynamicRouteConfigs() {
+		var routeConfig envoy_config_route_v3.RouteConfiguration
+		if err := rawRouteConfig.GetRouteConfig().UnmarshalTo(&routeConfig); err != nil {
+			return nil, ErrUnmarshalingDynamicRouteConfig
+		}
+		for _, virtualHost := range routeConfig.GetVirtualHosts() {
+			prefix := fmt.Sprintf("%s/%s", routeConfig.GetName(), virtualHost.GetName())
+			for _, domain := range virtualHost.GetDomains() {
+				add(RoutesKind, fmt.Sprintf("%s: domain %s", prefix, domain), "")
+			}
+			for _, route := range virtualHost.GetRoutes() {
+				add(RoutesKind, fmt.Sprintf("%s: %s", prefix, formatRoute(route)), "")
+			}
+		}
+	}
+
+	var clusters []*anypb.Any
+	for _, static := range config.Clusters.GetStaticClusters() {
+		clusters = append(clusters, static.GetCluster())
+	}
+	for _, dynamic := range config.Clusters.GetDynamicActiveClusters() {
+		clusters = append(clusters, dynamic.GetCluster())
+	}
+	for _, rawCluster := range clusters {
+		var cluster clusterv3.Cluster
+		if err := rawCluster.UnmarshalTo(&cluster); err != nil {
+			return nil, ErrUnmarshalingCluster
+		}
+		content, err := contentHash(&cluster)
+		if err != nil {
+			return nil, err
+		}
+		add(ClustersKind, cluster.GetName(), content)
+	}
+
+	for _, dynamic := range config.Endpoints.GetDynamicEndpointConfigs() {
+		var cla envoy_config_endpoint_v3.ClusterLoadAssignment
+		if err := dynamic.GetEndpointConfig().UnmarshalTo(&cla); err != nil {
+			return nil, ErrUnmarshalingClusterLoadAssigment
+		}
+		for _, localityEndpoints := range cla.GetEndpoints() {
+			for _, lbEndpoint := range localityEndpoints.GetLbEndpoints() {
+				address := lbEndpoint.GetEndpoint().GetAddress().GetSocketAddress()
+				endpoint := fmt.Sprintf("%s:%d", address.GetAddress(), address.GetPortValue())
+				// Envoy leaves out the cluster name of the endpoints of some clusters from its config dump
+				if cla.GetClusterName() != "" {
+					endpoint = fmt.Sprintf("%s: %s", cla.GetClusterName(), endpoint)
+				}
+				add(EndpointsKind, endpoint, "")
+			}
+		}
+	}
+
+	// Secrets are compared by their content only: replicas receive their secrets at different times and versions.
+	for _, static := range config.SecretsConfigDump.GetStaticSecrets() {
+		content, err := contentHash(static.GetSecret())
+		if err != nil {
+			return nil, err
+		}
+		add(SecretsKind, static.GetName(), content)
+	}
+	for _, dynamic := range config.SecretsConfigDump.GetDynamicActiveSecrets() {
+		content, err := contentHash(dynamic.GetSecret())
+		if err != nil {
+			return nil, err
+		}
+		add(SecretsKind, dynamic.GetName(), content)
+	}
+
+	return entries, nil
+}
+
+// contentHash describes the content of a piece of config by a short hash, such as "config 5f1c2a9e".
+func contentHash(message proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return "config " + hex.EncodeToString(sum[:4]), nil
+}
+
+// formatFilterChainMatch formats the criteria a filter chain is selected by, such as "port 14001, ips 10.0.77.207/32".
+func formatFilterChainMatch(match *envoy_config_listener_v3.FilterChainMatch) string {
+	var parts []string
+	if port := match.GetDestinationPort(); port != nil {
+		parts = append(parts, fmt.Sprintf("port %d", port.GetValue()))
+	}
+	var prefixRanges []string
+	for _, prefixRange := range match.GetPrefixRanges() {
+		prefixRanges = append(prefixRanges, fmt.Sprintf("%s/%d", prefixRange.GetAddressPrefix(), prefixRange.GetPrefixLen().GetValue()))
+	}
+	if len(prefixRanges) > 0 {
+		sort.Strings(prefixRanges)
+		parts = append(parts, "ips "+strings.Join(prefixRanges, ","))
+	}
+	if serverNames := match.GetServerNames(); len(serverNames) > 0 {
+		sorted := append([]string(nil), serverNames...)
+		sort.Strings(sorted)
+		parts = append(parts, "sni "+strings.Join(sorted, ","))
+	}
+	if transportProtocol := match.GetTransportProtocol(); transportProtocol != "" {
+		parts = append(parts, "transport "+transportProtocol)
+	}
+	if applicationProtocols := match.GetApplicationProtocols(); len(applicationProtocols) > 0 {
+		sorted := append([]string(nil), applicationProtocols...)
+		sort.Strings(sorted)
+		parts = append(parts, "alpn "+strings.Join(sorted, ","))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatRoute formats the match of a route and the clusters it forwards to, such as
+// "prefix /books header :method=GET -> [bookstore/bookstore-v1|14001=1]".
+func formatRoute(route *envoy_config_route_v3.Route) string {
+	match := route.GetMatch()
+	var parts []string
+	switch specifier := match.GetPathSpecifier().(type) {
+	case *envoy_config_route_v3.RouteMatch_Prefix:
+		parts = append(parts, "prefix "+specifier.Prefix)
+	case *envoy_config_route_v3.RouteMatch_Path:
+		parts = append(parts, "path "+specifier.Path)
+	case *envoy_config_route_v3.RouteMatch_SafeRegex:
+		parts = append(parts, "regex "+specifier.SafeRegex.GetRegex())
+	}
+	// All the headers of a route must match, so their order does not matter
+	var headers []string
+	for _, header := range match.GetHeaders() {
+		headers = append(headers, fmt.Sprintf("header %s", formatHeaderMatcher(header)))
+	}
+	sort.Strings(headers)
+	parts = append(parts, headers...)
+
+	target := "no cluster"
+	if routeAction := route.GetRoute(); routeAction != nil {
+		target = formatWeights(getPositiveClusterWeights(routeAction))
+	}
+	return fmt.Sprintf("%s -> %s", strings.Join(parts, " "), target)
+}
+
+// formatHeaderMatcher formats a header matcher of a route, such as ":method=GET" or "x-version~v1.*".
+func formatHeaderMatcher(header *envoy_config_route_v3.HeaderMatcher) string {
+	name := header.GetName()
+	if header.GetInvertMatch() {
+		name = "!" + name
+	}
+	switch specifier := header.GetHeaderMatchSpecifier().(type) {
+	case *envoy_config_route_v3.HeaderMatcher_ExactMatch:
+		return fmt.Sprintf("%s=%s", name, specifier.ExactMatch)
+	case *envoy_config_route_v3.HeaderMatcher_SafeRegexMatch:
+		return fmt.Sprintf("%s~%s", name, specifier.SafeRegexMatch.GetRegex())
+	case *envoy_config_route_v3.HeaderMatcher_PrefixMatch:
+		return fmt.Sprintf("%s^=%s", name, specifier.PrefixMatch)
+	case *envoy_config_route_v3.HeaderMatcher_SuffixMatch:
+		return fmt.Sprintf("%s$=%s", name, specifier.SuffixMatch)
+	case *envoy_config_route_v3.HeaderMatcher_ContainsMatch:
+		return fmt.Sprintf("%s*=%s", name, specifier.ContainsMatch)
+	default:
+		return name
+	}
+}
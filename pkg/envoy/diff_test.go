@@ -0,0 +1,190 @@
+package envoy
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	tassert "github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestDiffConfigs(t *testing.T) {
+	assert := tassert.New(t)
+	bookstoreFile := "../../tests/sample-envoy-config-dump-bookstore.json"
+
+	working, err := createConfigGetterFunc(bookstoreFile)()
+	assert.Nil(err)
+	replica, err := createConfigGetterFunc(bookstoreFile)()
+	assert.Nil(err)
+
+	differences, err := DiffConfigs(working, replica)
+	assert.Nil(err)
+	assert.Empty(differences)
+
+	// The replica has not received the bookwarehouse cluster and the endpoints
+	var clusters []*adminv3.ClustersConfigDump_DynamicCluster
+	for _, dynamicCluster := range replica.Clusters.DynamicActiveClusters {
+		var cluster clusterv3.Cluster
+		assert.Nil(dynamicCluster.GetCluster().UnmarshalTo(&cluster))
+		if cluster.GetName() != "bookwarehouse/bookwarehouse" {
+			clusters = append(clusters, dynamicCluster)
+		}
+	}
+	replica.Clusters.DynamicActiveClusters = clusters
+	replica.Endpoints.DynamicEndpointConfigs = nil
+
+	differences, err = DiffConfigs(working, replica)
+	assert.Nil(err)
+	assert.Equal([]ConfigDifference{
+		{
+			Kind:  ClustersKind,
+			OnlyA: []string{"bookwarehouse/bookwarehouse"},
+		},
+		{
+			Kind:  EndpointsKind,
+			OnlyA: []string{"10.244.2.5:14001", "bookstore/bookstore-v1-local: 127.0.0.1:14001", "envoy-metrics-cluster: 127.0.0.1:15000"},
+		},
+	}, differences)
+
+	bookbuyer, err := createConfigGetterFunc("../../tests/sample-envoy-config-dump-bookbuyer.json")()
+	assert.Nil(err)
+	differences, err = DiffConfigs(bookbuyer, working)
+	assert.Nil(err)
+	assert.Len(differences, 6)
+	assert.Equal(ListenersKind, differences[0].Kind)
+	assert.Empty(differences[0].OnlyA)
+	assert.Contains(differences[0].OnlyB, "inbound-listener")
+	assert.Equal(FilterChainsKind, differences[1].Kind)
+	assert.Equal([]string{"outbound-listener: outbound-mesh-http-filter-chain:bookstore/bookstore [port 14001, ips 10.0.77.207/32]"}, differences[1].OnlyA)
+	assert.Equal(RoutesKind, differences[2].Kind)
+	assert.Contains(differences[2].OnlyA, "rds-outbound/outbound_virtual-host|bookstore.bookstore: regex .* header :method~.* -> [bookstore/bookstore=100]")
+	assert.Equal(SecretsKind, differences[5].Kind)
+	assert.Contains(differences[5].OnlyB, "service-cert:bookstore/bookstore-v1")
+}
+
+func TestDiffConfigsComparesContentOfSameNamedEntries(t *testing.T) {
+	assert := tassert.New(t)
+	bookstoreFile := "../../tests/sample-envoy-config-dump-bookstore.json"
+
+	working, err := createConfigGetterFunc(bookstoreFile)()
+	assert.Nil(err)
+	replica, err := createConfigGetterFunc(bookstoreFile)()
+	assert.Nil(err)
+
+	// The replica has a stale service certificate
+	assert.NotEmpty(replica.SecretsConfigDump.DynamicActiveSecrets)
+	staleSecret := replica.SecretsConfigDump.DynamicActiveSecrets[0]
+	var secret tlsv3.Secret
+	assert.Nil(staleSecret.GetSecret().UnmarshalTo(&secret))
+	secret.Type = &tlsv3.Secret_TlsCertificate{TlsCertificate: &tlsv3.TlsCertificate{
+		CertificateChain: &corev3.DataSource{Specifier: &corev3.DataSource_InlineBytes{InlineBytes: []byte("stale certificate")}},
+	}}
+	rawSecret, err := anypb.New(&secret)
+	assert.Nil(err)
+	staleSecret.Secret = rawSecret
+
+	// The replica has a different connect timeout for the bookwarehouse cluster
+	for _, dynamicCluster := range replica.Clusters.DynamicActiveClusters {
+		var cluster clusterv3.Cluster
+		assert.Nil(dynamicCluster.GetCluster().UnmarshalTo(&cluster))
+		if cluster.GetName() != "bookwarehouse/bookwarehouse" {
+			continue
+		}
+		cluster.ConnectTimeout = durationpb.New(time.Minute)
+		rawCluster, err := anypb.New(&cluster)
+		assert.Nil(err)
+		dynamicCluster.Cluster = rawCluster
+	}
+
+	// The replica selects its inbound filter chain by another port
+	for _, dynamicListener := range replica.Listeners.DynamicListeners {
+		var listener listenerv3.Listener
+		assert.Nil(dynamicListener.GetActiveState().GetListener().UnmarshalTo(&listener))
+		if listener.GetName() != "inbound-listener" {
+			continue
+		}
+		listener.FilterChains[0].FilterChainMatch.DestinationPort = wrapperspb.UInt32(8080)
+		rawListener, err := anypb.New(&listener)
+		assert.Nil(err)
+		dynamicListener.ActiveState.Listener = rawListener
+	}
+
+	differences, err := DiffConfigs(working, replica)
+	assert.Nil(err)
+	assert.Len(differences, 3)
+
+	assert.Equal(FilterChainsKind, differences[0].Kind)
+	assert.Len(differences[0].OnlyA, 1)
+	assert.Contains(differences[0].OnlyA[0], "port 14001")
+	assert.Len(differences[0].OnlyB, 1)
+	assert.Contains(differences[0].OnlyB[0], "port 8080")
+
+	assert.Equal(ClustersKind, differences[1].Kind)
+	assert.Len(differences[1].OnlyA, 1)
+	assert.Regexp(`^bookwarehouse/bookwarehouse \(config [0-9a-f]{8}\)$`, differences[1].OnlyA[0])
+	assert.Len(differences[1].OnlyB, 1)
+	assert.NotEqual(differences[1].OnlyA, differences[1].OnlyB)
+
+	assert.Equal(SecretsKind, differences[2].Kind)
+	assert.Len(differences[2].OnlyB, 1)
+	assert.Regexp(`^`+regexp.QuoteMeta(staleSecret.GetName())+` \(config [0-9a-f]{8}\)$`, differences[2].OnlyB[0])
+	assert.Len(differences[2].OnlyA, 1)
+	assert.NotEqual(differences[2].OnlyA, differences[2].OnlyB)
+}
+
+func TestDiffConfigsIgnoresSecretVersionsAndUpdateTimes(t *testing.T) {
+	assert := tassert.New(t)
+	bookstoreFile := "../../tests/sample-envoy-config-dump-bookstore.json"
+
+	working, err := createConfigGetterFunc(bookstoreFile)()
+	assert.Nil(err)
+	replica, err := createConfigGetterFunc(bookstoreFile)()
+	assert.Nil(err)
+
+	// The replica received the same secrets at another time and version
+	assert.NotEmpty(replica.SecretsConfigDump.DynamicActiveSecrets)
+	for _, dynamicSecret := range replica.SecretsConfigDump.DynamicActiveSecrets {
+		dynamicSecret.VersionInfo = "replica"
+		dynamicSecret.LastUpdated = timestamppb.New(time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC))
+	}
+	for _, staticSecret := range replica.SecretsConfigDump.StaticSecrets {
+		staticSecret.LastUpdated = timestamppb.New(time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC))
+	}
+
+	differences, err := DiffConfigs(working, replica)
+	assert.Nil(err)
+	assert.Empty(differences)
+}
+
+func TestFormatRoute(t *testing.T) {
+	assert := tassert.New(t)
+
+	route := &routev3.Route{
+		Match: &routev3.RouteMatch{
+			PathSpecifier: &routev3.RouteMatch_Prefix{Prefix: "/books"},
+			Headers: []*routev3.HeaderMatcher{
+				{Name: "x-version", HeaderMatchSpecifier: &routev3.HeaderMatcher_PrefixMatch{PrefixMatch: "v1"}},
+				{Name: ":method", HeaderMatchSpecifier: &routev3.HeaderMatcher_ExactMatch{ExactMatch: "GET"}},
+			},
+		},
+		Action: &routev3.Route_Route{
+			Route: &routev3.RouteAction{
+				ClusterSpecifier: &routev3.RouteAction_Cluster{Cluster: "bookstore/bookstore-v1"},
+			},
+		},
+	}
+	assert.Equal("prefix /books header :method=GET header x-version^=v1 -> [bookstore/bookstore-v1=1]", formatRoute(route))
+
+	route.Action = &routev3.Route_DirectResponse{}
+	assert.Equal("prefix /books header :method=GET header x-version^=v1 -> no cluster", formatRoute(route))
+}
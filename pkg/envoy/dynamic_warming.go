@@ -1,6 +1,7 @@
 package envoy
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
@@ -16,7 +17,7 @@ type DynamicWarmingCheck struct {
 }
 
 // Run implements common.Runnable
-func (l DynamicWarmingCheck) Run() outcomes.Outcome {
+func (l DynamicWarmingCheck) Run(ctx context.Context) outcomes.Outcome {
 	if l.ConfigGetter == nil {
 		log.Error().Msg("Incorrectly initialized ConfigGetter")
 		return outcomes.Fail{Error: ErrIncorrectlyInitializedConfigGetter}
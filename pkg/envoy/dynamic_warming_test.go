@@ -1,6 +1,7 @@
 package envoy
 
 import (
+	"context"
 	"testing"
 
 	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
@@ -67,7 +68,7 @@ func TestDynamicWarmingCheck(t *testing.T) {
 				},
 			}
 			dynamicWarmingChecker := NewDynamicWarmingCheck(configGetter)
-			outcome := dynamicWarmingChecker.Run()
+			outcome := dynamicWarmingChecker.Run(context.TODO())
 			if test.expectedErr == nil {
 				assert.Nil(outcome.GetError())
 			} else {
@@ -1,6 +1,7 @@
 package envoy
 
 import (
+	"context"
 	"fmt"
 
 	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
@@ -20,7 +21,7 @@ type DestinationEndpointCheck struct {
 }
 
 // Run implements common.Runnable
-func (l DestinationEndpointCheck) Run() outcomes.Outcome {
+func (l DestinationEndpointCheck) Run(ctx context.Context) outcomes.Outcome {
 	if l.ConfigGetter == nil {
 		log.Error().Msg("Incorrectly initialized ConfigGetter")
 		return outcomes.Fail{Error: ErrIncorrectlyInitializedConfigGetter}
@@ -1,6 +1,7 @@
 package envoy
 
 import (
+	"context"
 	"testing"
 
 	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
@@ -88,7 +89,7 @@ func TestHasDestinationEndpoints(t *testing.T) {
 				},
 			}
 			clusterChecker := NewDestinationEndpointCheck(configGetter)
-			outcome := clusterChecker.Run()
+			outcome := clusterChecker.Run(context.TODO())
 			if test.pass {
 				assert.NoError(outcome.GetError())
 			} else {
@@ -351,7 +352,7 @@ func TestHasSpecificEndpoint(t *testing.T) {
 				},
 			}
 			clusterChecker := NewSpecificEndpointCheck(configGetter, test.pod)
-			outcome := clusterChecker.Run()
+			outcome := clusterChecker.Run(context.TODO())
 			if test.pass {
 				assert.NoError(outcome.GetError())
 			} else {
@@ -1,6 +1,7 @@
 package envoy
 
 import (
+	"context"
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
 	"github.com/openservicemesh/osm-health/pkg/kubernetes/podhelper"
+	"github.com/openservicemesh/osm-health/pkg/logs"
 	"github.com/openservicemesh/osm-health/pkg/runner"
 )
 
@@ -16,26 +18,28 @@ var _ runner.Runnable = (*BadLogsCheck)(nil)
 
 // BadLogsCheck implements common.Runnable
 type BadLogsCheck struct {
-	client kubernetes.Interface
-	pod    *corev1.Pod
+	client     kubernetes.Interface
+	pod        *corev1.Pod
+	logOptions logs.Options
 }
 
-// NewBadLogsCheck creates an BadLogsCheck which checks whether the envoy container of the pod has bad (fatal/error/warning/fail) log messages
-func NewBadLogsCheck(client kubernetes.Interface, pod *corev1.Pod) BadLogsCheck {
+// NewBadLogsCheck creates an BadLogsCheck which checks whether the envoy container of the pod has log messages which indicate problems
+func NewBadLogsCheck(client kubernetes.Interface, pod *corev1.Pod, logOptions logs.Options) BadLogsCheck {
 	return BadLogsCheck{
-		client: client,
-		pod:    pod,
+		client:     client,
+		pod:        pod,
+		logOptions: logOptions,
 	}
 }
 
 // Description implements common.Runnable
 func (check BadLogsCheck) Description() string {
-	return fmt.Sprintf("Checking whether pod %s has bad (fatal/error/warning/fail) logs in envoy container", check.pod.Name)
+	return fmt.Sprintf("Checking whether pod %s has errors or warnings in envoy container logs", check.pod.Name)
 }
 
 // Run implements common.Runnable
-func (check BadLogsCheck) Run() outcomes.Outcome {
-	return podhelper.HasNoBadLogs(check.client, check.pod, "envoy")
+func (check BadLogsCheck) Run(ctx context.Context) outcomes.Outcome {
+	return podhelper.HasNoBadLogs(ctx, check.client, check.pod, "envoy", logs.FormatEnvoy, check.logOptions)
 }
 
 // Suggestion implements common.Runnable.
@@ -1,47 +1,65 @@
 package envoy
 
-import "errors"
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
 
 var (
 	// ErrEnvoyListenerMissing is an error returned when an Envoy does not have a required listener.
-	ErrEnvoyListenerMissing = errors.New("envoy listener missing")
+	ErrEnvoyListenerMissing = outcomes.NewError("ENVOY_LISTENER_MISSING", "envoy listener missing")
 
 	// ErrEnvoyFilterChainMissing is an error returned when an Envoy does not have a required filter chain.
-	ErrEnvoyFilterChainMissing = errors.New("envoy listener filter chain missing")
+	ErrEnvoyFilterChainMissing = outcomes.NewError("ENVOY_FILTER_CHAIN_MISSING", "envoy listener filter chain missing")
 
 	// ErrEnvoyActiveStateListenerMissing is an error returned when an Envoy does not have a required active state listener.
-	ErrEnvoyActiveStateListenerMissing = errors.New("envoy active state listener missing")
+	ErrEnvoyActiveStateListenerMissing = outcomes.NewError("ENVOY_ACTIVE_STATE_LISTENER_MISSING", "envoy active state listener missing")
+
+	// ErrEnvoyClusterMissing is an error returned when an Envoy does not have a cluster for any service of a destination pod.
+	ErrEnvoyClusterMissing = outcomes.NewError("ENVOY_CLUSTER_MISSING", "envoy cluster missing")
 
 	// ErrEnvoyConfigEmpty is an error returned when an Envoy config is completely missing.
-	ErrEnvoyConfigEmpty = errors.New("envoy config is empty")
+	ErrEnvoyConfigEmpty = outcomes.NewError("ENVOY_CONFIG_EMPTY", "envoy config is empty")
 
 	// ErrOSMControllerVersionUnrecognized is an error returned when the supplied OSM Controller version is not recognized.
-	ErrOSMControllerVersionUnrecognized = errors.New("osm controller version not recognized")
+	ErrOSMControllerVersionUnrecognized = outcomes.NewError("OSM_CONTROLLER_VERSION_UNRECOGNIZED", "osm controller version not recognized")
 
 	// ErrIncorrectlyInitializedConfigGetter is an error returned when the ConfigGetter struct is not correctly initialized.
-	ErrIncorrectlyInitializedConfigGetter = errors.New("incorrectly initialized config getter")
+	ErrIncorrectlyInitializedConfigGetter = outcomes.NewError("INCORRECTLY_INITIALIZED_CONFIG_GETTER", "incorrectly initialized config getter")
 
 	// ErrNoDestinationEndpoints is an error returned when an Envoy has no destination endpoints.
-	ErrNoDestinationEndpoints = errors.New("no destination endpoints")
+	ErrNoDestinationEndpoints = outcomes.NewError("NO_DESTINATION_ENDPOINTS", "no destination endpoints")
 
 	// ErrUnmarshalingClusterLoadAssigment is an error returned when the unmarshaling of the Envoy ClusterLoadAssignment struct fails.
-	ErrUnmarshalingClusterLoadAssigment = errors.New("error unmarshaling envoy cluster load assigment")
+	ErrUnmarshalingClusterLoadAssigment = outcomes.NewError("UNMARSHALING_CLUSTER_LOAD_ASSIGNMENT", "error unmarshaling envoy cluster load assigment")
+
+	// ErrUnmarshalingCluster is an error returned when the unmarshaling of the Envoy Cluster struct fails.
+	ErrUnmarshalingCluster = outcomes.NewError("UNMARSHALING_CLUSTER", "error unmarshaling envoy cluster")
 
 	// ErrUnmarshalingListener is an error returned when the unmarshaling of the Envoy Listener struct fails.
-	ErrUnmarshalingListener = errors.New("error unmarshaling envoy listener")
+	ErrUnmarshalingListener = outcomes.NewError("UNMARSHALING_LISTENER", "error unmarshaling envoy listener")
 
 	// ErrEndpointNotFound is an error returned when a specific endpoint is not found in Envoy EDS config.
-	ErrEndpointNotFound = errors.New("endpoint not found")
+	ErrEndpointNotFound = outcomes.NewError("ENDPOINT_NOT_FOUND", "endpoint not found")
 
 	// ErrUnmarshalingDynamicRouteConfig is an error returned when the unmarshaling of the dynamic RouteConfiguration struct fails.
-	ErrUnmarshalingDynamicRouteConfig = errors.New("error unmarshaling dynamic route configuration")
+	ErrUnmarshalingDynamicRouteConfig = outcomes.NewError("UNMARSHALING_DYNAMIC_ROUTE_CONFIG", "error unmarshaling dynamic route configuration")
 
 	// ErrNoDynamicRouteConfigDomains is an error returned when an Envoy has no dynamic route config domains.
-	ErrNoDynamicRouteConfigDomains = errors.New("no dynamic route config domains")
+	ErrNoDynamicRouteConfigDomains = outcomes.NewError("NO_DYNAMIC_ROUTE_CONFIG_DOMAINS", "no dynamic route config domains")
 
 	// ErrDynamicRouteConfigDomainNotFound is an error returned when a specific dynamic route config domain is not found.
-	ErrDynamicRouteConfigDomainNotFound = errors.New("dynamic route config domain not found")
+	ErrDynamicRouteConfigDomainNotFound = outcomes.NewError("DYNAMIC_ROUTE_CONFIG_DOMAIN_NOT_FOUND", "dynamic route config domain not found")
+
+	// ErrHTTPRouteMatchNotProgrammed is an error returned when an SMI HTTPRouteGroup match is not programmed as an Envoy route.
+	ErrHTTPRouteMatchNotProgrammed = outcomes.NewError("HTTP_ROUTE_MATCH_NOT_PROGRAMMED", "HTTPRouteGroup match not programmed in envoy routes")
+
+	// ErrWeightedClustersMismatch is an error returned when the weighted clusters of an Envoy route do not match the weights of an SMI TrafficSplit.
+	ErrWeightedClustersMismatch = outcomes.NewError("WEIGHTED_CLUSTERS_MISMATCH", "envoy route weighted clusters do not match TrafficSplit weights")
+
+	// ErrEnvoyServiceCertificateStale is an error returned when an Envoy has not renewed its service certificate within the certificate validity duration.
+	ErrEnvoyServiceCertificateStale = outcomes.NewError("ENVOY_SERVICE_CERTIFICATE_STALE", "envoy service certificate not renewed within its validity duration")
+
+	// ErrEnvoyProbeListenerMismatch is an error returned when an Envoy has no listener on the port of a probe rewritten to go through it.
+	ErrEnvoyProbeListenerMismatch = outcomes.NewError("ENVOY_PROBE_LISTENER_MISMATCH", "rewritten probe port does not match an envoy listener")
 
 	// ErrDynamicWarmingSecretsConfigDumpNotEmpty is an error returned when the pod's envoy is possibly experiencing dynamic warming issues.
-	ErrDynamicWarmingSecretsConfigDumpNotEmpty = errors.New("possible dynamic warming issue due to non-empty dynamic warming secrets in envoy's secrets config dump")
+	ErrDynamicWarmingSecretsConfigDumpNotEmpty = outcomes.NewError("DYNAMIC_WARMING_SECRETS_CONFIG_DUMP_NOT_EMPTY", "possible dynamic warming issue due to non-empty dynamic warming secrets in envoy's secrets config dump")
 )
@@ -8,19 +8,18 @@ import (
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	"github.com/pkg/errors"
 	smiAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned"
+	"google.golang.org/protobuf/types/known/anypb"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
 	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
 	"github.com/openservicemesh/osm-health/pkg/osm/version"
 	"github.com/openservicemesh/osm-health/pkg/runner"
 	"github.com/openservicemesh/osm-health/pkg/smi"
-	"github.com/openservicemesh/osm-health/pkg/smi/access/v1alpha2"
-	"github.com/openservicemesh/osm-health/pkg/smi/access/v1alpha3"
+	"github.com/openservicemesh/osm-health/pkg/smi/access"
 	"github.com/openservicemesh/osm-health/pkg/utils"
-	"github.com/openservicemesh/osm/pkg/configurator"
 )
 
 // Verify interface compliance
@@ -40,13 +39,13 @@ type ListenerCheck struct {
 
 // ListenerFilterCheck implements common.Runnable
 type ListenerFilterCheck struct {
-	srcConfigGetter ConfigGetter
-	dstConfigGetter ConfigGetter
-	osmVersion      version.ControllerVersion
-	cfg             configurator.Configurator
-	srcPod          *corev1.Pod
-	dstPod          *corev1.Pod
-	accessClient    smiAccessClient.Interface
+	srcConfigGetter  ConfigGetter
+	dstConfigGetter  ConfigGetter
+	osmVersion       version.ControllerVersion
+	meshConfigGetter config.MeshConfigGetter
+	srcPod           *corev1.Pod
+	dstPod           *corev1.Pod
+	accessClient     smiAccessClient.Interface
 	// This is used for Info() function from Runnable. Helps the logs identify what kind of a listener we are looking for.
 	listenerType string
 	k8s          kubernetes.Interface
@@ -70,7 +69,7 @@ const (
 )
 
 // Run implements common.Runnable
-func (l ListenerCheck) Run() outcomes.Outcome {
+func (l ListenerCheck) Run(ctx context.Context) outcomes.Outcome {
 	if l.ConfigGetter == nil {
 		log.Error().Msg("Incorrectly initialized ConfigGetter")
 		return outcomes.Fail{Error: ErrIncorrectlyInitializedConfigGetter}
@@ -149,23 +148,21 @@ func NewInboundListenerCheck(configGetter ConfigGetter, osmVersion version.Contr
 }
 
 // Run implements common.Runnable
-func (l ListenerFilterCheck) Run() outcomes.Outcome {
+func (l ListenerFilterCheck) Run(ctx context.Context) outcomes.Outcome {
+	meshConfig, err := l.meshConfigGetter.GetMeshConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
 	// Check if permissive mode is enabled, in which case every meshed pod is allowed to communicate with each other
-	if l.cfg.IsPermissiveTrafficPolicyMode() {
-		return outcomes.Info{Diagnostics: "OSM is in permissive traffic policy modes -- all meshed pods can communicate and SMI access policies are not applicable"}
+	if meshConfig.Spec.Traffic.EnablePermissiveTrafficPolicyMode {
+		return outcomes.Skipped{Reason: "OSM is in permissive traffic policy modes -- all meshed pods can communicate and SMI access policies are not applicable"}
 	}
 
 	// Get rule version from TrafficTarget. The rules will be used to determine what filter chains are expected in the src and dst Envoy configs
 	var ruleTypes map[string]struct{}
-	var err error
-	switch version.SupportedTrafficTarget[l.osmVersion] {
-	case version.V1Alpha2:
-		ruleTypes, err = getRuleTypesFromMatchingTrafficTargetsV1alpha2(l.srcPod, l.dstPod, l.accessClient)
-	case version.V1Alpha3:
-		ruleTypes, err = getRuleTypesFromMatchingTrafficTargetsV1alpha3(l.srcPod, l.dstPod, l.accessClient)
-	default:
-		return outcomes.Fail{Error: fmt.Errorf(
-			"OSM Controller version could not be mapped to a TrafficTarget version. Supported versions are v0.6 through v0.11")}
+	ruleTypes, err = getRuleTypesFromMatchingTrafficTargets(ctx, l.osmVersion, l.srcPod, l.dstPod, l.accessClient)
+	if errors.Is(err, access.ErrorUnsupportedTrafficTargetVersion) {
+		return outcomes.Fail{Error: err}
 	}
 	if err != nil {
 		return outcomes.Info{Diagnostics: fmt.Sprintf(
@@ -182,7 +179,7 @@ func (l ListenerFilterCheck) Run() outcomes.Outcome {
 	}
 
 	// Get possible backing service(s) for dst pod
-	svcs, err := pod.GetMatchingServices(l.k8s, l.dstPod.Labels, l.dstPod.Namespace)
+	svcs, err := pod.GetMatchingServices(ctx, l.k8s, l.dstPod.Labels, l.dstPod.Namespace)
 	if err != nil {
 		return outcomes.Fail{Error: errors.Wrapf(err, "failed to map Pod %s/%s to Kubernetes Services", l.dstPod.Namespace, l.dstPod.Name)}
 	}
@@ -239,9 +236,9 @@ func (l ListenerFilterCheck) Run() outcomes.Outcome {
 		return outcomes.Fail{Error: ErrOSMControllerVersionUnrecognized}
 	}
 
-	err = findMatchingFilterChainNames(dstEnvoyConfig, expectedInboundListenerName, possibleInboundFilterChainNames)
+	listener, err := findMatchingFilterChainNames(dstEnvoyConfig, expectedInboundListenerName, possibleInboundFilterChainNames)
 	if err != nil {
-		return outcomes.Fail{Error: err}
+		return filterChainFail(err, listener, l.dstConfigGetter)
 	}
 
 	// Check outbound listener filter chain names in src config
@@ -250,9 +247,9 @@ func (l ListenerFilterCheck) Run() outcomes.Outcome {
 		return outcomes.Fail{Error: ErrOSMControllerVersionUnrecognized}
 	}
 
-	err = findMatchingFilterChainNames(srcEnvoyConfig, expectedOutboundListenerName, possibleOutboundFilterChainNames)
+	listener, err = findMatchingFilterChainNames(srcEnvoyConfig, expectedOutboundListenerName, possibleOutboundFilterChainNames)
 	if err != nil {
-		return outcomes.Fail{Error: err}
+		return filterChainFail(err, listener, l.srcConfigGetter)
 	}
 
 	return outcomes.Pass{}
@@ -294,19 +291,15 @@ func getPossibleOutboundFilterChainNames(svcs []*corev1.Service, ruleTypes map[s
 	return possibleOutboundFilterChainNames, nil
 }
 
-func getRuleTypesFromMatchingTrafficTargetsV1alpha2(srcPod *corev1.Pod, dstPod *corev1.Pod, accessClient smiAccessClient.Interface) (map[string]struct{}, error) {
-	trafficTargets, err := accessClient.AccessV1alpha2().TrafficTargets(dstPod.Namespace).List(context.TODO(), metav1.ListOptions{})
+func getRuleTypesFromMatchingTrafficTargets(ctx context.Context, osmVersion version.ControllerVersion, srcPod *corev1.Pod, dstPod *corev1.Pod, accessClient smiAccessClient.Interface) (map[string]struct{}, error) {
+	trafficTargets, err := access.GetMatchingTrafficTargets(ctx, osmVersion, accessClient, srcPod, dstPod)
 	if err != nil {
-		log.Err(err).Msgf("Error getting TrafficTargets for namespace %s", dstPod.Namespace)
 		return nil, err
 	}
 
 	ruleTypes := map[string]struct{}{}
-	for _, trafficTarget := range trafficTargets.Items {
-		if !v1alpha2.DoesTargetMatchPods(trafficTarget.Spec, srcPod, dstPod) {
-			continue
-		}
-		for _, rule := range trafficTarget.Spec.Rules {
+	for _, trafficTarget := range trafficTargets {
+		for _, rule := range trafficTarget.Rules {
 			ruleTypes[rule.Kind] = struct{}{}
 		}
 	}
@@ -314,43 +307,37 @@ func getRuleTypesFromMatchingTrafficTargetsV1alpha2(srcPod *corev1.Pod, dstPod *
 	return ruleTypes, nil
 }
 
-func getRuleTypesFromMatchingTrafficTargetsV1alpha3(srcPod *corev1.Pod, dstPod *corev1.Pod, accessClient smiAccessClient.Interface) (map[string]struct{}, error) {
-	trafficTargets, err := accessClient.AccessV1alpha3().TrafficTargets(dstPod.Namespace).List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
-		log.Err(err).Msgf("Error getting TrafficTargets for namespace %s", dstPod.Namespace)
-		return nil, err
-	}
-
-	ruleTypes := map[string]struct{}{}
-	for _, trafficTarget := range trafficTargets.Items {
-		if !v1alpha3.DoesTargetMatchPods(trafficTarget.Spec, srcPod, dstPod) {
-			continue
-		}
-		for _, rule := range trafficTarget.Spec.Rules {
-			ruleTypes[rule.Kind] = struct{}{}
+// filterChainFail returns the outcome of a failure to find the expected filter chains, with the listener that misses
+// them when it was found.
+func filterChainFail(err error, listener *envoy_config_listener_v3.Listener, configGetter ConfigGetter) outcomes.Outcome {
+	fail := outcomes.Fail{Error: err}
+	if listener != nil {
+		fail.Snippets = []outcomes.Snippet{
+			newSnippet(fmt.Sprintf("Listener %s of %s", listener.Name, configGetter.GetObjectName()), listener),
 		}
 	}
-
-	return ruleTypes, nil
+	return fail
 }
 
-func findMatchingFilterChainNames(envoyConfig *Config, expectedListenerName string, possibleFilterChainNames map[string]bool) error {
+// findMatchingFilterChainNames marks the possible filter chain names found in the expected listener, and fails unless
+// all of them are found. The listener is returned when it is found, so that it can be shown with the failure.
+func findMatchingFilterChainNames(envoyConfig *Config, expectedListenerName string, possibleFilterChainNames map[string]bool) (*envoy_config_listener_v3.Listener, error) {
 	var actualFilterChainNames []string
 	var actualListeners []string
-	foundListener := false
+	var foundListener *envoy_config_listener_v3.Listener
 
 	for _, actualListener := range envoyConfig.Listeners.GetDynamicListeners() {
 		actualListeners = append(actualListeners, actualListener.Name)
 		if expectedListenerName == actualListener.Name {
-			foundListener = true
 			var listener envoy_config_listener_v3.Listener
 			activeStateListener := actualListener.GetActiveState().GetListener()
 			if activeStateListener == nil {
-				return ErrEnvoyActiveStateListenerMissing
+				return nil, ErrEnvoyActiveStateListenerMissing
 			}
 			if err := activeStateListener.UnmarshalTo(&listener); err != nil {
-				return ErrUnmarshalingListener
+				return nil, ErrUnmarshalingListener
 			}
+			foundListener = &listener
 			for _, listenerFilter := range listener.FilterChains {
 				// Check filter chain name
 				actualFilterChainNames = append(actualFilterChainNames, listenerFilter.Name)
@@ -368,9 +355,9 @@ func findMatchingFilterChainNames(envoyConfig *Config, expectedListenerName stri
 			}
 		}
 	}
-	if !foundListener {
+	if foundListener == nil {
 		log.Error().Msgf("must have dynamic listener with name %s but only found %v", expectedListenerName, actualListeners)
-		return ErrEnvoyListenerMissing
+		return nil, ErrEnvoyListenerMissing
 	}
 	// Iterate through map of possible filter chain names to determine if all have been found
 	missingFilterChain := false
@@ -383,9 +370,9 @@ func findMatchingFilterChainNames(envoyConfig *Config, expectedListenerName stri
 	}
 	if missingFilterChain {
 		log.Error().Msgf("expected filter chain names %v but only found %v", expectedFilterChainNames, actualFilterChainNames)
-		return ErrEnvoyFilterChainMissing
+		return foundListener, ErrEnvoyFilterChainMissing
 	}
-	return nil
+	return foundListener, nil
 }
 
 // Suggestion implements common.Runnable
@@ -409,19 +396,42 @@ func NewListenerFilterCheck(
 	srcConfigGetter ConfigGetter,
 	dstConfigGetter ConfigGetter,
 	osmVersion version.ControllerVersion,
-	cfg configurator.Configurator,
+	meshConfigGetter config.MeshConfigGetter,
 	srcPod *corev1.Pod,
 	dstPod *corev1.Pod,
 	accessClient smiAccessClient.Interface,
 	k8s kubernetes.Interface) ListenerFilterCheck {
 	return ListenerFilterCheck{
-		srcConfigGetter: srcConfigGetter,
-		dstConfigGetter: dstConfigGetter,
-		osmVersion:      osmVersion,
-		cfg:             cfg,
-		srcPod:          srcPod,
-		dstPod:          dstPod,
-		accessClient:    accessClient,
-		k8s:             k8s,
+		srcConfigGetter:  srcConfigGetter,
+		dstConfigGetter:  dstConfigGetter,
+		osmVersion:       osmVersion,
+		meshConfigGetter: meshConfigGetter,
+		srcPod:           srcPod,
+		dstPod:           dstPod,
+		accessClient:     accessClient,
+		k8s:              k8s,
+	}
+}
+
+// getListeners returns the static listeners and the active state of the dynamic listeners of an Envoy config.
+func getListeners(config *Config) ([]*envoy_config_listener_v3.Listener, error) {
+	var rawListeners []*anypb.Any
+	for _, static := range config.Listeners.GetStaticListeners() {
+		rawListeners = append(rawListeners, static.GetListener())
+	}
+	for _, dynamic := range config.Listeners.GetDynamicListeners() {
+		if activeState := dynamic.GetActiveState(); activeState != nil {
+			rawListeners = append(rawListeners, activeState.GetListener())
+		}
+	}
+
+	var listeners []*envoy_config_listener_v3.Listener
+	for _, rawListener := range rawListeners {
+		var listener envoy_config_listener_v3.Listener
+		if err := rawListener.UnmarshalTo(&listener); err != nil {
+			return nil, ErrUnmarshalingListener
+		}
+		listeners = append(listeners, &listener)
 	}
+	return listeners, nil
 }
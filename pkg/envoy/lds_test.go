@@ -1,6 +1,7 @@
 package envoy
 
 import (
+	"context"
 	"testing"
 
 	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
@@ -28,7 +29,7 @@ func TestEnvoyListenerChecker(t *testing.T) {
 		getter: createConfigGetterFunc("../../tests/sample-envoy-config-dump-bookstore.json"),
 	}
 	listenerChecker := NewInboundListenerCheck(configGetter, osmVersion)
-	outcome := listenerChecker.Run()
+	outcome := listenerChecker.Run(context.TODO())
 	assert.Nil(outcome.GetError())
 }
 
@@ -41,7 +42,7 @@ func TestEnvoyListenerCheckerEmptyConfig(t *testing.T) {
 		},
 	}
 	listenerChecker := NewOutboundListenerCheck(configGetter, osmVersion)
-	outcome := listenerChecker.Run()
+	outcome := listenerChecker.Run(context.TODO())
 	assert.NotNil(outcome.GetError())
 	assert.Equal("envoy config is empty", outcome.GetError().Error())
 }
@@ -53,7 +54,7 @@ func TestEnvoyListenerCheckerInvalidOSMVersion(t *testing.T) {
 		getter: createConfigGetterFunc("../../tests/sample-envoy-config-dump-bookbuyer.json"),
 	}
 	listenerChecker := NewOutboundListenerCheck(configGetter, osmVersion)
-	outcome := listenerChecker.Run()
+	outcome := listenerChecker.Run(context.TODO())
 	assert.NotNil(outcome.GetError())
 	assert.Equal("osm controller version not recognized", outcome.GetError().Error())
 }
@@ -564,7 +565,7 @@ func TestGetRuleTypesFromMatchingTrafficTargetsV1alpha2(t *testing.T) {
 				objs[i] = test.trafficTargets[i]
 			}
 			fakeAccessClient := fakeAccess.NewSimpleClientset(objs...)
-			ruleTypes, err := getRuleTypesFromMatchingTrafficTargetsV1alpha2(test.srcPod, test.dstPod, fakeAccessClient)
+			ruleTypes, err := getRuleTypesFromMatchingTrafficTargets(context.TODO(), "v0.6", test.srcPod, test.dstPod, fakeAccessClient)
 
 			assert.Equal(test.expErr, err != nil)
 			assert.Equal(test.expRuleTypes, ruleTypes)
@@ -770,7 +771,7 @@ func TestGetRuleTypesFromMatchingTrafficTargetsV1alpha3(t *testing.T) {
 				objs[i] = test.trafficTargets[i]
 			}
 			fakeAccessClient := fakeAccess.NewSimpleClientset(objs...)
-			ruleTypes, err := getRuleTypesFromMatchingTrafficTargetsV1alpha3(test.srcPod, test.dstPod, fakeAccessClient)
+			ruleTypes, err := getRuleTypesFromMatchingTrafficTargets(context.TODO(), "v0.9", test.srcPod, test.dstPod, fakeAccessClient)
 
 			assert.Equal(test.expErr, err != nil)
 			assert.Equal(test.expRuleTypes, ruleTypes)
@@ -937,8 +938,14 @@ func TestFindMatchingFilterChainNames(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			assert := tassert.New(t)
-			err := findMatchingFilterChainNames(test.config, test.expectedListenerName, test.possibleFilterChainNames)
+			listener, err := findMatchingFilterChainNames(test.config, test.expectedListenerName, test.possibleFilterChainNames)
 			assert.Equal(test.expErr, err)
+			if test.expErr == nil || test.expErr == ErrEnvoyFilterChainMissing {
+				// The listener is returned to be shown with the failure
+				assert.Equal(test.expectedListenerName, listener.GetName())
+			} else {
+				assert.Nil(listener)
+			}
 		})
 	}
 }
@@ -0,0 +1,129 @@
+package envoy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/podhelper"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*ProbeListenersCheck)(nil)
+
+// ProbeListenersCheck implements common.Runnable
+type ProbeListenersCheck struct {
+	ConfigGetter
+	pod        *corev1.Pod
+	osmVersion version.ControllerVersion
+}
+
+// Run implements common.Runnable
+func (c ProbeListenersCheck) Run(ctx context.Context) outcomes.Outcome {
+	sidecarProbes, known := version.SidecarProbes[c.osmVersion]
+	if !known {
+		return outcomes.Skipped{Reason: fmt.Sprintf("osm-health does not know how OSM %s rewrites the probes of meshed pods; describe them with --version-capabilities-file", c.osmVersion)}
+	}
+	if len(sidecarProbes) == 0 {
+		return outcomes.Skipped{Reason: fmt.Sprintf("OSM %s does not rewrite the probes of meshed pods", c.osmVersion)}
+	}
+
+	// Probes whose port is the sidecar probe port of their type were rewritten and must be served by an Envoy listener
+	rewrittenProbes := map[version.ProbeType]version.SidecarProbe{}
+	for idx := range c.pod.Spec.Containers {
+		container := &c.pod.Spec.Containers[idx]
+		if container.Name == constants.EnvoyContainerName {
+			continue
+		}
+		for probeType, probe := range podhelper.ContainerProbes(container) {
+			var port int32
+			switch {
+			case probe.HTTPGet != nil:
+				port = int32(probe.HTTPGet.Port.IntValue())
+			case probe.TCPSocket != nil:
+				port = int32(probe.TCPSocket.Port.IntValue())
+			default:
+				continue
+			}
+			if sidecarProbe, ok := sidecarProbes[probeType]; ok && port == sidecarProbe.Port {
+				rewrittenProbes[probeType] = sidecarProbe
+			}
+		}
+	}
+	if len(rewrittenProbes) == 0 {
+		return outcomes.Skipped{Reason: fmt.Sprintf("pod %s has no probes that go through its Envoy sidecar", c.pod.Name)}
+	}
+
+	if c.ConfigGetter == nil {
+		log.Error().Msg("Incorrectly initialized ConfigGetter")
+		return outcomes.Fail{Error: ErrIncorrectlyInitializedConfigGetter}
+	}
+	envoyConfig, err := c.ConfigGetter.GetConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	if envoyConfig == nil {
+		return outcomes.Fail{Error: ErrEnvoyConfigEmpty}
+	}
+	listeners, err := getListeners(envoyConfig)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	listenerPorts := map[string]uint32{}
+	for _, listener := range listeners {
+		listenerPorts[listener.GetName()] = listener.GetAddress().GetSocketAddress().GetPortValue()
+	}
+
+	var mismatches []string
+	for _, probeType := range []version.ProbeType{version.LivenessProbe, version.ReadinessProbe, version.StartupProbe} {
+		sidecarProbe, ok := rewrittenProbes[probeType]
+		if !ok {
+			continue
+		}
+		listenerPort, exists := listenerPorts[sidecarProbe.ListenerName]
+		switch {
+		case !exists:
+			mismatches = append(mismatches, fmt.Sprintf("the %s probe port %d has no listener %s", probeType, sidecarProbe.Port, sidecarProbe.ListenerName))
+		case listenerPort != uint32(sidecarProbe.Port):
+			mismatches = append(mismatches, fmt.Sprintf("the %s probe port %d does not match port %d of listener %s", probeType, sidecarProbe.Port, listenerPort, sidecarProbe.ListenerName))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return outcomes.Fail{Error: errors.Wrap(ErrEnvoyProbeListenerMismatch, strings.Join(mismatches, "; "))}
+	}
+	return outcomes.Pass{}
+}
+
+// Suggestion implements common.Runnable
+func (c ProbeListenersCheck) Suggestion() string {
+	return fmt.Sprintf("The Envoy bootstrap config of pod %s/%s does not serve its rewritten probes. Verify that the pod was injected by the osm-injector of OSM %s, and restart the pod otherwise (\"kubectl delete pod -n %s %s\")",
+		c.pod.Namespace, c.pod.Name, c.osmVersion, c.pod.Namespace, c.pod.Name)
+}
+
+// FixIt implements common.Runnable
+func (c ProbeListenersCheck) FixIt() error {
+	panic("implement me")
+}
+
+// Description implements common.Runnable
+func (c ProbeListenersCheck) Description() string {
+	return fmt.Sprintf("Checking whether %s has listeners for the rewritten probes of pod %s", c.ConfigGetter.GetObjectName(), c.pod.Name)
+}
+
+// NewProbeListenersCheck creates a ProbeListenersCheck which checks whether the given Pod's Envoy has a listener on the
+// port of each probe the OSM sidecar injector rewrote to go through the sidecar.
+func NewProbeListenersCheck(configGetter ConfigGetter, pod *corev1.Pod, osmVersion version.ControllerVersion) ProbeListenersCheck {
+	return ProbeListenersCheck{
+		ConfigGetter: configGetter,
+		pod:          pod,
+		osmVersion:   osmVersion,
+	}
+}
@@ -0,0 +1,89 @@
+package envoy
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+)
+
+func TestProbeListenersCheck(t *testing.T) {
+	podWithProbes := func(probes ...*corev1.Probe) *corev1.Pod {
+		container := corev1.Container{Name: "bookstore"}
+		if len(probes) > 0 {
+			container.LivenessProbe = probes[0]
+		}
+		if len(probes) > 1 {
+			container.ReadinessProbe = probes[1]
+		}
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "bookstore-v1-a", Namespace: "bookstore"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{container}},
+		}
+	}
+	httpProbe := func(port int, path string) *corev1.Probe {
+		return &corev1.Probe{Handler: corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Port: intstr.FromInt(port), Path: path}}}
+	}
+
+	tests := []struct {
+		name             string
+		osmVersion       version.ControllerVersion
+		pod              *corev1.Pod
+		configFile       string
+		expectedOutcome  outcomes.Outcome
+		expectedErrorMsg string
+	}{
+		{
+			name:            "rewritten probes served by the probe listeners",
+			osmVersion:      "v0.9",
+			pod:             podWithProbes(httpProbe(15901, "/osm-liveness-probe"), httpProbe(15902, "/osm-readiness-probe")),
+			configFile:      "../../tests/sample-envoy-config-dump-bookstore.json",
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:             "rewritten probes without probe listeners",
+			osmVersion:       "v0.9",
+			pod:              podWithProbes(httpProbe(15901, "/osm-liveness-probe")),
+			configFile:       "../../tests/sample-envoy-config-dump-bookbuyer.json",
+			expectedOutcome:  outcomes.Fail{},
+			expectedErrorMsg: "the liveness probe port 15901 has no listener liveness_listener: rewritten probe port does not match an envoy listener",
+		},
+		{
+			name:            "no rewritten probes",
+			osmVersion:      "v0.9",
+			pod:             podWithProbes(httpProbe(14001, "/liveness")),
+			configFile:      "../../tests/sample-envoy-config-dump-bookstore.json",
+			expectedOutcome: outcomes.Skipped{},
+		},
+		{
+			name:            "OSM version whose probes are unknown",
+			osmVersion:      "v0.5",
+			pod:             podWithProbes(httpProbe(15901, "/osm-liveness-probe")),
+			configFile:      "../../tests/sample-envoy-config-dump-bookstore.json",
+			expectedOutcome: outcomes.Skipped{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			configGetter := mockConfigGetter{
+				getter: createConfigGetterFunc(test.configFile),
+			}
+
+			outcome := NewProbeListenersCheck(configGetter, test.pod, test.osmVersion).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			if test.expectedErrorMsg != "" {
+				assert.EqualError(outcome.GetError(), test.expectedErrorMsg)
+			} else {
+				assert.Nil(outcome.GetError())
+			}
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package envoy
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 
@@ -24,7 +26,7 @@ type RouteDomainCheck struct {
 }
 
 // Run implements common.Runnable
-func (check RouteDomainCheck) Run() outcomes.Outcome {
+func (check RouteDomainCheck) Run(ctx context.Context) outcomes.Outcome {
 	if check.ConfigGetter == nil {
 		log.Error().Msg("Incorrectly initialized ConfigGetter")
 		return outcomes.Fail{Error: ErrIncorrectlyInitializedConfigGetter}
@@ -41,6 +43,7 @@ func (check RouteDomainCheck) Run() outcomes.Outcome {
 
 	foundAnyRouteDomains := false
 	foundSpecificRouteDomain := false
+	var routeConfigs []proto.Message
 
 	for _, rawDynRouteCfg := range envoyConfig.Routes.GetDynamicRouteConfigs() {
 		var dynRouteCfg envoy_config_route_v3.RouteConfiguration
@@ -51,6 +54,7 @@ func (check RouteDomainCheck) Run() outcomes.Outcome {
 		if !strings.HasPrefix(dynRouteCfg.Name, check.RouteName) {
 			continue
 		}
+		routeConfigs = append(routeConfigs, &dynRouteCfg)
 
 		for _, virtualHost := range dynRouteCfg.GetVirtualHosts() {
 			for _, domain := range virtualHost.GetDomains() {
@@ -75,7 +79,12 @@ func (check RouteDomainCheck) Run() outcomes.Outcome {
 	}
 
 	if len(check.Domains) > 0 && !foundSpecificRouteDomain {
-		return outcomes.Fail{Error: ErrDynamicRouteConfigDomainNotFound}
+		return outcomes.Fail{
+			Error: ErrDynamicRouteConfigDomainNotFound,
+			Snippets: []outcomes.Snippet{
+				newSnippet(fmt.Sprintf("Route configs %s of %s", check.RouteName, check.ConfigGetter.GetObjectName()), routeConfigs...),
+			},
+		}
 	}
 
 	return outcomes.Pass{}
@@ -98,20 +107,20 @@ func (check RouteDomainCheck) Description() string {
 
 // NewOutboundRouteDomainPodCheck creates a new common.Runnable, which checks
 // whether the Envoy config has outbound dynamic route domains to the Pod's services.
-func NewOutboundRouteDomainPodCheck(client kubernetes.Interface, configGetter ConfigGetter, pod *corev1.Pod) RouteDomainCheck {
-	return NewPodServicesRouteDomainCheck(client, configGetter, pod, OutboundDynamicRouteConfigName)
+func NewOutboundRouteDomainPodCheck(ctx context.Context, client kubernetes.Interface, configGetter ConfigGetter, pod *corev1.Pod) RouteDomainCheck {
+	return NewPodServicesRouteDomainCheck(ctx, client, configGetter, pod, OutboundDynamicRouteConfigName)
 }
 
 // NewInboundRouteDomainPodCheck creates a new common.Runnable, which checks
 // whether the Envoy config has inbound dynamic route domains from the Pod's services.
-func NewInboundRouteDomainPodCheck(client kubernetes.Interface, configGetter ConfigGetter, pod *corev1.Pod) RouteDomainCheck {
-	return NewPodServicesRouteDomainCheck(client, configGetter, pod, InboundDynamicRouteConfigName)
+func NewInboundRouteDomainPodCheck(ctx context.Context, client kubernetes.Interface, configGetter ConfigGetter, pod *corev1.Pod) RouteDomainCheck {
+	return NewPodServicesRouteDomainCheck(ctx, client, configGetter, pod, InboundDynamicRouteConfigName)
 }
 
 // NewPodServicesRouteDomainCheck checks whether the pod's corresponding service's domains are
 // contained in the envoy dynamic route config domain list.
-func NewPodServicesRouteDomainCheck(client kubernetes.Interface, configGetter ConfigGetter, podToCheck *corev1.Pod, routeName string) RouteDomainCheck {
-	podSvcs, err := pod.GetMatchingServices(client, podToCheck.ObjectMeta.GetLabels(), podToCheck.Namespace)
+func NewPodServicesRouteDomainCheck(ctx context.Context, client kubernetes.Interface, configGetter ConfigGetter, podToCheck *corev1.Pod, routeName string) RouteDomainCheck {
+	podSvcs, err := pod.GetMatchingServices(ctx, client, podToCheck.ObjectMeta.GetLabels(), podToCheck.Namespace)
 	if err != nil {
 		log.Warn().Msgf("unable to obtain the services of pod %s/%s", podToCheck.Namespace, podToCheck.Name)
 	}
@@ -0,0 +1,312 @@
+package envoy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/pkg/errors"
+	smiAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned"
+	smiSpecClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm-health/pkg/smi"
+	"github.com/openservicemesh/osm-health/pkg/smi/access"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+const (
+	// methodHeaderKey is the name of the pseudo-header OSM matches HTTP methods against.
+	methodHeaderKey = ":method"
+
+	// authorityHeaderKey is the name of the pseudo-header OSM matches the HTTPRouteGroup "host" header against.
+	authorityHeaderKey = ":authority"
+
+	// httpHostHeaderKey is the name of the HTTP host header in HTTPRouteGroup matches.
+	httpHostHeaderKey = "host"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*HTTPRouteMatchCheck)(nil)
+
+// HTTPRouteMatchCheck implements common.Runnable
+type HTTPRouteMatchCheck struct {
+	ConfigGetter
+	osmVersion       version.ControllerVersion
+	meshConfigGetter config.MeshConfigGetter
+	srcPod           *corev1.Pod
+	dstPod           *corev1.Pod
+	accessClient     smiAccessClient.Interface
+	specClient       smiSpecClient.Interface
+	k8s              kubernetes.Interface
+
+	// RouteName is the prefix of the dynamic route config the HTTPRouteGroup matches are expected in.
+	RouteName string
+
+	// allowWildcard is set when a route matching all paths and methods satisfies any HTTPRouteGroup match.
+	// OSM programs the outbound routes of a source Envoy with wildcards, and enforces the matches on the destination.
+	allowWildcard bool
+}
+
+// Run implements common.Runnable
+func (check HTTPRouteMatchCheck) Run(ctx context.Context) outcomes.Outcome {
+	if check.ConfigGetter == nil {
+		log.Error().Msg("Incorrectly initialized ConfigGetter")
+		return outcomes.Fail{Error: ErrIncorrectlyInitializedConfigGetter}
+	}
+
+	meshConfig, err := check.meshConfigGetter.GetMeshConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	// Check if permissive mode is enabled, in which case every meshed pod is allowed to communicate with each other
+	if meshConfig.Spec.Traffic.EnablePermissiveTrafficPolicyMode {
+		return outcomes.Skipped{Reason: "OSM is in permissive traffic policy modes -- all meshed pods can communicate and SMI access policies are not applicable"}
+	}
+
+	matches, err := access.GetHTTPRouteMatchesForPods(ctx, check.osmVersion, check.accessClient, check.specClient, check.srcPod, check.dstPod)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	if len(matches) == 0 {
+		return outcomes.Info{Diagnostics: fmt.Sprintf(
+			"No HTTPRouteGroup matches referenced by Traffic Targets from pod '%s/%s' to pod '%s/%s'",
+			check.srcPod.Namespace,
+			check.srcPod.Name,
+			check.dstPod.Namespace,
+			check.dstPod.Name)}
+	}
+
+	svcs, err := pod.GetMatchingServices(ctx, check.k8s, check.dstPod.Labels, check.dstPod.Namespace)
+	if err != nil {
+		return outcomes.Fail{Error: errors.Wrapf(err, "failed to map Pod %s/%s to Kubernetes Services", check.dstPod.Namespace, check.dstPod.Name)}
+	}
+	domains := make(map[string]struct{})
+	for _, svc := range svcs {
+		domains[fmt.Sprintf("%s.%s", svc.Name, svc.Namespace)] = struct{}{}
+	}
+	if len(domains) == 0 {
+		return outcomes.Fail{Error: fmt.Errorf("pod %s/%s is not backing any services - no routes expected in the Envoy config", check.dstPod.Namespace, check.dstPod.Name)}
+	}
+
+	envoyConfig, err := check.ConfigGetter.GetConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	if envoyConfig == nil {
+		return outcomes.Fail{Error: ErrEnvoyConfigEmpty}
+	}
+
+	routes, err := getRoutesForDomains(envoyConfig, check.RouteName, domains)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	if len(routes) == 0 {
+		return outcomes.Fail{Error: ErrDynamicRouteConfigDomainNotFound}
+	}
+
+	var missingMatches []string
+	for _, match := range matches {
+		for _, method := range getMethodsForMatch(match) {
+			if !anyRouteSatisfiesMatch(routes, match, method, check.allowWildcard) {
+				missingMatches = append(missingMatches, fmt.Sprintf("%s (method: %s)", match, method))
+			}
+		}
+	}
+
+	if len(missingMatches) > 0 {
+		routeMessages := make([]proto.Message, 0, len(routes))
+		for _, route := range routes {
+			routeMessages = append(routeMessages, route)
+		}
+		return outcomes.Fail{
+			Error: errors.Wrapf(ErrHTTPRouteMatchNotProgrammed, "missing in %s route config of %s: %s",
+				check.RouteName, check.ConfigGetter.GetObjectName(), strings.Join(missingMatches, ", ")),
+			Snippets: []outcomes.Snippet{
+				newSnippet(fmt.Sprintf("Routes %s of %s", check.RouteName, check.ConfigGetter.GetObjectName()), routeMessages...),
+			},
+		}
+	}
+
+	return outcomes.Pass{}
+}
+
+// getRoutesForDomains returns the routes of the virtual hosts in the route configs prefixed with routeName which serve any of the given domains.
+func getRoutesForDomains(envoyConfig *Config, routeName string, domains map[string]struct{}) ([]*envoy_config_route_v3.Route, error) {
+	var routes []*envoy_config_route_v3.Route
+	for _, rawDynRouteCfg := range envoyConfig.Routes.GetDynamicRouteConfigs() {
+		var dynRouteCfg envoy_config_route_v3.RouteConfiguration
+		if err := rawDynRouteCfg.GetRouteConfig().UnmarshalTo(&dynRouteCfg); err != nil {
+			return nil, ErrUnmarshalingDynamicRouteConfig
+		}
+
+		if !strings.HasPrefix(dynRouteCfg.Name, routeName) {
+			continue
+		}
+
+		for _, virtualHost := range dynRouteCfg.GetVirtualHosts() {
+			for _, domain := range virtualHost.GetDomains() {
+				if _, ok := domains[domain]; ok {
+					routes = append(routes, virtualHost.GetRoutes()...)
+					break
+				}
+			}
+		}
+	}
+	return routes, nil
+}
+
+// getMethodsForMatch returns the methods OSM programs a route for, for the given HTTPRouteGroup match.
+// Matches without methods, or with the wildcard method, are programmed as a single route matching all methods.
+func getMethodsForMatch(match smi.HTTPRouteMatch) []string {
+	if len(match.Methods) == 0 {
+		return []string{constants.WildcardHTTPMethod}
+	}
+	for _, method := range match.Methods {
+		if method == constants.WildcardHTTPMethod {
+			return []string{constants.WildcardHTTPMethod}
+		}
+	}
+	return match.Methods
+}
+
+func anyRouteSatisfiesMatch(routes []*envoy_config_route_v3.Route, match smi.HTTPRouteMatch, method string, allowWildcard bool) bool {
+	for _, route := range routes {
+		if routeSatisfiesMatch(route, match, method, allowWildcard) {
+			return true
+		}
+	}
+	return false
+}
+
+// routeSatisfiesMatch checks whether the Envoy route was programmed for the given HTTPRouteGroup match and method.
+func routeSatisfiesMatch(route *envoy_config_route_v3.Route, match smi.HTTPRouteMatch, method string, allowWildcard bool) bool {
+	expectedPath := match.PathRegex
+	if expectedPath == "" {
+		expectedPath = constants.RegexMatchAll
+	}
+	actualPath := route.GetMatch().GetSafeRegex().GetRegex()
+	if !regexMatches(actualPath, expectedPath, allowWildcard) {
+		return false
+	}
+
+	expectedHeaders := map[string]string{}
+	for name, value := range match.Headers {
+		if name == httpHostHeaderKey {
+			name = authorityHeaderKey
+		}
+		expectedHeaders[name] = value
+	}
+	expectedMethod := method
+	if expectedMethod == constants.WildcardHTTPMethod {
+		expectedMethod = constants.RegexMatchAll
+	}
+
+	actualHeaders := map[string]string{}
+	for _, header := range route.GetMatch().GetHeaders() {
+		value := header.GetSafeRegexMatch().GetRegex()
+		if value == "" {
+			value = header.GetExactMatch()
+		}
+		actualHeaders[header.GetName()] = value
+	}
+
+	actualMethod, ok := actualHeaders[methodHeaderKey]
+	if !ok {
+		actualMethod = constants.RegexMatchAll
+	}
+	if !regexMatches(actualMethod, expectedMethod, allowWildcard) {
+		return false
+	}
+	delete(actualHeaders, methodHeaderKey)
+
+	// A route with header matchers the HTTPRouteGroup match does not define is stricter than the match.
+	for name, value := range actualHeaders {
+		if expectedValue, ok := expectedHeaders[name]; !ok || expectedValue != value {
+			return false
+		}
+	}
+	if allowWildcard {
+		return true
+	}
+	return len(actualHeaders) == len(expectedHeaders)
+}
+
+func regexMatches(actual string, expected string, allowWildcard bool) bool {
+	return actual == expected || (allowWildcard && actual == constants.RegexMatchAll)
+}
+
+// Suggestion implements common.Runnable
+func (check HTTPRouteMatchCheck) Suggestion() string {
+	return fmt.Sprintf("Compare the HTTPRouteGroups referenced by the TrafficTargets (\"kubectl get httproutegroups -n %s -o yaml\") with the routes in the Envoy config (\"osm proxy get config_dump %s -n %s\")",
+		check.dstPod.Namespace, check.ConfigGetter.GetObjectName(), check.dstPod.Namespace)
+}
+
+// FixIt implements common.Runnable
+func (check HTTPRouteMatchCheck) FixIt() error {
+	panic("implement me")
+}
+
+// Description implements common.Runnable
+func (check HTTPRouteMatchCheck) Description() string {
+	return fmt.Sprintf("Checking whether %s is configured with %s Envoy routes for the HTTPRouteGroup matches between pod %s/%s and pod %s/%s",
+		check.ConfigGetter.GetObjectName(), check.RouteName, check.srcPod.Namespace, check.srcPod.Name, check.dstPod.Namespace, check.dstPod.Name)
+}
+
+// NewInboundHTTPRouteMatchCheck creates an HTTPRouteMatchCheck which checks whether the destination Envoy has inbound
+// routes for every HTTPRouteGroup match referenced by TrafficTargets between the source and destination pods.
+func NewInboundHTTPRouteMatchCheck(
+	dstConfigGetter ConfigGetter,
+	osmVersion version.ControllerVersion,
+	meshConfigGetter config.MeshConfigGetter,
+	srcPod *corev1.Pod,
+	dstPod *corev1.Pod,
+	accessClient smiAccessClient.Interface,
+	specClient smiSpecClient.Interface,
+	k8s kubernetes.Interface) HTTPRouteMatchCheck {
+	return HTTPRouteMatchCheck{
+		ConfigGetter:     dstConfigGetter,
+		osmVersion:       osmVersion,
+		meshConfigGetter: meshConfigGetter,
+		srcPod:           srcPod,
+		dstPod:           dstPod,
+		accessClient:     accessClient,
+		specClient:       specClient,
+		k8s:              k8s,
+		RouteName:        InboundDynamicRouteConfigName,
+	}
+}
+
+// NewOutboundHTTPRouteMatchCheck creates an HTTPRouteMatchCheck which checks whether the source Envoy has outbound
+// routes allowing every HTTPRouteGroup match referenced by TrafficTargets between the source and destination pods.
+func NewOutboundHTTPRouteMatchCheck(
+	srcConfigGetter ConfigGetter,
+	osmVersion version.ControllerVersion,
+	meshConfigGetter config.MeshConfigGetter,
+	srcPod *corev1.Pod,
+	dstPod *corev1.Pod,
+	accessClient smiAccessClient.Interface,
+	specClient smiSpecClient.Interface,
+	k8s kubernetes.Interface) HTTPRouteMatchCheck {
+	return HTTPRouteMatchCheck{
+		ConfigGetter:     srcConfigGetter,
+		osmVersion:       osmVersion,
+		meshConfigGetter: meshConfigGetter,
+		srcPod:           srcPod,
+		dstPod:           dstPod,
+		accessClient:     accessClient,
+		specClient:       specClient,
+		k8s:              k8s,
+		RouteName:        OutboundDynamicRouteConfigName,
+		allowWildcard:    true,
+	}
+}
@@ -0,0 +1,156 @@
+package envoy
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/smi"
+)
+
+func TestGetMethodsForMatch(t *testing.T) {
+	tests := []struct {
+		name            string
+		match           smi.HTTPRouteMatch
+		expectedMethods []string
+	}{
+		{
+			name:            "no methods defaults to wildcard",
+			match:           smi.HTTPRouteMatch{},
+			expectedMethods: []string{"*"},
+		},
+		{
+			name:            "wildcard method takes precedence",
+			match:           smi.HTTPRouteMatch{Methods: []string{"GET", "*"}},
+			expectedMethods: []string{"*"},
+		},
+		{
+			name:            "explicit methods",
+			match:           smi.HTTPRouteMatch{Methods: []string{"GET", "POST"}},
+			expectedMethods: []string{"GET", "POST"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			assert.Equal(test.expectedMethods, getMethodsForMatch(test.match))
+		})
+	}
+}
+
+func TestAnyRouteSatisfiesMatch(t *testing.T) {
+	bookstoreInbound := createConfigGetterFunc("../../tests/sample-envoy-config-dump-bookstore.json")
+	bookbuyerOutbound := createConfigGetterFunc("../../tests/sample-envoy-config-dump-bookbuyer.json")
+
+	tests := []struct {
+		name          string
+		getter        func() (*Config, error)
+		routeName     string
+		match         smi.HTTPRouteMatch
+		method        string
+		allowWildcard bool
+		expected      bool
+	}{
+		{
+			name:      "inbound route with matching path regex and method",
+			getter:    bookstoreInbound,
+			routeName: InboundDynamicRouteConfigName,
+			match:     smi.HTTPRouteMatch{RouteGroup: "bookstore-service-routes", Name: "buy-a-book", PathRegex: ".*a-book.*new", Methods: []string{"GET"}},
+			method:    "GET",
+			expected:  true,
+		},
+		{
+			name:      "inbound route with matching path regex, method and headers",
+			getter:    bookstoreInbound,
+			routeName: InboundDynamicRouteConfigName,
+			match: smi.HTTPRouteMatch{
+				RouteGroup: "bookstore-service-routes",
+				Name:       "books-bought",
+				PathRegex:  "/books-bought",
+				Methods:    []string{"GET"},
+				Headers: map[string]string{
+					"user-agent": ".*-http-client/*.*",
+					"client-app": "bookbuyer",
+				},
+			},
+			method:   "GET",
+			expected: true,
+		},
+		{
+			name:      "inbound route missing a header matcher",
+			getter:    bookstoreInbound,
+			routeName: InboundDynamicRouteConfigName,
+			match: smi.HTTPRouteMatch{
+				RouteGroup: "bookstore-service-routes",
+				Name:       "books-bought",
+				PathRegex:  "/books-bought",
+				Methods:    []string{"GET"},
+				Headers: map[string]string{
+					"user-agent": ".*-http-client/*.*",
+					"client-app": "bookbuyer",
+					"host":       "bookstore.bookstore",
+				},
+			},
+			method:   "GET",
+			expected: false,
+		},
+		{
+			name:      "inbound route with different method",
+			getter:    bookstoreInbound,
+			routeName: InboundDynamicRouteConfigName,
+			match:     smi.HTTPRouteMatch{RouteGroup: "bookstore-service-routes", Name: "buy-a-book", PathRegex: ".*a-book.*new", Methods: []string{"POST"}},
+			method:    "POST",
+			expected:  false,
+		},
+		{
+			name:      "inbound route with different path regex",
+			getter:    bookstoreInbound,
+			routeName: InboundDynamicRouteConfigName,
+			match:     smi.HTTPRouteMatch{RouteGroup: "bookstore-service-routes", Name: "sell-a-book", PathRegex: "/sell", Methods: []string{"GET"}},
+			method:    "GET",
+			expected:  false,
+		},
+		{
+			name:          "outbound wildcard route satisfies any match",
+			getter:        bookbuyerOutbound,
+			routeName:     OutboundDynamicRouteConfigName,
+			match:         smi.HTTPRouteMatch{RouteGroup: "bookstore-service-routes", Name: "sell-a-book", PathRegex: "/sell", Methods: []string{"POST"}, Headers: map[string]string{"client-app": "bookbuyer"}},
+			method:        "POST",
+			allowWildcard: true,
+			expected:      true,
+		},
+		{
+			name:      "outbound wildcard route does not satisfy a match when wildcards are not allowed",
+			getter:    bookbuyerOutbound,
+			routeName: OutboundDynamicRouteConfigName,
+			match:     smi.HTTPRouteMatch{RouteGroup: "bookstore-service-routes", Name: "sell-a-book", PathRegex: "/sell", Methods: []string{"POST"}},
+			method:    "POST",
+			expected:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			config, err := test.getter()
+			assert.Nil(err)
+
+			routes, err := getRoutesForDomains(config, test.routeName, map[string]struct{}{"bookstore.bookstore": {}})
+			assert.Nil(err)
+			assert.NotEmpty(routes)
+
+			assert.Equal(test.expected, anyRouteSatisfiesMatch(routes, test.match, test.method, test.allowWildcard))
+		})
+	}
+}
+
+func TestGetRoutesForDomainsNoMatchingDomain(t *testing.T) {
+	assert := tassert.New(t)
+	config, err := createConfigGetterFunc("../../tests/sample-envoy-config-dump-bookstore.json")()
+	assert.Nil(err)
+
+	routes, err := getRoutesForDomains(config, InboundDynamicRouteConfigName, map[string]struct{}{"bookthief.bookthief": {}})
+	assert.Nil(err)
+	assert.Empty(routes)
+}
@@ -1,12 +1,15 @@
 package envoy
 
 import (
+	"context"
 	"testing"
 
 	tassert "github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
 )
 
 var (
@@ -25,8 +28,8 @@ func TestEnvoyOutboundRouteDomainPodChecker(t *testing.T) {
 		},
 	}
 	client := fake.NewSimpleClientset(pod)
-	routeDomainChecker := NewOutboundRouteDomainPodCheck(client, configGetter, pod)
-	outcome := routeDomainChecker.Run()
+	routeDomainChecker := NewOutboundRouteDomainPodCheck(context.TODO(), client, configGetter, pod)
+	outcome := routeDomainChecker.Run(context.TODO())
 	assert.Nil(outcome.GetError())
 }
 
@@ -44,8 +47,8 @@ func TestEnvoyOutboundRouteDomainPodCheckerEmptyConfig(t *testing.T) {
 		},
 	}
 	client := fake.NewSimpleClientset(pod)
-	routeDomainChecker := NewOutboundRouteDomainPodCheck(client, configGetter, pod)
-	outcome := routeDomainChecker.Run()
+	routeDomainChecker := NewOutboundRouteDomainPodCheck(context.TODO(), client, configGetter, pod)
+	outcome := routeDomainChecker.Run(context.TODO())
 	assert.NotNil(outcome.GetError())
 	assert.Equal(ErrEnvoyConfigEmpty.Error(), outcome.GetError().Error())
 }
@@ -62,8 +65,8 @@ func TestEnvoyOutboundRouteDomainPodCheckerNoDomains(t *testing.T) {
 		},
 	}
 	client := fake.NewSimpleClientset(pod)
-	routeDomainChecker := NewOutboundRouteDomainPodCheck(client, configGetter, pod)
-	outcome := routeDomainChecker.Run()
+	routeDomainChecker := NewOutboundRouteDomainPodCheck(context.TODO(), client, configGetter, pod)
+	outcome := routeDomainChecker.Run(context.TODO())
 	assert.NotNil(outcome.GetError())
 	assert.Equal(ErrNoDynamicRouteConfigDomains.Error(), outcome.GetError().Error())
 }
@@ -94,10 +97,13 @@ func TestEnvoyOutboundRouteDomainPodCheckerDomainNotFound(t *testing.T) {
 		},
 	}
 	client := fake.NewSimpleClientset(pod, svc)
-	routeDomainChecker := NewOutboundRouteDomainPodCheck(client, configGetter, pod)
-	outcome := routeDomainChecker.Run()
+	routeDomainChecker := NewOutboundRouteDomainPodCheck(context.TODO(), client, configGetter, pod)
+	outcome := routeDomainChecker.Run(context.TODO())
 	assert.NotNil(outcome.GetError())
 	assert.Equal(ErrDynamicRouteConfigDomainNotFound.Error(), outcome.GetError().Error())
+	fail := outcome.(outcomes.Fail)
+	assert.Len(fail.Snippets, 1)
+	assert.Contains(fail.Snippets[0].Content, "rds-outbound")
 }
 
 func TestEnvoyInboundRouteDomainPodChecker(t *testing.T) {
@@ -112,8 +118,8 @@ func TestEnvoyInboundRouteDomainPodChecker(t *testing.T) {
 		},
 	}
 	client := fake.NewSimpleClientset(pod)
-	routeDomainChecker := NewInboundRouteDomainPodCheck(client, configGetter, pod)
-	outcome := routeDomainChecker.Run()
+	routeDomainChecker := NewInboundRouteDomainPodCheck(context.TODO(), client, configGetter, pod)
+	outcome := routeDomainChecker.Run(context.TODO())
 	assert.Nil(outcome.GetError())
 }
 
@@ -131,8 +137,8 @@ func TestEnvoyInboundRouteDomainPodCheckerEmptyConfig(t *testing.T) {
 		},
 	}
 	client := fake.NewSimpleClientset(pod)
-	routeDomainChecker := NewInboundRouteDomainPodCheck(client, configGetter, pod)
-	outcome := routeDomainChecker.Run()
+	routeDomainChecker := NewInboundRouteDomainPodCheck(context.TODO(), client, configGetter, pod)
+	outcome := routeDomainChecker.Run(context.TODO())
 	assert.NotNil(outcome.GetError())
 	assert.Equal(ErrEnvoyConfigEmpty.Error(), outcome.GetError().Error())
 }
@@ -149,8 +155,8 @@ func TestEnvoyInboundRouteDomainPodCheckerNoDomains(t *testing.T) {
 		},
 	}
 	client := fake.NewSimpleClientset(pod)
-	routeDomainChecker := NewInboundRouteDomainPodCheck(client, configGetter, pod)
-	outcome := routeDomainChecker.Run()
+	routeDomainChecker := NewInboundRouteDomainPodCheck(context.TODO(), client, configGetter, pod)
+	outcome := routeDomainChecker.Run(context.TODO())
 	assert.NotNil(outcome.GetError())
 	assert.Equal(ErrNoDynamicRouteConfigDomains.Error(), outcome.GetError().Error())
 }
@@ -181,8 +187,8 @@ func TestEnvoyInboundRouteDomainPodCheckerDomainNotFound(t *testing.T) {
 		},
 	}
 	client := fake.NewSimpleClientset(pod, svc)
-	routeDomainChecker := NewInboundRouteDomainPodCheck(client, configGetter, pod)
-	outcome := routeDomainChecker.Run()
+	routeDomainChecker := NewInboundRouteDomainPodCheck(context.TODO(), client, configGetter, pod)
+	outcome := routeDomainChecker.Run(context.TODO())
 	assert.NotNil(outcome.GetError())
 	assert.Equal(ErrDynamicRouteConfigDomainNotFound.Error(), outcome.GetError().Error())
 }
@@ -193,7 +199,7 @@ func TestEnvoyOutboundRouteDomainHostChecker(t *testing.T) {
 		getter: createConfigGetterFunc("../../tests/sample-envoy-config-dump-bookbuyer.json"),
 	}
 	routeDomainChecker := NewOutboundRouteDomainHostCheck(configGetter, bookstoreDestinationHost)
-	outcome := routeDomainChecker.Run()
+	outcome := routeDomainChecker.Run(context.TODO())
 	assert.Nil(outcome.GetError())
 }
 
@@ -205,7 +211,7 @@ func TestEnvoyOutboundRouteDomainHostCheckerEmptyConfig(t *testing.T) {
 		},
 	}
 	routeDomainChecker := NewOutboundRouteDomainHostCheck(configGetter, bookstoreDestinationHost)
-	outcome := routeDomainChecker.Run()
+	outcome := routeDomainChecker.Run(context.TODO())
 	assert.NotNil(outcome.GetError())
 	assert.Equal(ErrEnvoyConfigEmpty.Error(), outcome.GetError().Error())
 }
@@ -216,7 +222,7 @@ func TestEnvoyOutboundRouteDomainHostCheckerNoDomains(t *testing.T) {
 		getter: createConfigGetterFunc("../../tests/sample-envoy-config-dump-bookbuyer-no-rds-dynamic-route-virtual-host-domains.json"),
 	}
 	routeDomainChecker := NewOutboundRouteDomainHostCheck(configGetter, bookstoreDestinationHost)
-	outcome := routeDomainChecker.Run()
+	outcome := routeDomainChecker.Run(context.TODO())
 	assert.NotNil(outcome.GetError())
 	assert.Equal(ErrNoDynamicRouteConfigDomains.Error(), outcome.GetError().Error())
 }
@@ -227,7 +233,7 @@ func TestEnvoyOutboundRouteDomainHostCheckerDomainNotFound(t *testing.T) {
 		getter: createConfigGetterFunc("../../tests/sample-envoy-config-dump-bookbuyer-not-found-rds-dynamic-route-virtual-host-domain.json"),
 	}
 	routeDomainChecker := NewOutboundRouteDomainHostCheck(configGetter, bookstoreDestinationHost)
-	outcome := routeDomainChecker.Run()
+	outcome := routeDomainChecker.Run(context.TODO())
 	assert.NotNil(outcome.GetError())
 	assert.Equal(ErrDynamicRouteConfigDomainNotFound.Error(), outcome.GetError().Error())
 }
@@ -0,0 +1,174 @@
+package envoy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/pkg/errors"
+	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm-health/pkg/smi"
+	"github.com/openservicemesh/osm-health/pkg/smi/split"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*TrafficSplitWeightedClustersCheck)(nil)
+
+// TrafficSplitWeightedClustersCheck implements common.Runnable
+type TrafficSplitWeightedClustersCheck struct {
+	ConfigGetter
+	osmVersion  version.ControllerVersion
+	dstPod      *corev1.Pod
+	k8s         kubernetes.Interface
+	splitClient smiSplitClient.Interface
+}
+
+// Run implements common.Runnable
+func (check TrafficSplitWeightedClustersCheck) Run(ctx context.Context) outcomes.Outcome {
+	if check.ConfigGetter == nil {
+		log.Error().Msg("Incorrectly initialized ConfigGetter")
+		return outcomes.Fail{Error: ErrIncorrectlyInitializedConfigGetter}
+	}
+
+	trafficSplits, err := split.GetTrafficSplitsForPod(ctx, check.osmVersion, check.k8s, check.splitClient, check.dstPod)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	if len(trafficSplits) == 0 {
+		return outcomes.Info{Diagnostics: fmt.Sprintf("pod '%s/%s' does not participate in any traffic split", check.dstPod.Namespace, check.dstPod.Name)}
+	}
+
+	envoyConfig, err := check.ConfigGetter.GetConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	if envoyConfig == nil {
+		return outcomes.Fail{Error: ErrEnvoyConfigEmpty}
+	}
+
+	for _, trafficSplit := range trafficSplits {
+		if err := checkWeightedClustersForTrafficSplit(envoyConfig, trafficSplit); err != nil {
+			return outcomes.Fail{Error: errors.Wrapf(err, "envoy config of %s", check.ConfigGetter.GetObjectName())}
+		}
+	}
+	return outcomes.Pass{}
+}
+
+// checkWeightedClustersForTrafficSplit checks that every outbound route for the root service of the TrafficSplit
+// splits traffic across the backend clusters in the same proportions as the TrafficSplit.
+func checkWeightedClustersForTrafficSplit(envoyConfig *Config, trafficSplit smi.TrafficSplit) error {
+	domain := fmt.Sprintf("%s.%s", trafficSplit.Service, trafficSplit.Namespace)
+	routes, err := getRoutesForDomains(envoyConfig, OutboundDynamicRouteConfigName, map[string]struct{}{domain: {}})
+	if err != nil {
+		return err
+	}
+	if len(routes) == 0 {
+		return errors.Wrapf(ErrDynamicRouteConfigDomainNotFound, "no outbound route for root service %s of traffic split %s", domain, trafficSplit)
+	}
+
+	expectedWeights := make(map[string]uint32)
+	for _, backend := range trafficSplit.Backends {
+		if backend.Weight > 0 {
+			expectedWeights[fmt.Sprintf("%s/%s", trafficSplit.Namespace, backend.Service)] += uint32(backend.Weight)
+		}
+	}
+
+	for _, route := range routes {
+		actualWeights := getPositiveClusterWeights(route.GetRoute())
+		if !weightsAreProportional(expectedWeights, actualWeights) {
+			return errors.Wrapf(ErrWeightedClustersMismatch, "route for root service %s has weighted clusters %s, traffic split %s has %s",
+				domain, formatWeights(actualWeights), trafficSplit, formatWeights(expectedWeights))
+		}
+	}
+	return nil
+}
+
+// getPositiveClusterWeights returns the positive weights of the route's clusters, summed per service.
+func getPositiveClusterWeights(routeAction *envoy_config_route_v3.RouteAction) map[string]uint32 {
+	weights := make(map[string]uint32)
+	if cluster := routeAction.GetCluster(); cluster != "" {
+		weights[getClusterServiceName(cluster)] = 1
+		return weights
+	}
+	for _, weightedCluster := range routeAction.GetWeightedClusters().GetClusters() {
+		if weight := weightedCluster.GetWeight().GetValue(); weight > 0 {
+			weights[getClusterServiceName(weightedCluster.GetName())] += weight
+		}
+	}
+	return weights
+}
+
+// getClusterServiceName strips the port suffix OSM appends to Envoy cluster names from v0.10 onwards:
+// "bookstore/bookstore-v1|14001" becomes "bookstore/bookstore-v1".
+func getClusterServiceName(clusterName string) string {
+	return strings.SplitN(clusterName, "|", 2)[0]
+}
+
+// weightsAreProportional checks whether both maps have the same clusters, with weights in the same proportions.
+func weightsAreProportional(expected map[string]uint32, actual map[string]uint32) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	var expectedTotal, actualTotal uint64
+	for cluster, weight := range expected {
+		if _, ok := actual[cluster]; !ok {
+			return false
+		}
+		expectedTotal += uint64(weight)
+	}
+	for _, weight := range actual {
+		actualTotal += uint64(weight)
+	}
+	for cluster, weight := range expected {
+		if uint64(weight)*actualTotal != uint64(actual[cluster])*expectedTotal {
+			return false
+		}
+	}
+	return true
+}
+
+func formatWeights(weights map[string]uint32) string {
+	var formatted []string
+	for cluster, weight := range weights {
+		formatted = append(formatted, fmt.Sprintf("%s=%d", cluster, weight))
+	}
+	sort.Strings(formatted)
+	return "[" + strings.Join(formatted, ", ") + "]"
+}
+
+// Suggestion implements common.Runnable
+func (check TrafficSplitWeightedClustersCheck) Suggestion() string {
+	return fmt.Sprintf("Compare the TrafficSplits (\"kubectl get trafficsplit -n %s -o yaml\") with the outbound routes in the Envoy config (\"osm proxy get config_dump %s\")",
+		check.dstPod.Namespace, check.ConfigGetter.GetObjectName())
+}
+
+// FixIt implements common.Runnable
+func (check TrafficSplitWeightedClustersCheck) FixIt() error {
+	panic("implement me")
+}
+
+// Description implements common.Runnable
+func (check TrafficSplitWeightedClustersCheck) Description() string {
+	return fmt.Sprintf("Checking whether %s routes traffic to the services of pod %s/%s with the weights of their traffic splits",
+		check.ConfigGetter.GetObjectName(), check.dstPod.Namespace, check.dstPod.Name)
+}
+
+// NewTrafficSplitWeightedClustersCheck creates a TrafficSplitWeightedClustersCheck which checks whether the source Envoy's
+// outbound routes for the root services of the TrafficSplits the destination pod participates in match the split weights.
+func NewTrafficSplitWeightedClustersCheck(srcConfigGetter ConfigGetter, osmVersion version.ControllerVersion, dstPod *corev1.Pod, k8s kubernetes.Interface, splitClient smiSplitClient.Interface) TrafficSplitWeightedClustersCheck {
+	return TrafficSplitWeightedClustersCheck{
+		ConfigGetter: srcConfigGetter,
+		osmVersion:   osmVersion,
+		dstPod:       dstPod,
+		k8s:          k8s,
+		splitClient:  splitClient,
+	}
+}
@@ -0,0 +1,109 @@
+package envoy
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/smi"
+)
+
+func TestCheckWeightedClustersForTrafficSplit(t *testing.T) {
+	tests := []struct {
+		name          string
+		trafficSplit  smi.TrafficSplit
+		clusterNames  *strings.Replacer
+		expectedError error
+	}{
+		{
+			name: "weights match",
+			trafficSplit: smi.TrafficSplit{Name: "bookstore-split", Namespace: "bookstore", Service: "bookstore", Backends: []smi.TrafficSplitBackend{
+				{Service: "bookstore-v1", Weight: 50},
+				{Service: "bookstore-v2", Weight: 50},
+			}},
+		},
+		{
+			name: "weights are proportional",
+			trafficSplit: smi.TrafficSplit{Name: "bookstore-split", Namespace: "bookstore", Service: "bookstore", Backends: []smi.TrafficSplitBackend{
+				{Service: "bookstore-v1", Weight: 1},
+				{Service: "bookstore-v2", Weight: 1},
+			}},
+		},
+		{
+			name: "weights match clusters with port suffix",
+			trafficSplit: smi.TrafficSplit{Name: "bookstore-split", Namespace: "bookstore", Service: "bookstore", Backends: []smi.TrafficSplitBackend{
+				{Service: "bookstore-v1", Weight: 50},
+				{Service: "bookstore-v2", Weight: 50},
+			}},
+			clusterNames: strings.NewReplacer(
+				`"bookstore/bookstore-v1"`, `"bookstore/bookstore-v1|14001"`,
+				`"bookstore/bookstore-v2"`, `"bookstore/bookstore-v2|14001"`),
+		},
+		{
+			name: "weights differ for clusters with port suffix",
+			trafficSplit: smi.TrafficSplit{Name: "bookstore-split", Namespace: "bookstore", Service: "bookstore", Backends: []smi.TrafficSplitBackend{
+				{Service: "bookstore-v1", Weight: 90},
+				{Service: "bookstore-v2", Weight: 10},
+			}},
+			clusterNames: strings.NewReplacer(
+				`"bookstore/bookstore-v1"`, `"bookstore/bookstore-v1|14001"`,
+				`"bookstore/bookstore-v2"`, `"bookstore/bookstore-v2|14001"`),
+			expectedError: ErrWeightedClustersMismatch,
+		},
+		{
+			name: "weights are summed per service across ports",
+			trafficSplit: smi.TrafficSplit{Name: "bookstore-split", Namespace: "bookstore", Service: "bookstore", Backends: []smi.TrafficSplitBackend{
+				{Service: "bookstore-v1", Weight: 100},
+			}},
+			clusterNames: strings.NewReplacer(
+				`"bookstore/bookstore-v1"`, `"bookstore/bookstore-v1|14001"`,
+				`"bookstore/bookstore-v2"`, `"bookstore/bookstore-v1|8080"`),
+		},
+		{
+			name: "weights differ",
+			trafficSplit: smi.TrafficSplit{Name: "bookstore-split", Namespace: "bookstore", Service: "bookstore", Backends: []smi.TrafficSplitBackend{
+				{Service: "bookstore-v1", Weight: 90},
+				{Service: "bookstore-v2", Weight: 10},
+			}},
+			expectedError: ErrWeightedClustersMismatch,
+		},
+		{
+			name: "backend missing from route",
+			trafficSplit: smi.TrafficSplit{Name: "bookstore-split", Namespace: "bookstore", Service: "bookstore", Backends: []smi.TrafficSplitBackend{
+				{Service: "bookstore-v1", Weight: 50},
+				{Service: "bookstore-v2", Weight: 25},
+				{Service: "bookstore-v3", Weight: 25},
+			}},
+			expectedError: ErrWeightedClustersMismatch,
+		},
+		{
+			name: "no route for root service",
+			trafficSplit: smi.TrafficSplit{Name: "bookthief-split", Namespace: "bookthief", Service: "bookthief", Backends: []smi.TrafficSplitBackend{
+				{Service: "bookthief-v1", Weight: 100},
+			}},
+			expectedError: ErrDynamicRouteConfigDomainNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			sampleConfig, err := os.ReadFile("../../tests/sample-envoy-config-dump-bookstore.json")
+			assert.Nil(err)
+			if test.clusterNames != nil {
+				sampleConfig = []byte(test.clusterNames.Replace(string(sampleConfig)))
+			}
+			config, err := ParseEnvoyConfig(sampleConfig)
+			assert.Nil(err)
+
+			err = checkWeightedClustersForTrafficSplit(config, test.trafficSplit)
+			if test.expectedError != nil {
+				assert.ErrorIs(err, test.expectedError)
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
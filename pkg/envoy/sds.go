@@ -1,6 +1,7 @@
 package envoy
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -48,7 +49,7 @@ func (ct SDSCertType) String() string {
 }
 
 // Run implements common.Runnable
-func (c HasValidEnvoyCertificateCheck) Run() outcomes.Outcome {
+func (c HasValidEnvoyCertificateCheck) Run(ctx context.Context) outcomes.Outcome {
 	if c.ConfigGetter == nil {
 		log.Error().Msg("Incorrectly initialized ConfigGetter")
 		return outcomes.Fail{Error: ErrIncorrectlyInitializedConfigGetter}
@@ -74,7 +75,7 @@ func (c HasValidEnvoyCertificateCheck) Run() outcomes.Outcome {
 	// so construct the secret name for each possible service.
 	possibleSecretNames := map[string]struct{}{}
 	if c.certificateType == RootCertTypeForMTLSOutbound {
-		svcs, err := pod.GetMatchingServices(c.k8s, c.pod.Labels, c.pod.Namespace)
+		svcs, err := pod.GetMatchingServices(ctx, c.k8s, c.pod.Labels, c.pod.Namespace)
 		if err != nil {
 			return outcomes.Fail{Error: errors.Wrapf(err, "failed to map Pod %s/%s to Kubernetes Services", c.pod.Namespace, c.pod.Name)}
 		}
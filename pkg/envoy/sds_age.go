@@ -0,0 +1,106 @@
+package envoy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*ServiceCertificateAgeCheck)(nil)
+
+// defaultServiceCertValidity is the validity OSM gives service certificates when the MeshConfig does not set one.
+const defaultServiceCertValidity = 24 * time.Hour
+
+// ServiceCertificateAgeCheck implements common.Runnable
+type ServiceCertificateAgeCheck struct {
+	ConfigGetter
+	pod              *corev1.Pod
+	meshConfigGetter config.MeshConfigGetter
+}
+
+// Run implements common.Runnable
+func (c ServiceCertificateAgeCheck) Run(ctx context.Context) outcomes.Outcome {
+	if c.ConfigGetter == nil {
+		log.Error().Msg("Incorrectly initialized ConfigGetter")
+		return outcomes.Fail{Error: ErrIncorrectlyInitializedConfigGetter}
+	}
+	meshConfig, err := c.meshConfigGetter.GetMeshConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	validity := defaultServiceCertValidity
+	if configured := meshConfig.Spec.Certificate.ServiceCertValidityDuration; configured != "" {
+		validity, err = time.ParseDuration(configured)
+		if err != nil {
+			return outcomes.Fail{Error: errors.Wrapf(err, "invalid spec.certificate.serviceCertValidityDuration %q", configured)}
+		}
+	}
+
+	envoyConfig, err := c.ConfigGetter.GetConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	if envoyConfig == nil {
+		return outcomes.Fail{Error: ErrEnvoyConfigEmpty}
+	}
+
+	secretName := fmt.Sprintf("%s:%s/%s", ServiceCertType, c.pod.Namespace, c.pod.Spec.ServiceAccountName)
+	for _, dynSecret := range envoyConfig.SecretsConfigDump.GetDynamicActiveSecrets() {
+		if dynSecret.GetName() != secretName {
+			continue
+		}
+		if dynSecret.GetLastUpdated() == nil {
+			return outcomes.Skipped{Reason: fmt.Sprintf("Envoy does not report when secret %s was last updated", secretName)}
+		}
+		// OSM renews the service certificate before it expires, so a certificate older than its validity has expired
+		lastUpdated := dynSecret.GetLastUpdated().AsTime()
+		if age := time.Since(lastUpdated); age > validity {
+			return outcomes.Fail{
+				Error: ErrEnvoyServiceCertificateStale,
+				Details: map[string]interface{}{
+					"secret":      secretName,
+					"lastUpdated": lastUpdated.UTC().Format(time.RFC3339),
+					"validity":    validity.String(),
+				},
+			}
+		}
+		return outcomes.Pass{}
+	}
+
+	return outcomes.Skipped{Reason: fmt.Sprintf("%s does not have secret %s", c.ConfigGetter.GetObjectName(), secretName)}
+}
+
+// Suggestion implements common.Runnable
+func (c ServiceCertificateAgeCheck) Suggestion() string {
+	return fmt.Sprintf("Check the osm-controller logs for errors issuing certificates, then restart pod %s/%s so that its Envoy requests a new service certificate (\"kubectl delete pod -n %s %s\")",
+		c.pod.Namespace, c.pod.Name, c.pod.Namespace, c.pod.Name)
+}
+
+// FixIt implements common.Runnable
+func (c ServiceCertificateAgeCheck) FixIt() error {
+	panic("implement me")
+}
+
+// Description implements common.Runnable
+func (c ServiceCertificateAgeCheck) Description() string {
+	return fmt.Sprintf("Checking whether %s has renewed its service certificate within the certificate validity duration", c.ConfigGetter.GetObjectName())
+}
+
+// NewServiceCertificateAgeCheck creates a ServiceCertificateAgeCheck which checks whether the service certificate of
+// the given Pod's Envoy was last updated more recently than the service certificate validity duration of the MeshConfig.
+func NewServiceCertificateAgeCheck(configGetter ConfigGetter, pod *corev1.Pod, meshConfigGetter config.MeshConfigGetter) ServiceCertificateAgeCheck {
+	return ServiceCertificateAgeCheck{
+		ConfigGetter:     configGetter,
+		pod:              pod,
+		meshConfigGetter: meshConfigGetter,
+	}
+}
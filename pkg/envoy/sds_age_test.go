@@ -0,0 +1,106 @@
+package envoy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	adminv3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	tassert "github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+)
+
+func TestServiceCertificateAgeCheck(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bookstore-v1-a",
+			Namespace: "bookstore",
+		},
+		Spec: corev1.PodSpec{
+			ServiceAccountName: "bookstore-v1",
+		},
+	}
+	secretsUpdated := func(name string, lastUpdated time.Time) *Config {
+		return &Config{
+			SecretsConfigDump: adminv3.SecretsConfigDump{
+				DynamicActiveSecrets: []*adminv3.SecretsConfigDump_DynamicSecret{
+					{Name: name, LastUpdated: timestamppb.New(lastUpdated)},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		config           *Config
+		validity         string
+		expectedOutcome  outcomes.Outcome
+		expectedErrorMsg string
+	}{
+		{
+			name:            "service certificate renewed within its validity",
+			config:          secretsUpdated("service-cert:bookstore/bookstore-v1", time.Now().Add(-time.Hour)),
+			validity:        "24h",
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:             "service certificate not renewed within its validity",
+			config:           secretsUpdated("service-cert:bookstore/bookstore-v1", time.Now().Add(-25*time.Hour)),
+			validity:         "24h",
+			expectedOutcome:  outcomes.Fail{},
+			expectedErrorMsg: ErrEnvoyServiceCertificateStale.Error(),
+		},
+		{
+			name:            "no service certificate",
+			config:          secretsUpdated("service-cert:bookstore/bookstore-v2", time.Now().Add(-25*time.Hour)),
+			validity:        "24h",
+			expectedOutcome: outcomes.Skipped{},
+		},
+		{
+			name:            "unset validity defaults to 24h for a renewed certificate",
+			config:          secretsUpdated("service-cert:bookstore/bookstore-v1", time.Now().Add(-time.Hour)),
+			validity:        "",
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:             "unset validity defaults to 24h for a stale certificate",
+			config:           secretsUpdated("service-cert:bookstore/bookstore-v1", time.Now().Add(-25*time.Hour)),
+			validity:         "",
+			expectedOutcome:  outcomes.Fail{},
+			expectedErrorMsg: ErrEnvoyServiceCertificateStale.Error(),
+		},
+		{
+			name:             "invalid validity duration",
+			config:           secretsUpdated("service-cert:bookstore/bookstore-v1", time.Now()),
+			validity:         "one day",
+			expectedOutcome:  outcomes.Fail{},
+			expectedErrorMsg: `invalid spec.certificate.serviceCertValidityDuration "one day": time: invalid duration "one day"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			configGetter := mockConfigGetter{
+				getter: func() (*Config, error) {
+					return test.config, nil
+				},
+			}
+			meshConfig := config.NewSnapshot(&configv1alpha1.MeshConfig{Spec: configv1alpha1.MeshConfigSpec{
+				Certificate: configv1alpha1.CertificateSpec{ServiceCertValidityDuration: test.validity},
+			}})
+
+			outcome := NewServiceCertificateAgeCheck(configGetter, pod, meshConfig).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			if test.expectedErrorMsg != "" {
+				assert.EqualError(outcome.GetError(), test.expectedErrorMsg)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package envoy
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -351,7 +352,7 @@ func TestEnvoySecretCheck(t *testing.T) {
 			}
 			k8s := fake.NewSimpleClientset(objs...)
 			envoyCertificateChecker := test.checkFunc(k8s, configGetter, test.dstPod)
-			outcome := envoyCertificateChecker.Run()
+			outcome := envoyCertificateChecker.Run(context.TODO())
 			if test.pass {
 				assert.NoError(outcome.GetError())
 			} else {
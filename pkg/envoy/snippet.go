@@ -0,0 +1,31 @@
+package envoy
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+// newSnippet returns a snippet of the JSON of the given Envoy config, such as the listener a check inspected.
+// Several messages are formatted as a JSON array.
+func newSnippet(title string, messages ...proto.Message) outcomes.Snippet {
+	marshal := protojson.MarshalOptions{Multiline: true, Indent: "  "}
+	var parts []string
+	for _, message := range messages {
+		content, err := marshal.Marshal(message)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error marshaling Envoy config for snippet %q", title)
+			continue
+		}
+		parts = append(parts, string(content))
+	}
+
+	content := strings.Join(parts, ",\n")
+	if len(messages) > 1 {
+		content = "[\n" + content + "\n]"
+	}
+	return outcomes.Snippet{Title: title, Content: content}
+}
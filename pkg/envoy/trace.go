@@ -0,0 +1,497 @@
+package envoy
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	clusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	tcpproxyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/pkg/errors"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+const (
+	// httpConnectionManagerFilterName is the name of the Envoy HTTP connection manager network filter.
+	httpConnectionManagerFilterName = "envoy.filters.network.http_connection_manager"
+
+	// tcpProxyFilterName is the name of the Envoy TCP proxy network filter.
+	tcpProxyFilterName = "envoy.filters.network.tcp_proxy"
+
+	// pathHeaderKey is the name of the pseudo-header holding the request path.
+	pathHeaderKey = ":path"
+)
+
+// TraceRequest is the request whose path through an Envoy config is traced.
+type TraceRequest struct {
+	// Method is the HTTP method of the request
+	Method string
+
+	// Host is the value of the Host (:authority) header of the request
+	Host string
+
+	// Path is the path of the request
+	Path string
+
+	// Headers holds additional request headers that routes may match against
+	Headers map[string]string
+
+	// DestinationIP is the IP address the request is sent to
+	DestinationIP string
+
+	// DestinationPort is the port the request is sent to
+	DestinationPort uint32
+
+	// DestinationPodIP is the IP address of the pod expected to receive the request, if known
+	DestinationPodIP string
+}
+
+// TraceStep is the outcome of a single step in resolving a request through an Envoy config.
+type TraceStep struct {
+	// Description describes the resolution step
+	Description string
+
+	// Outcome is the outcome of the resolution step
+	Outcome outcomes.Outcome
+}
+
+type tracer struct {
+	envoyConfig *Config
+	request     TraceRequest
+	steps       []TraceStep
+}
+
+// Trace walks the path the given request would take through the outbound listener, filter chains, routes,
+// clusters, endpoints and upstream TLS contexts of the Envoy config. The trace stops at the first step that fails.
+func Trace(envoyConfig *Config, outboundListenerName string, request TraceRequest) []TraceStep {
+	t := &tracer{
+		envoyConfig: envoyConfig,
+		request:     request,
+	}
+	if envoyConfig == nil {
+		t.fail("Reading Envoy config", ErrEnvoyConfigEmpty)
+		return t.steps
+	}
+
+	filterChain, ok := t.traceFilterChain(outboundListenerName)
+	if !ok {
+		return t.steps
+	}
+
+	clusters, ok := t.traceNetworkFilters(filterChain)
+	if !ok {
+		return t.steps
+	}
+
+	for _, clusterName := range clusters {
+		cluster, ok := t.traceCluster(clusterName)
+		if !ok {
+			continue
+		}
+		t.traceEndpoints(cluster)
+		t.traceTLSContext(cluster)
+	}
+
+	return t.steps
+}
+
+func (t *tracer) pass(description string, msg string) {
+	t.steps = append(t.steps, TraceStep{Description: description, Outcome: outcomes.Pass{Diagnostics: msg}})
+}
+
+func (t *tracer) info(description string, diagnostics string) {
+	t.steps = append(t.steps, TraceStep{Description: description, Outcome: outcomes.Info{Diagnostics: diagnostics}})
+}
+
+func (t *tracer) fail(description string, err error) {
+	t.steps = append(t.steps, TraceStep{Description: description, Outcome: outcomes.Fail{Error: err}})
+}
+
+// traceFilterChain selects the outbound listener filter chain matching the destination IP and port of the request.
+func (t *tracer) traceFilterChain(listenerName string) (*envoy_config_listener_v3.FilterChain, bool) {
+	description := fmt.Sprintf("Matching a filter chain of listener %s for destination %s:%d", listenerName, t.request.DestinationIP, t.request.DestinationPort)
+
+	var listener *envoy_config_listener_v3.Listener
+	for _, dynListener := range t.envoyConfig.Listeners.GetDynamicListeners() {
+		if dynListener.GetName() != listenerName {
+			continue
+		}
+		activeStateListener := dynListener.GetActiveState().GetListener()
+		if activeStateListener == nil {
+			t.fail(description, ErrEnvoyActiveStateListenerMissing)
+			return nil, false
+		}
+		listener = &envoy_config_listener_v3.Listener{}
+		if err := activeStateListener.UnmarshalTo(listener); err != nil {
+			t.fail(description, ErrUnmarshalingListener)
+			return nil, false
+		}
+		break
+	}
+	if listener == nil {
+		t.fail(description, errors.Wrapf(ErrEnvoyListenerMissing, "no listener named %s", listenerName))
+		return nil, false
+	}
+
+	dstIP := net.ParseIP(t.request.DestinationIP)
+	var bestMatch *envoy_config_listener_v3.FilterChain
+	bestScore := -1
+	for _, filterChain := range listener.GetFilterChains() {
+		score, ok := filterChainMatchScore(filterChain.GetFilterChainMatch(), dstIP, t.request.DestinationPort)
+		if ok && score > bestScore {
+			bestMatch = filterChain
+			bestScore = score
+		}
+	}
+
+	if bestMatch == nil {
+		if defaultFilterChain := listener.GetDefaultFilterChain(); defaultFilterChain != nil {
+			t.info(description, fmt.Sprintf("no filter chain matches the destination; the request would use the default filter chain %s", defaultFilterChain.GetName()))
+			return defaultFilterChain, true
+		}
+		t.fail(description, errors.Wrapf(ErrEnvoyFilterChainMissing, "no filter chain matches destination %s:%d", t.request.DestinationIP, t.request.DestinationPort))
+		return nil, false
+	}
+
+	t.pass(description, fmt.Sprintf("matched filter chain %s", bestMatch.GetName()))
+	return bestMatch, true
+}
+
+// filterChainMatchScore returns whether the filter chain match applies to the destination, and how specific it is.
+// Envoy prefers the filter chain with the most specific destination port, then the longest destination IP prefix.
+func filterChainMatchScore(match *envoy_config_listener_v3.FilterChainMatch, dstIP net.IP, dstPort uint32) (int, bool) {
+	score := 0
+	if port := match.GetDestinationPort(); port != nil {
+		if port.GetValue() != dstPort {
+			return 0, false
+		}
+		score += 1000
+	}
+
+	prefixRanges := match.GetPrefixRanges()
+	if len(prefixRanges) == 0 {
+		return score, true
+	}
+	longestPrefix := -1
+	for _, prefixRange := range prefixRanges {
+		prefixLen := int(prefixRange.GetPrefixLen().GetValue())
+		_, ipNet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", prefixRange.GetAddressPrefix(), prefixLen))
+		if err != nil || dstIP == nil || !ipNet.Contains(dstIP) {
+			continue
+		}
+		if prefixLen > longestPrefix {
+			longestPrefix = prefixLen
+		}
+	}
+	if longestPrefix < 0 {
+		return 0, false
+	}
+	return score + longestPrefix, true
+}
+
+// traceNetworkFilters resolves the clusters the filter chain sends the request to.
+func (t *tracer) traceNetworkFilters(filterChain *envoy_config_listener_v3.FilterChain) ([]string, bool) {
+	for _, filter := range filterChain.GetFilters() {
+		switch filter.GetName() {
+		case httpConnectionManagerFilterName:
+			var hcm hcmv3.HttpConnectionManager
+			if err := filter.GetTypedConfig().UnmarshalTo(&hcm); err != nil {
+				t.fail(fmt.Sprintf("Reading the HTTP connection manager of filter chain %s", filterChain.GetName()), err)
+				return nil, false
+			}
+			return t.traceRoute(hcm.GetRds().GetRouteConfigName())
+
+		case tcpProxyFilterName:
+			description := fmt.Sprintf("Selecting the upstream cluster of the TCP proxy in filter chain %s", filterChain.GetName())
+			var tcpProxy tcpproxyv3.TcpProxy
+			if err := filter.GetTypedConfig().UnmarshalTo(&tcpProxy); err != nil {
+				t.fail(description, err)
+				return nil, false
+			}
+			if cluster := tcpProxy.GetCluster(); cluster != "" {
+				t.pass(description, fmt.Sprintf("selected cluster %s", cluster))
+				return []string{cluster}, true
+			}
+			var clusters, weights []string
+			for _, weightedCluster := range tcpProxy.GetWeightedClusters().GetClusters() {
+				clusters = append(clusters, weightedCluster.GetName())
+				weights = append(weights, fmt.Sprintf("%s (weight %d)", weightedCluster.GetName(), weightedCluster.GetWeight()))
+			}
+			if len(clusters) == 0 {
+				t.fail(description, errors.New("TCP proxy does not reference any cluster"))
+				return nil, false
+			}
+			t.pass(description, fmt.Sprintf("selected weighted clusters %s", strings.Join(weights, ", ")))
+			return clusters, true
+		}
+	}
+
+	t.fail(fmt.Sprintf("Selecting the network filter of filter chain %s", filterChain.GetName()),
+		errors.Errorf("filter chain has neither a %s nor a %s filter", httpConnectionManagerFilterName, tcpProxyFilterName))
+	return nil, false
+}
+
+// traceRoute resolves the virtual host and route the request matches in the given route config.
+func (t *tracer) traceRoute(routeConfigName string) ([]string, bool) {
+	description := fmt.Sprintf("Matching a route in route config %s for %s %s%s", routeConfigName, t.request.Method, t.request.Host, t.request.Path)
+
+	var routeConfig *envoy_config_route_v3.RouteConfiguration
+	for _, rawDynRouteCfg := range t.envoyConfig.Routes.GetDynamicRouteConfigs() {
+		var dynRouteCfg envoy_config_route_v3.RouteConfiguration
+		if err := rawDynRouteCfg.GetRouteConfig().UnmarshalTo(&dynRouteCfg); err != nil {
+			t.fail(description, ErrUnmarshalingDynamicRouteConfig)
+			return nil, false
+		}
+		if dynRouteCfg.GetName() == routeConfigName {
+			routeConfig = &dynRouteCfg
+			break
+		}
+	}
+	if routeConfig == nil {
+		t.fail(description, errors.Errorf("route config %s not found", routeConfigName))
+		return nil, false
+	}
+
+	virtualHost := findVirtualHost(routeConfig.GetVirtualHosts(), t.request.Host)
+	if virtualHost == nil {
+		t.fail(description, errors.Wrapf(ErrDynamicRouteConfigDomainNotFound, "no virtual host serves domain %s", t.request.Host))
+		return nil, false
+	}
+
+	for idx, route := range virtualHost.GetRoutes() {
+		if !t.routeMatches(route.GetMatch()) {
+			continue
+		}
+		routeAction := route.GetRoute()
+		if routeAction == nil {
+			t.fail(description, errors.Errorf("route %d of virtual host %s does not forward requests to a cluster", idx, virtualHost.GetName()))
+			return nil, false
+		}
+		if cluster := routeAction.GetCluster(); cluster != "" {
+			t.pass(description, fmt.Sprintf("matched route %d of virtual host %s, selected cluster %s", idx, virtualHost.GetName(), cluster))
+			return []string{cluster}, true
+		}
+		var clusters, weights []string
+		for _, weightedCluster := range routeAction.GetWeightedClusters().GetClusters() {
+			clusters = append(clusters, weightedCluster.GetName())
+			weights = append(weights, fmt.Sprintf("%s (weight %d)", weightedCluster.GetName(), weightedCluster.GetWeight().GetValue()))
+		}
+		if len(clusters) == 0 {
+			t.fail(description, errors.Errorf("route %d of virtual host %s does not reference any cluster", idx, virtualHost.GetName()))
+			return nil, false
+		}
+		t.pass(description, fmt.Sprintf("matched route %d of virtual host %s, selected weighted clusters %s", idx, virtualHost.GetName(), strings.Join(weights, ", ")))
+		return clusters, true
+	}
+
+	t.fail(description, errors.Errorf("no route of virtual host %s matches the method, path and headers of the request", virtualHost.GetName()))
+	return nil, false
+}
+
+// findVirtualHost returns the virtual host serving the given host, following Envoy's domain matching order:
+// exact domains first, then the longest suffix wildcard, then the longest prefix wildcard, then the catch-all.
+func findVirtualHost(virtualHosts []*envoy_config_route_v3.VirtualHost, host string) *envoy_config_route_v3.VirtualHost {
+	hostCandidates := []string{strings.ToLower(host)}
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		hostCandidates = append(hostCandidates, strings.ToLower(hostname))
+	}
+
+	var suffixMatch, prefixMatch, catchAll *envoy_config_route_v3.VirtualHost
+	suffixLen, prefixLen := -1, -1
+	for _, virtualHost := range virtualHosts {
+		for _, domain := range virtualHost.GetDomains() {
+			domain = strings.ToLower(domain)
+			for _, candidate := range hostCandidates {
+				switch {
+				case domain == candidate:
+					return virtualHost
+				case domain == "*":
+					catchAll = virtualHost
+				case strings.HasPrefix(domain, "*") && strings.HasSuffix(candidate, domain[1:]) && len(domain) > suffixLen:
+					suffixMatch, suffixLen = virtualHost, len(domain)
+				case strings.HasSuffix(domain, "*") && strings.HasPrefix(candidate, domain[:len(domain)-1]) && len(domain) > prefixLen:
+					prefixMatch, prefixLen = virtualHost, len(domain)
+				}
+			}
+		}
+	}
+
+	switch {
+	case suffixMatch != nil:
+		return suffixMatch
+	case prefixMatch != nil:
+		return prefixMatch
+	default:
+		return catchAll
+	}
+}
+
+// routeMatches checks whether the route match applies to the path, method and headers of the request.
+func (t *tracer) routeMatches(match *envoy_config_route_v3.RouteMatch) bool {
+	path := t.request.Path
+	if path == "" {
+		path = "/"
+	}
+	// Envoy removes the query string before matching path and regex routes, but not prefix routes.
+	pathWithoutQuery := strings.SplitN(path, "?", 2)[0]
+	switch {
+	case match.GetSafeRegex() != nil:
+		if !fullRegexMatch(match.GetSafeRegex().GetRegex(), pathWithoutQuery) {
+			return false
+		}
+	case match.GetPath() != "":
+		if match.GetPath() != pathWithoutQuery {
+			return false
+		}
+	default:
+		if !strings.HasPrefix(path, match.GetPrefix()) {
+			return false
+		}
+	}
+
+	for _, headerMatcher := range match.GetHeaders() {
+		value, present := t.headerValue(headerMatcher.GetName(), path)
+		matches := present && headerMatches(headerMatcher, value)
+		if headerMatcher.GetInvertMatch() {
+			matches = !matches
+		}
+		if !matches {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *tracer) headerValue(name string, path string) (string, bool) {
+	switch strings.ToLower(name) {
+	case methodHeaderKey:
+		return t.request.Method, true
+	case authorityHeaderKey, httpHostHeaderKey:
+		return t.request.Host, true
+	case pathHeaderKey:
+		return path, true
+	}
+	for key, value := range t.request.Headers {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+func headerMatches(headerMatcher *envoy_config_route_v3.HeaderMatcher, value string) bool {
+	switch specifier := headerMatcher.GetHeaderMatchSpecifier().(type) {
+	case *envoy_config_route_v3.HeaderMatcher_ExactMatch:
+		return specifier.ExactMatch == value
+	case *envoy_config_route_v3.HeaderMatcher_SafeRegexMatch:
+		return fullRegexMatch(specifier.SafeRegexMatch.GetRegex(), value)
+	case *envoy_config_route_v3.HeaderMatcher_PrefixMatch:
+		return strings.HasPrefix(value, specifier.PrefixMatch)
+	case *envoy_config_route_v3.HeaderMatcher_SuffixMatch:
+		return strings.HasSuffix(value, specifier.SuffixMatch)
+	case *envoy_config_route_v3.HeaderMatcher_ContainsMatch:
+		return strings.Contains(value, specifier.ContainsMatch)
+	case *envoy_config_route_v3.HeaderMatcher_PresentMatch:
+		return specifier.PresentMatch
+	default:
+		return true
+	}
+}
+
+// fullRegexMatch checks whether the RE2 regex matches the entire value, as Envoy's safe_regex matchers do.
+func fullRegexMatch(regex string, value string) bool {
+	re, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", regex))
+	if err != nil {
+		log.Error().Err(err).Msgf("invalid regex %s in envoy route", regex)
+		return false
+	}
+	return re.MatchString(value)
+}
+
+// traceCluster looks up the cluster with the given name.
+func (t *tracer) traceCluster(clusterName string) (*clusterv3.Cluster, bool) {
+	description := fmt.Sprintf("Looking up cluster %s", clusterName)
+	for _, dynCluster := range t.envoyConfig.Clusters.GetDynamicActiveClusters() {
+		var cluster clusterv3.Cluster
+		if err := dynCluster.GetCluster().UnmarshalTo(&cluster); err != nil {
+			log.Error().Err(err).Msgf("failed to unmarshal cluster %s", dynCluster.String())
+			continue
+		}
+		if cluster.GetName() == clusterName {
+			t.pass(description, fmt.Sprintf("found cluster of type %s", cluster.GetType()))
+			return &cluster, true
+		}
+	}
+	t.fail(description, errors.Errorf("cluster %s not found in the Envoy config", clusterName))
+	return nil, false
+}
+
+// traceEndpoints looks up the EDS endpoints of the cluster.
+func (t *tracer) traceEndpoints(cluster *clusterv3.Cluster) {
+	description := fmt.Sprintf("Looking up endpoints of cluster %s", cluster.GetName())
+	if cluster.GetType() != clusterv3.Cluster_EDS {
+		t.info(description, fmt.Sprintf("cluster of type %s does not use EDS endpoints", cluster.GetType()))
+		return
+	}
+
+	var endpoints []string
+	foundDestinationPod := false
+	for _, dynEpt := range t.envoyConfig.Endpoints.GetDynamicEndpointConfigs() {
+		var cla envoy_config_endpoint_v3.ClusterLoadAssignment
+		if err := dynEpt.GetEndpointConfig().UnmarshalTo(&cla); err != nil {
+			t.fail(description, ErrUnmarshalingClusterLoadAssigment)
+			return
+		}
+		if cla.GetClusterName() != cluster.GetName() {
+			continue
+		}
+		for _, ept := range cla.GetEndpoints() {
+			for _, lbEpt := range ept.GetLbEndpoints() {
+				socketAddress := lbEpt.GetEndpoint().GetAddress().GetSocketAddress()
+				endpoints = append(endpoints, fmt.Sprintf("%s:%d", socketAddress.GetAddress(), socketAddress.GetPortValue()))
+				if socketAddress.GetAddress() == t.request.DestinationPodIP {
+					foundDestinationPod = true
+				}
+			}
+		}
+	}
+	sort.Strings(endpoints)
+
+	if len(endpoints) == 0 {
+		t.fail(description, ErrNoDestinationEndpoints)
+		return
+	}
+	if t.request.DestinationPodIP != "" && !foundDestinationPod {
+		t.fail(description, errors.Wrapf(ErrEndpointNotFound, "destination pod IP %s is not one of the endpoints %v", t.request.DestinationPodIP, endpoints))
+		return
+	}
+	t.pass(description, fmt.Sprintf("found endpoints %v", endpoints))
+}
+
+// traceTLSContext reports the SNI of the upstream TLS context of the cluster.
+func (t *tracer) traceTLSContext(cluster *clusterv3.Cluster) {
+	description := fmt.Sprintf("Reading the upstream TLS context of cluster %s", cluster.GetName())
+	transportSocket := cluster.GetTransportSocket()
+	if transportSocket == nil || transportSocket.GetTypedConfig() == nil {
+		t.info(description, "cluster does not originate TLS")
+		return
+	}
+	var tlsContext tlsv3.UpstreamTlsContext
+	if err := transportSocket.GetTypedConfig().UnmarshalTo(&tlsContext); err != nil {
+		t.fail(description, err)
+		return
+	}
+	if tlsContext.GetSni() == "" {
+		t.fail(description, errors.New("upstream TLS context does not set an SNI"))
+		return
+	}
+	t.pass(description, fmt.Sprintf("SNI %s", tlsContext.GetSni()))
+}
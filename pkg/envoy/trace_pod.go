@@ -0,0 +1,147 @@
+package envoy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/cluster"
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
+	"github.com/openservicemesh/osm-health/pkg/osm/utils"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/printer"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+// TracePodRequest traces the path a request sent by the source pod would take through its Envoy config and prints each step.
+// dstPod is optional; when set, the trace checks that the pod is one of the endpoints the request may be sent to.
+func TracePodRequest(ctx context.Context, srcPod *corev1.Pod, dstPod *corev1.Pod, method string, destinationURL *url.URL, headers map[string]string, osmControlPlaneNamespace common.MeshNamespace) {
+	log.Info().Msgf("Tracing %s %s from %s/%s", method, destinationURL, srcPod.Namespace, srcPod.Name)
+
+	client, err := pod.GetKubeClient()
+	if err != nil {
+		log.Error().Err(err).Msg("Error creating Kubernetes client")
+		return
+	}
+	client = cluster.NewSnapshot(client, nil, nil, nil).KubeClient()
+
+	meshInfo, err := utils.GetMeshInfoForPod(ctx, client, srcPod.Namespace, osmControlPlaneNamespace)
+	if err != nil {
+		log.Error().Err(err).Msg("Error getting OSM info")
+		return
+	}
+
+	request, err := NewTraceRequest(ctx, client, srcPod.Namespace, method, destinationURL, headers)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error resolving destination %s", destinationURL)
+		return
+	}
+	if dstPod != nil {
+		request.DestinationPodIP = dstPod.Status.PodIP
+	}
+
+	outboundListenerName, ok := version.OutboundListenerNames[meshInfo.OSMVersion]
+	if !ok {
+		log.Error().Err(ErrOSMControllerVersionUnrecognized).Msgf("Unable to determine the outbound listener name for OSM version %s", meshInfo.OSMVersion)
+		return
+	}
+
+	srcConfigGetter, err := GetEnvoyConfigGetterForPod(srcPod, meshInfo.OSMVersion)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error creating ConfigGetter for pod %s/%s", srcPod.Namespace, srcPod.Name)
+		return
+	}
+
+	envoyConfig, err := srcConfigGetter.GetConfig()
+	if err != nil {
+		log.Error().Err(err).Msgf("Error getting Envoy config for pod %s", srcConfigGetter.GetObjectName())
+		return
+	}
+
+	steps := Trace(envoyConfig, outboundListenerName, request)
+	printables := runner.Run(ctx, version.NewControllerVersionCheck(meshInfo.DetectedOSMVersion, meshInfo.OSMVersion))
+	for _, step := range steps {
+		printables = append(printables, common.Printable{
+			CheckDescription: step.Description,
+			Type:             step.Outcome.GetOutcomeType(),
+			Diagnostics:      step.Outcome.GetDiagnostics(),
+			Error:            step.Outcome.GetError(),
+			Outcome:          step.Outcome,
+		})
+	}
+	printer.Print(printables...)
+	printer.Record(printer.Report{
+		Command:    "envoy trace",
+		Mesh:       meshInfo,
+		Printables: printables,
+	})
+}
+
+// NewTraceRequest builds the TraceRequest for the given method and URL. When the URL host is not an IP address,
+// it is resolved as a Kubernetes service name of the form name[.namespace[.svc...]], defaulting to srcNamespace.
+func NewTraceRequest(ctx context.Context, client kubernetes.Interface, srcNamespace string, method string, destinationURL *url.URL, headers map[string]string) (TraceRequest, error) {
+	request := TraceRequest{
+		Method:  strings.ToUpper(method),
+		Host:    destinationURL.Host,
+		Path:    destinationURL.RequestURI(),
+		Headers: headers,
+	}
+
+	var port uint32
+	if urlPort := destinationURL.Port(); urlPort != "" {
+		parsedPort, err := strconv.ParseUint(urlPort, 10, 32)
+		if err != nil {
+			return TraceRequest{}, errors.Wrapf(err, "invalid port %s", urlPort)
+		}
+		port = uint32(parsedPort)
+	}
+
+	hostname := destinationURL.Hostname()
+	if ip := net.ParseIP(hostname); ip != nil {
+		request.DestinationIP = ip.String()
+	} else {
+		hostChunks := strings.Split(hostname, ".")
+		serviceName, serviceNamespace := hostChunks[0], srcNamespace
+		if len(hostChunks) > 1 {
+			serviceNamespace = hostChunks[1]
+		}
+		svc, err := client.CoreV1().Services(serviceNamespace).Get(ctx, serviceName, metav1.GetOptions{})
+		if err != nil {
+			return TraceRequest{}, errors.Wrapf(err, "unable to resolve host %s to service %s/%s", hostname, serviceNamespace, serviceName)
+		}
+		if svc.Spec.ClusterIP == "" || svc.Spec.ClusterIP == corev1.ClusterIPNone {
+			return TraceRequest{}, errors.Errorf("service %s/%s does not have a cluster IP", serviceNamespace, serviceName)
+		}
+		request.DestinationIP = svc.Spec.ClusterIP
+		if port == 0 && len(svc.Spec.Ports) == 1 {
+			port = uint32(svc.Spec.Ports[0].Port)
+		}
+	}
+
+	if port == 0 {
+		switch destinationURL.Scheme {
+		case "https":
+			port = 443
+		case "http", "":
+			port = 80
+		default:
+			return TraceRequest{}, errors.Errorf("unable to determine the port of %s", destinationURL)
+		}
+	}
+	request.DestinationPort = port
+
+	if request.Host == "" {
+		request.Host = fmt.Sprintf("%s:%d", request.DestinationIP, port)
+	}
+
+	return request, nil
+}
@@ -0,0 +1,168 @@
+package envoy
+
+import (
+	"testing"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+func TestTrace(t *testing.T) {
+	bookbuyerRequest := TraceRequest{
+		Method:          "GET",
+		Host:            "bookstore.bookstore:14001",
+		Path:            "/books-bought",
+		DestinationIP:   "10.0.77.207",
+		DestinationPort: 14001,
+	}
+
+	tests := []struct {
+		name             string
+		configFile       string
+		request          TraceRequest
+		expectedOutcomes []string
+	}{
+		{
+			name:       "request to bookstore resolves to the bookstore cluster",
+			configFile: "../../tests/sample-envoy-config-dump-bookbuyer.json",
+			request:    bookbuyerRequest,
+			// The bookbuyer config dump does not contain EDS endpoints.
+			expectedOutcomes: []string{"Pass", "Pass", "Pass", "Fail", "Pass"},
+		},
+		{
+			name:       "request to an unknown destination port does not match a filter chain",
+			configFile: "../../tests/sample-envoy-config-dump-bookbuyer.json",
+			request: TraceRequest{
+				Method:          "GET",
+				Host:            "bookstore.bookstore:8080",
+				Path:            "/",
+				DestinationIP:   "10.0.77.207",
+				DestinationPort: 8080,
+			},
+			expectedOutcomes: []string{"Fail"},
+		},
+		{
+			name:       "request to an unknown host does not match a virtual host",
+			configFile: "../../tests/sample-envoy-config-dump-bookbuyer.json",
+			request: TraceRequest{
+				Method:          "GET",
+				Host:            "bookthief.bookthief",
+				Path:            "/",
+				DestinationIP:   "10.0.77.207",
+				DestinationPort: 14001,
+			},
+			expectedOutcomes: []string{"Pass", "Fail"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			config, err := createConfigGetterFunc(test.configFile)()
+			assert.Nil(err)
+
+			steps := Trace(config, "outbound-listener", test.request)
+			var actualOutcomes []string
+			for _, step := range steps {
+				switch step.Outcome.(type) {
+				case outcomes.Pass:
+					actualOutcomes = append(actualOutcomes, "Pass")
+				case outcomes.Fail:
+					actualOutcomes = append(actualOutcomes, "Fail")
+				case outcomes.Info:
+					actualOutcomes = append(actualOutcomes, "Info")
+				}
+			}
+			assert.Equal(test.expectedOutcomes, actualOutcomes)
+		})
+	}
+}
+
+func TestTraceSelectsClusterAndSNI(t *testing.T) {
+	assert := tassert.New(t)
+	config, err := createConfigGetterFunc("../../tests/sample-envoy-config-dump-bookbuyer.json")()
+	assert.Nil(err)
+
+	steps := Trace(config, "outbound-listener", TraceRequest{
+		Method:          "POST",
+		Host:            "bookstore.bookstore.svc.cluster.local",
+		Path:            "/buy-a-book/new",
+		DestinationIP:   "10.0.77.207",
+		DestinationPort: 14001,
+	})
+	assert.Len(steps, 5)
+	assert.Contains(steps[1].Outcome.(outcomes.Pass).Diagnostics, "bookstore/bookstore (weight 100)")
+	assert.Equal("SNI bookstore.bookstore.svc.cluster.local", steps[4].Outcome.(outcomes.Pass).Diagnostics)
+}
+
+func TestTraceHostIsCaseInsensitive(t *testing.T) {
+	assert := tassert.New(t)
+	config, err := createConfigGetterFunc("../../tests/sample-envoy-config-dump-bookbuyer.json")()
+	assert.Nil(err)
+
+	steps := Trace(config, "outbound-listener", TraceRequest{
+		Method:          "GET",
+		Host:            "BOOKSTORE.bookstore.svc:14001",
+		Path:            "/",
+		DestinationIP:   "10.0.77.207",
+		DestinationPort: 14001,
+	})
+	assert.GreaterOrEqual(len(steps), 2)
+	assert.IsType(outcomes.Pass{}, steps[1].Outcome)
+}
+
+func TestTraceNilConfig(t *testing.T) {
+	assert := tassert.New(t)
+	steps := Trace(nil, "outbound-listener", TraceRequest{})
+	assert.Len(steps, 1)
+	assert.Equal(ErrEnvoyConfigEmpty, steps[0].Outcome.GetError())
+}
+
+func TestTraceRouteMatchIgnoresQueryString(t *testing.T) {
+	tests := []struct {
+		name     string
+		match    *envoy_config_route_v3.RouteMatch
+		path     string
+		expected bool
+	}{
+		{
+			name: "regex match ignores the query string",
+			match: &envoy_config_route_v3.RouteMatch{PathSpecifier: &envoy_config_route_v3.RouteMatch_SafeRegex{
+				SafeRegex: &envoy_type_matcher_v3.RegexMatcher{Regex: "/books-bought"},
+			}},
+			path:     "/books-bought?page=2",
+			expected: true,
+		},
+		{
+			name:     "path match ignores the query string",
+			match:    &envoy_config_route_v3.RouteMatch{PathSpecifier: &envoy_config_route_v3.RouteMatch_Path{Path: "/books-bought"}},
+			path:     "/books-bought?page=2",
+			expected: true,
+		},
+		{
+			name:     "prefix match includes the query string",
+			match:    &envoy_config_route_v3.RouteMatch{PathSpecifier: &envoy_config_route_v3.RouteMatch_Prefix{Prefix: "/books-bought?page"}},
+			path:     "/books-bought?page=2",
+			expected: true,
+		},
+		{
+			name: "regex match still rejects other paths",
+			match: &envoy_config_route_v3.RouteMatch{PathSpecifier: &envoy_config_route_v3.RouteMatch_SafeRegex{
+				SafeRegex: &envoy_type_matcher_v3.RegexMatcher{Regex: "/books-bought"},
+			}},
+			path:     "/buy-a-book?page=2",
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			tracer := &tracer{request: TraceRequest{Method: "GET", Path: test.path}}
+			assert.Equal(test.expected, tracer.routeMatches(test.match))
+		})
+	}
+}
@@ -1,30 +1,56 @@
 package ingress
 
 import (
+	"context"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/diagnosis"
 	"github.com/openservicemesh/osm-health/pkg/kubernetes/namespace"
 	"github.com/openservicemesh/osm-health/pkg/osm/utils"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/plugins"
 	"github.com/openservicemesh/osm-health/pkg/printer"
 	"github.com/openservicemesh/osm-health/pkg/runner"
 )
 
 // ToDestinationPod checks the Ingress to the given pod.
-func ToDestinationPod(client kubernetes.Interface, dstPod *corev1.Pod, osmControlPlaneNamespace common.MeshNamespace) {
+func ToDestinationPod(ctx context.Context, client kubernetes.Interface, dstPod *corev1.Pod, osmControlPlaneNamespace common.MeshNamespace) {
 	log.Info().Msgf("Testing ingress to pod %s/%s", dstPod.Namespace, dstPod.Name)
 
-	meshInfo, err := utils.GetMeshInfo(client, osmControlPlaneNamespace)
+	meshInfo, err := utils.GetMeshInfoForPod(ctx, client, dstPod.Namespace, osmControlPlaneNamespace)
 	if err != nil {
 		log.Err(err).Msg("Error getting OSM info")
+		meshInfo = &utils.MeshInfo{}
 	}
 
-	outcomes := runner.Run(
+	checks := []runner.Runnable{
+		// Check that osm-health knows the capabilities of the installed OSM version
+		version.NewControllerVersionCheck(meshInfo.DetectedOSMVersion, meshInfo.OSMVersion),
+
 		// Check destination Pod's namespace
 		namespace.NewSidecarInjectionCheck(client, dstPod.Namespace),
 		namespace.NewMonitoredCheck(client, dstPod.Namespace, meshInfo.Name),
-	)
+		namespace.NewAnnotationsCheck(client, dstPod.Namespace, meshInfo.OSMVersion),
+	}
+	checks = append(checks, plugins.Checks(plugins.Target{
+		Command:      "ingress to-pod",
+		OSMNamespace: meshInfo.Namespace.String(),
+		MeshName:     meshInfo.Name.String(),
+		Pods:         []plugins.Pod{{Namespace: dstPod.Namespace, Name: dstPod.Name, Role: plugins.RoleDestination}},
+	})...)
+
+	outcomes := runner.Run(ctx, checks...)
 
+	diagnoses := diagnosis.Diagnose(diagnosis.Rules(), outcomes...)
 	printer.Print(outcomes...)
+	printer.PrintDiagnoses(diagnoses...)
+	printer.Record(printer.Report{
+		Command:    "ingress to-pod",
+		Mesh:       meshInfo,
+		Printables: outcomes,
+		Diagnoses:  diagnoses,
+	})
 }
@@ -2,16 +2,71 @@ package namespace
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/annotations"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
 )
 
-func getAnnotations(client kubernetes.Interface, namespace string) (map[string]string, error) {
-	ns, err := client.CoreV1().Namespaces().Get(context.TODO(), namespace, corev1.GetOptions{})
+func getAnnotations(ctx context.Context, client kubernetes.Interface, namespace string) (map[string]string, error) {
+	ns, err := client.CoreV1().Namespaces().Get(ctx, namespace, corev1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
 	return ns.Annotations, nil
 }
+
+// Verify interface compliance
+var _ runner.Runnable = (*AnnotationsCheck)(nil)
+
+// AnnotationsCheck implements common.Runnable
+type AnnotationsCheck struct {
+	client     kubernetes.Interface
+	namespace  string
+	osmVersion version.ControllerVersion
+}
+
+// NewAnnotationsCheck creates an AnnotationsCheck which checks whether the OSM annotations of a namespace are supported
+// by the given OSM version and have valid values.
+func NewAnnotationsCheck(client kubernetes.Interface, namespace string, osmVersion version.ControllerVersion) AnnotationsCheck {
+	return AnnotationsCheck{
+		client:     client,
+		namespace:  namespace,
+		osmVersion: osmVersion,
+	}
+}
+
+// Description implements common.Runnable
+func (check AnnotationsCheck) Description() string {
+	return fmt.Sprintf("Checking whether namespace %s has valid OSM annotations", check.namespace)
+}
+
+// Run implements common.Runnable
+func (check AnnotationsCheck) Run(ctx context.Context) outcomes.Outcome {
+	nsAnnotations, err := getAnnotations(ctx, check.client, check.namespace)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	if err := annotations.Validate(check.osmVersion, nsAnnotations); err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	return outcomes.Pass{}
+}
+
+// Suggestion implements common.Runnable
+func (check AnnotationsCheck) Suggestion() string {
+	return fmt.Sprintf("Fix or remove the invalid openservicemesh.io annotations of namespace %s", check.namespace)
+}
+
+// FixIt implements common.Runnable
+func (check AnnotationsCheck) FixIt() error {
+	panic("implement me")
+}
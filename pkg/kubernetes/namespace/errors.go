@@ -1,14 +1,14 @@
 package namespace
 
-import "errors"
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
 
 var (
 	// ErrNotAnnotatedForSidecarInjection is used when an object is expected to have sidecar injection annotation but it does not.
-	ErrNotAnnotatedForSidecarInjection = errors.New("not annotated for sidecar injection")
+	ErrNotAnnotatedForSidecarInjection = outcomes.NewError("NOT_ANNOTATED_FOR_SIDECAR_INJECTION", "not annotated for sidecar injection")
 
 	// ErrNotMonitoredByOSMController is used when namespace is expected to be monitored by OSM but is not.
-	ErrNotMonitoredByOSMController = errors.New("not monitored by OSM controller")
+	ErrNotMonitoredByOSMController = outcomes.NewError("NOT_MONITORED_BY_OSM_CONTROLLER", "not monitored by OSM controller")
 
 	// ErrNamespacesNotInSameMesh is used when two given namespaces are not in the same mesh
-	ErrNamespacesNotInSameMesh = errors.New("namespaces not monitored by the same mesh")
+	ErrNamespacesNotInSameMesh = outcomes.NewError("NAMESPACES_NOT_IN_SAME_MESH", "namespaces not monitored by the same mesh")
 )
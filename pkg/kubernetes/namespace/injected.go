@@ -1,6 +1,7 @@
 package namespace
 
 import (
+	"context"
 	"fmt"
 
 	"k8s.io/client-go/kubernetes"
@@ -45,8 +46,8 @@ func (check SidecarInjectionCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (check SidecarInjectionCheck) Run() outcomes.Outcome {
-	annotations, err := getAnnotations(check.client, check.namespace)
+func (check SidecarInjectionCheck) Run(ctx context.Context) outcomes.Outcome {
+	annotations, err := getAnnotations(ctx, check.client, check.namespace)
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		return outcomes.Fail{Error: err}
@@ -7,8 +7,8 @@ import (
 	"k8s.io/client-go/kubernetes"
 )
 
-func getLabels(client kubernetes.Interface, namespace string) (map[string]string, error) {
-	ns, err := client.CoreV1().Namespaces().Get(context.TODO(), namespace, corev1.GetOptions{})
+func getLabels(ctx context.Context, client kubernetes.Interface, namespace string) (map[string]string, error) {
+	ns, err := client.CoreV1().Namespaces().Get(ctx, namespace, corev1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
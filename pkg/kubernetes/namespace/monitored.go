@@ -1,8 +1,10 @@
 package namespace
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/openservicemesh/osm-health/pkg/common"
@@ -36,8 +38,8 @@ func (check MonitoredCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (check MonitoredCheck) Run() outcomes.Outcome {
-	labels, err := getLabels(check.client, check.namespace)
+func (check MonitoredCheck) Run(ctx context.Context) outcomes.Outcome {
+	labels, err := getLabels(ctx, check.client, check.namespace)
 	if err != nil {
 		return outcomes.Fail{Error: err}
 	}
@@ -87,28 +89,32 @@ func (check NamespacesInSameMeshCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (check NamespacesInSameMeshCheck) Run() outcomes.Outcome {
-	labelsA, err := getLabels(check.client, check.namespaceA)
+func (check NamespacesInSameMeshCheck) Run(ctx context.Context) outcomes.Outcome {
+	labelsA, err := getLabels(ctx, check.client, check.namespaceA)
 	if err != nil {
 		return outcomes.Fail{Error: err}
 	}
 	meshNameA, labelExistsA := labelsA[constants.OSMKubeResourceMonitorAnnotation]
 
-	labelsB, err := getLabels(check.client, check.namespaceB)
+	labelsB, err := getLabels(ctx, check.client, check.namespaceB)
 	if err != nil {
 		return outcomes.Fail{Error: err}
 	}
 
 	meshNameB, labelExistsB := labelsB[constants.OSMKubeResourceMonitorAnnotation]
-	if !labelExistsA || !labelExistsB {
-		return outcomes.Fail{Error: ErrNotMonitoredByOSMController}
+	if !labelExistsA {
+		return outcomes.Fail{Error: errors.Wrapf(ErrNotMonitoredByOSMController, "namespace %s", check.namespaceA)}
+	}
+	if !labelExistsB {
+		return outcomes.Fail{Error: errors.Wrapf(ErrNotMonitoredByOSMController, "namespace %s", check.namespaceB)}
 	}
 
 	if meshNameA != meshNameB {
-		return outcomes.Fail{Error: ErrNamespacesNotInSameMesh}
+		return outcomes.Fail{Error: errors.Wrapf(ErrNamespacesNotInSameMesh, "namespace %s is monitored by mesh %s and namespace %s is monitored by mesh %s",
+			check.namespaceA, meshNameA, check.namespaceB, meshNameB)}
 	}
 
-	return outcomes.Pass{}
+	return outcomes.Pass{Diagnostics: fmt.Sprintf("mesh %s", meshNameA)}
 }
 
 // Suggestion implements common.Runnable
@@ -3,8 +3,12 @@ package pod
 import (
 	"context"
 	"errors"
+	"sort"
 	"strings"
 
+	smiAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned"
+	smiSpecClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned"
+	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -12,15 +16,12 @@ import (
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 
-	"github.com/openservicemesh/osm-health/pkg/common"
-	"github.com/openservicemesh/osm/pkg/configurator"
-	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm-health/pkg/cluster"
 	"github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned"
-	"github.com/openservicemesh/osm/pkg/signals"
 )
 
 // FromString validates the name of the Pod
-func FromString(namespacedPod string) (*corev1.Pod, error) {
+func FromString(ctx context.Context, namespacedPod string) (*corev1.Pod, error) {
 	podChunks := strings.Split(namespacedPod, "/")
 	if len(podChunks) != 2 {
 		log.Fatal().Msgf("Invalid Pod name %s; This is expected to be in the format: namespace/name", namespacedPod)
@@ -38,7 +39,7 @@ func FromString(namespacedPod string) (*corev1.Pod, error) {
 		return nil, err
 	}
 
-	podList, err := kubeClient.CoreV1().Pods(namespace).List(context.Background(), metav1.ListOptions{})
+	podList, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		log.Error().Err(err).Msg("Error getting list of Pods")
 		return nil, errors.New("error getting pods")
@@ -58,11 +59,22 @@ func FromString(namespacedPod string) (*corev1.Pod, error) {
 
 // GetKubeConfig returns the kubeconfig
 func GetKubeConfig() (*restclient.Config, error) {
-	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{}).ClientConfig()
+	kubeConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	if recorder := cluster.ActiveRecorder(); recorder != nil {
+		kubeConfig.Wrap(recorder.WrapTransport)
+	}
+	return kubeConfig, nil
 }
 
 // GetKubeClient returns a Kubernetes clientset.
 func GetKubeClient() (kubernetes.Interface, error) {
+	if replay := cluster.ActiveReplay(); replay != nil {
+		return replay.KubeClient(), nil
+	}
+
 	kubeConfig, err := GetKubeConfig()
 	if err != nil {
 		return nil, err
@@ -71,21 +83,83 @@ func GetKubeClient() (kubernetes.Interface, error) {
 	return kubernetes.NewForConfigOrDie(kubeConfig), nil
 }
 
-// GetOsmConfigurator returns a new OSM configurator
-func GetOsmConfigurator(osmNamespace common.MeshNamespace) configurator.Configurator {
-	stop := signals.RegisterExitHandlers()
+// GetOsmConfigClient returns a clientset for the OSM config API group, which serves MeshConfig.
+func GetOsmConfigClient() (versioned.Interface, error) {
+	if replay := cluster.ActiveReplay(); replay != nil {
+		return replay.ConfigClient(), nil
+	}
+
+	kubeConfig, err := GetKubeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Return an untyped nil on error, so that callers checking the interface for nil do not get a nil *Clientset
+	configClient, err := versioned.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, err
+	}
+	return configClient, nil
+}
+
+// GetSMIAccessClient returns a clientset for the SMI access API group, which serves TrafficTargets.
+func GetSMIAccessClient() (smiAccessClient.Interface, error) {
+	if replay := cluster.ActiveReplay(); replay != nil {
+		return replay.AccessClient(), nil
+	}
+
+	kubeConfig, err := GetKubeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	accessClient, err := smiAccessClient.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, err
+	}
+	return accessClient, nil
+}
+
+// GetSMISpecClient returns a clientset for the SMI specs API group, which serves HTTPRouteGroups and TCPRoutes.
+func GetSMISpecClient() (smiSpecClient.Interface, error) {
+	if replay := cluster.ActiveReplay(); replay != nil {
+		return replay.SpecClient(), nil
+	}
+
+	kubeConfig, err := GetKubeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	specClient, err := smiSpecClient.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, err
+	}
+	return specClient, nil
+}
+
+// GetSMISplitClient returns a clientset for the SMI split API group, which serves TrafficSplits.
+func GetSMISplitClient() (smiSplitClient.Interface, error) {
+	if replay := cluster.ActiveReplay(); replay != nil {
+		return replay.SplitClient(), nil
+	}
+
 	kubeConfig, err := GetKubeConfig()
 	if err != nil {
-		log.Error().Err(err).Msg("Error getting kubeconfig")
+		return nil, err
+	}
+
+	splitClient, err := smiSplitClient.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, err
 	}
-	cfg := configurator.NewConfigurator(versioned.NewForConfigOrDie(kubeConfig), stop, osmNamespace.String(), constants.OSMMeshConfig)
-	return cfg
+	return splitClient, nil
 }
 
 // GetMatchingServices returns a list of Kubernetes services in the namespace that match the pod's label
-func GetMatchingServices(kubeClient kubernetes.Interface, podLabels map[string]string, namespace string) ([]*corev1.Service, error) {
+func GetMatchingServices(ctx context.Context, kubeClient kubernetes.Interface, podLabels map[string]string, namespace string) ([]*corev1.Service, error) {
 	var serviceList []*corev1.Service
-	svcList, err := kubeClient.CoreV1().Services(namespace).List(context.Background(), metav1.ListOptions{})
+	svcList, err := kubeClient.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -105,3 +179,47 @@ func GetMatchingServices(kubeClient kubernetes.Interface, podLabels map[string]s
 
 	return serviceList, nil
 }
+
+// GetReadyReplicas returns the pods that are ready addresses of the Endpoints of the services the pod backs, sorted by
+// name. These are the replicas a client of the services may be sent to, including the pod itself when it is ready.
+func GetReadyReplicas(ctx context.Context, kubeClient kubernetes.Interface, pod *corev1.Pod) ([]*corev1.Pod, error) {
+	svcs, err := GetMatchingServices(ctx, kubeClient, pod.Labels, pod.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	svcNames := make(map[string]struct{})
+	for _, svc := range svcs {
+		svcNames[svc.Name] = struct{}{}
+	}
+
+	endpointsList, err := kubeClient.CoreV1().Endpoints(pod.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	podNames := make(map[string]struct{})
+	for _, endpoints := range endpointsList.Items {
+		if _, ok := svcNames[endpoints.Name]; !ok {
+			continue
+		}
+		for _, subset := range endpoints.Subsets {
+			for _, address := range subset.Addresses {
+				if address.TargetRef != nil && address.TargetRef.Kind == "Pod" {
+					podNames[address.TargetRef.Name] = struct{}{}
+				}
+			}
+		}
+	}
+
+	var replicas []*corev1.Pod
+	for podName := range podNames {
+		replica, err := kubeClient.CoreV1().Pods(pod.Namespace).Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		replicas = append(replicas, replica)
+	}
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].Name < replicas[j].Name
+	})
+	return replicas, nil
+}
@@ -1,6 +1,7 @@
 package pod
 
 import (
+	"context"
 	"testing"
 
 	tassert "github.com/stretchr/testify/assert"
@@ -130,7 +131,7 @@ func TestGetMatchingServices(t *testing.T) {
 			}
 			client := fake.NewSimpleClientset(objs...)
 
-			outSvc, err := GetMatchingServices(client, testCase.podLabels, testCase.namespace)
+			outSvc, err := GetMatchingServices(context.TODO(), client, testCase.podLabels, testCase.namespace)
 			if testCase.isErrorExpected {
 				assert.Error(err)
 			} else {
@@ -140,3 +141,55 @@ func TestGetMatchingServices(t *testing.T) {
 		})
 	}
 }
+
+func TestGetReadyReplicas(t *testing.T) {
+	assert := tassert.New(t)
+
+	newPod := func(name string, app string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "bookstore",
+				Labels:    map[string]string{"app": app},
+			},
+		}
+	}
+	podAddress := func(name string) corev1.EndpointAddress {
+		return corev1.EndpointAddress{TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: name}}
+	}
+	dstPod := newPod("bookstore-v1-b", "bookstore")
+
+	client := fake.NewSimpleClientset(
+		dstPod,
+		newPod("bookstore-v1-a", "bookstore"),
+		newPod("bookstore-v1-c", "bookstore"),
+		newPod("bookwarehouse", "bookwarehouse"),
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "bookstore"},
+			Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "bookstore"}},
+		},
+		&corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "bookstore"},
+			Subsets: []corev1.EndpointSubset{
+				{
+					Addresses:         []corev1.EndpointAddress{podAddress("bookstore-v1-b"), podAddress("bookstore-v1-a")},
+					NotReadyAddresses: []corev1.EndpointAddress{podAddress("bookstore-v1-c")},
+				},
+			},
+		},
+		&corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Name: "bookwarehouse", Namespace: "bookstore"},
+			Subsets: []corev1.EndpointSubset{
+				{Addresses: []corev1.EndpointAddress{podAddress("bookwarehouse")}},
+			},
+		},
+	)
+
+	replicas, err := GetReadyReplicas(context.TODO(), client, dstPod)
+	assert.NoError(err)
+	var names []string
+	for _, replica := range replicas {
+		names = append(names, replica.Name)
+	}
+	assert.Equal([]string{"bookstore-v1-a", "bookstore-v1-b"}, names)
+}
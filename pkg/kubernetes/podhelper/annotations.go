@@ -0,0 +1,170 @@
+package podhelper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
+	"github.com/openservicemesh/osm-health/pkg/osm/annotations"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*AnnotationsCheck)(nil)
+
+// AnnotationsCheck implements common.Runnable
+type AnnotationsCheck struct {
+	pod        *corev1.Pod
+	osmVersion version.ControllerVersion
+}
+
+// NewAnnotationsCheck creates an AnnotationsCheck which checks whether the OSM annotations of a pod are supported
+// by the given OSM version and have valid values.
+func NewAnnotationsCheck(pod *corev1.Pod, osmVersion version.ControllerVersion) AnnotationsCheck {
+	return AnnotationsCheck{
+		pod:        pod,
+		osmVersion: osmVersion,
+	}
+}
+
+// Description implements common.Runnable
+func (check AnnotationsCheck) Description() string {
+	return fmt.Sprintf("Checking whether pod %s has valid OSM annotations", check.pod.Name)
+}
+
+// Run implements common.Runnable
+func (check AnnotationsCheck) Run(ctx context.Context) outcomes.Outcome {
+	if err := annotations.Validate(check.osmVersion, check.pod.Annotations); err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	return outcomes.Pass{}
+}
+
+// Suggestion implements common.Runnable
+func (check AnnotationsCheck) Suggestion() string {
+	return fmt.Sprintf("Fix or remove the invalid openservicemesh.io annotations of pod %s/%s and restart it", check.pod.Namespace, check.pod.Name)
+}
+
+// FixIt implements common.Runnable
+func (check AnnotationsCheck) FixIt() error {
+	panic("implement me")
+}
+
+// Verify interface compliance
+var _ runner.Runnable = (*PortExclusionCheck)(nil)
+
+// PortExclusionCheck implements common.Runnable
+type PortExclusionCheck struct {
+	client kubernetes.Interface
+	srcPod *corev1.Pod
+	dstPod *corev1.Pod
+}
+
+// NewPortExclusionCheck creates a PortExclusionCheck which checks whether the port exclusion list annotations
+// of the source and destination pods exclude the ports of the destination pod's services from the Envoy sidecars.
+func NewPortExclusionCheck(client kubernetes.Interface, srcPod *corev1.Pod, dstPod *corev1.Pod) PortExclusionCheck {
+	return PortExclusionCheck{
+		client: client,
+		srcPod: srcPod,
+		dstPod: dstPod,
+	}
+}
+
+// Description implements common.Runnable
+func (check PortExclusionCheck) Description() string {
+	return fmt.Sprintf("Checking whether port exclusion annotations exclude traffic from pod %s to pod %s from the Envoy sidecars", check.srcPod.Name, check.dstPod.Name)
+}
+
+// Run implements common.Runnable
+func (check PortExclusionCheck) Run(ctx context.Context) outcomes.Outcome {
+	outboundExcluded, err := annotations.GetPortExclusionList(check.srcPod.Annotations, annotations.OutboundPortExclusionList)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	inboundExcluded, err := annotations.GetPortExclusionList(check.dstPod.Annotations, annotations.InboundPortExclusionList)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	if len(outboundExcluded) == 0 && len(inboundExcluded) == 0 {
+		return outcomes.Pass{}
+	}
+
+	services, err := pod.GetMatchingServices(ctx, check.client, check.dstPod.Labels, check.dstPod.Namespace)
+	if err != nil {
+		return outcomes.Fail{Error: errors.Wrapf(err, "failed to map pod %s/%s to Kubernetes services", check.dstPod.Namespace, check.dstPod.Name)}
+	}
+
+	var bypasses []string
+	for _, svc := range services {
+		for _, svcPort := range svc.Spec.Ports {
+			targetPort := getTargetPort(check.dstPod, svcPort)
+
+			// Headless services are addressed by pod IP and target port, other services by cluster IP and service port.
+			outboundPort := int(svcPort.Port)
+			if svc.Spec.ClusterIP == corev1.ClusterIPNone {
+				outboundPort = targetPort
+			}
+
+			if containsPort(outboundExcluded, outboundPort) {
+				bypasses = append(bypasses, fmt.Sprintf("port %d of service %s/%s is in the %s annotation of source pod %s/%s, so the source Envoy is bypassed",
+					outboundPort, svc.Namespace, svc.Name, annotations.OutboundPortExclusionList, check.srcPod.Namespace, check.srcPod.Name))
+			}
+			if containsPort(inboundExcluded, targetPort) {
+				bypasses = append(bypasses, fmt.Sprintf("target port %d of service %s/%s is in the %s annotation of destination pod %s/%s, so the destination Envoy is bypassed",
+					targetPort, svc.Namespace, svc.Name, annotations.InboundPortExclusionList, check.dstPod.Namespace, check.dstPod.Name))
+			}
+		}
+	}
+
+	if len(bypasses) > 0 {
+		return outcomes.Warning{Diagnostics: strings.Join(bypasses, "\n")}
+	}
+	return outcomes.Pass{}
+}
+
+// Suggestion implements common.Runnable
+func (check PortExclusionCheck) Suggestion() string {
+	return "Remove the destination service ports from the port exclusion list annotations if the traffic should go through the mesh"
+}
+
+// FixIt implements common.Runnable
+func (check PortExclusionCheck) FixIt() error {
+	panic("implement me")
+}
+
+// getTargetPort returns the port of the pod that the given service port forwards traffic to, or 0 if there is none.
+func getTargetPort(p *corev1.Pod, svcPort corev1.ServicePort) int {
+	switch {
+	case svcPort.TargetPort.Type == intstr.String && svcPort.TargetPort.StrVal != "":
+		for _, container := range p.Spec.Containers {
+			for _, containerPort := range container.Ports {
+				if containerPort.Name == svcPort.TargetPort.StrVal {
+					return int(containerPort.ContainerPort)
+				}
+			}
+		}
+		// The named port is not exposed by the pod, so the service does not forward traffic to it.
+		return 0
+	case svcPort.TargetPort.IntVal != 0:
+		return int(svcPort.TargetPort.IntVal)
+	default:
+		return int(svcPort.Port)
+	}
+}
+
+func containsPort(ports []int, port int) bool {
+	for _, p := range ports {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package podhelper
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/annotations"
+)
+
+func TestPortExclusionCheck(t *testing.T) {
+	dstPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bookstore-v1",
+			Namespace: "bookstore",
+			Labels:    map[string]string{"app": "bookstore"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  "bookstore",
+					Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 14001}},
+				},
+			},
+		},
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bookstore",
+			Namespace: "bookstore",
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "bookstore"},
+			Ports:    []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromString("http")}},
+		},
+	}
+
+	tests := []struct {
+		name                   string
+		srcAnnotations         map[string]string
+		dstAnnotations         map[string]string
+		expectedOutcome        outcomes.Outcome
+		expectedDiagnosticPart string
+	}{
+		{
+			name:            "no port exclusions",
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "exclusions do not match the service ports",
+			srcAnnotations:  map[string]string{annotations.OutboundPortExclusionList: "14001"},
+			dstAnnotations:  map[string]string{annotations.InboundPortExclusionList: "80"},
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:                   "source excludes the service port",
+			srcAnnotations:         map[string]string{annotations.OutboundPortExclusionList: "6379,80"},
+			expectedOutcome:        outcomes.Warning{},
+			expectedDiagnosticPart: "port 80 of service bookstore/bookstore",
+		},
+		{
+			name:                   "destination excludes the target port",
+			dstAnnotations:         map[string]string{annotations.InboundPortExclusionList: "14001"},
+			expectedOutcome:        outcomes.Warning{},
+			expectedDiagnosticPart: "target port 14001 of service bookstore/bookstore",
+		},
+		{
+			name:            "invalid exclusion list",
+			srcAnnotations:  map[string]string{annotations.OutboundPortExclusionList: "http"},
+			expectedOutcome: outcomes.Fail{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			srcPod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "bookbuyer",
+					Namespace:   "bookbuyer",
+					Annotations: test.srcAnnotations,
+				},
+			}
+			dst := dstPod.DeepCopy()
+			dst.Annotations = test.dstAnnotations
+
+			outcome := NewPortExclusionCheck(fake.NewSimpleClientset(svc), srcPod, dst).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.Contains(outcome.GetDiagnostics(), test.expectedDiagnosticPart)
+		})
+	}
+}
@@ -4,63 +4,56 @@ import (
 	"bufio"
 	"context"
 	"fmt"
-	"regexp"
 
-	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/logs"
 )
 
-// HasNoBadLogs checks whether the logs of the pod container contain bad (fatal/error/warning/fail) logs
-func HasNoBadLogs(client kubernetes.Interface, pod *corev1.Pod, containerName string) outcomes.Outcome {
+// HasNoBadLogs checks whether the logs of the pod container, written in the given format, contain entries which indicate problems
+func HasNoBadLogs(ctx context.Context, client kubernetes.Interface, pod *corev1.Pod, containerName string, format logs.Format, opts logs.Options) outcomes.Outcome {
 	if !PodHasContainer(pod, containerName) {
 		return outcomes.Fail{Error: ErrPodDoesNotHaveContainer}
 	}
 
-	logsTailLines := int64(10)
 	podLogsOpt := corev1.PodLogOptions{
 		Container: containerName,
 		Follow:    false,
 		Previous:  false,
-		TailLines: &logsTailLines,
+	}
+	if opts.TailLines > 0 {
+		podLogsOpt.TailLines = &opts.TailLines
+	}
+	if opts.Since > 0 {
+		sinceSeconds := int64(opts.Since.Seconds())
+		podLogsOpt.SinceSeconds = &sinceSeconds
 	}
 
 	request := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &podLogsOpt)
-	podLogsReader, err := request.Stream(context.TODO())
+	podLogsReader, err := request.Stream(ctx)
 	if err != nil {
 		// If there are issues obtaining current container logs, return previously terminated container logs.
 		podLogsOpt.Previous = true
 		request := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &podLogsOpt)
-		podLogsReader, err = request.Stream(context.TODO())
+		podLogsReader, err = request.Stream(ctx)
 		if err != nil {
 			return outcomes.Fail{Error: fmt.Errorf("could not obtain %s container logs of pod %s: %#v", containerName, pod.Name, err)}
 		}
 	}
 	defer podLogsReader.Close() //nolint: errcheck,gosec
 
-	re := regexp.MustCompile("(?i)(fatal)|(error)|(warn)|(fail)")
+	var logLines []string
 	scanner := bufio.NewScanner(podLogsReader)
-	var badLogLines string
-	containsLogs := false
 	for scanner.Scan() {
-		containsLogs = true
-		logLine := scanner.Text()
-		log.Warn().Msgf("%s\n", logLine)
-		if re.MatchString(logLine) {
-			badLogLines += logLine + "\n"
-		}
-	}
-
-	if !containsLogs {
-		log.Warn().Msgf("%s container of pod %s does not contain any logs", containerName, pod.Name)
+		logLines = append(logLines, scanner.Text())
 	}
 
-	if len(badLogLines) != 0 {
-		return outcomes.Fail{Error: errors.Errorf("%s container of pod %s contains bad log lines: %s", containerName, pod.Name, badLogLines)}
+	if len(logLines) == 0 {
+		log.Debug().Msgf("%s container of pod %s does not contain any logs", containerName, pod.Name)
 	}
 
-	return outcomes.Pass{}
+	return logs.Analyze(fmt.Sprintf("%s/%s", pod.Name, containerName), logLines, format, opts.Allowlist).Outcome()
 }
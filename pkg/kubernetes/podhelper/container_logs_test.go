@@ -1,12 +1,15 @@
 package podhelper
 
 import (
+	"context"
 	"testing"
 
 	tassert "github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm-health/pkg/logs"
 )
 
 func TestHasNoBadLogs(t *testing.T) {
@@ -88,7 +91,7 @@ func TestHasNoBadLogs(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert := tassert.New(t)
 			client := fake.NewSimpleClientset()
-			outcome := HasNoBadLogs(client, &tc.pod, tc.searchContainerName)
+			outcome := HasNoBadLogs(context.TODO(), client, &tc.pod, tc.searchContainerName, logs.FormatPlain, logs.DefaultOptions())
 			assert.Equal(tc.expectedErr, outcome.GetError())
 		})
 	}
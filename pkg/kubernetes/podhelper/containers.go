@@ -1,6 +1,7 @@
 package podhelper
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/openservicemesh/osm-health/pkg/runner"
@@ -8,7 +9,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
-	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
 )
 
 // Verify interface compliance
@@ -16,15 +17,15 @@ var _ runner.Runnable = (*EnvoySidecarImageCheck)(nil)
 
 // EnvoySidecarImageCheck implements common.Runnable
 type EnvoySidecarImageCheck struct {
-	cfg configurator.Configurator
-	pod *corev1.Pod
+	meshConfigGetter config.MeshConfigGetter
+	pod              *corev1.Pod
 }
 
 // NewEnvoySidecarImageCheck creates an EnvoySidecarImageCheck which checks whether a pod has a sidecar with the envoy image specified in the meshconfig
-func NewEnvoySidecarImageCheck(osmConfigurator configurator.Configurator, pod *corev1.Pod) EnvoySidecarImageCheck {
+func NewEnvoySidecarImageCheck(meshConfigGetter config.MeshConfigGetter, pod *corev1.Pod) EnvoySidecarImageCheck {
 	return EnvoySidecarImageCheck{
-		cfg: osmConfigurator,
-		pod: pod,
+		meshConfigGetter: meshConfigGetter,
+		pod:              pod,
 	}
 }
 
@@ -34,9 +35,13 @@ func (check EnvoySidecarImageCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (check EnvoySidecarImageCheck) Run() outcomes.Outcome {
+func (check EnvoySidecarImageCheck) Run(ctx context.Context) outcomes.Outcome {
+	meshConfig, err := check.meshConfigGetter.GetMeshConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
 	for _, container := range check.pod.Spec.Containers {
-		if container.Image == check.cfg.GetEnvoyImage() {
+		if container.Image == meshConfig.Spec.Sidecar.EnvoyImage {
 			return outcomes.Pass{}
 		}
 	}
@@ -58,15 +63,15 @@ var _ runner.Runnable = (*OsmInitContainerImageCheck)(nil)
 
 // OsmInitContainerImageCheck implements common.Runnable
 type OsmInitContainerImageCheck struct {
-	cfg configurator.Configurator
-	pod *corev1.Pod
+	meshConfigGetter config.MeshConfigGetter
+	pod              *corev1.Pod
 }
 
 // NewOsmContainerImageCheck creates an OsmInitContainerImageCheck which checks whether a pod has a sidecar with the osm init container image specified in the meshconfig
-func NewOsmContainerImageCheck(osmConfigurator configurator.Configurator, pod *corev1.Pod) OsmInitContainerImageCheck {
+func NewOsmContainerImageCheck(meshConfigGetter config.MeshConfigGetter, pod *corev1.Pod) OsmInitContainerImageCheck {
 	return OsmInitContainerImageCheck{
-		cfg: osmConfigurator,
-		pod: pod,
+		meshConfigGetter: meshConfigGetter,
+		pod:              pod,
 	}
 }
 
@@ -76,9 +81,13 @@ func (check OsmInitContainerImageCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (check OsmInitContainerImageCheck) Run() outcomes.Outcome {
+func (check OsmInitContainerImageCheck) Run(ctx context.Context) outcomes.Outcome {
+	meshConfig, err := check.meshConfigGetter.GetMeshConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
 	for _, container := range check.pod.Spec.InitContainers {
-		if container.Image == check.cfg.GetInitContainerImage() {
+		if container.Image == meshConfig.Spec.Sidecar.InitContainerImage {
 			return outcomes.Pass{}
 		}
 	}
@@ -119,7 +128,7 @@ func (check MinNumContainersCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (check MinNumContainersCheck) Run() outcomes.Outcome {
+func (check MinNumContainersCheck) Run(ctx context.Context) outcomes.Outcome {
 	if len(check.pod.Spec.Containers) < check.minNum {
 		return outcomes.Fail{Error: ErrExpectedMinNumContainers}
 	}
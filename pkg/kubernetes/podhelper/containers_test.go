@@ -1,6 +1,7 @@
 package podhelper
 
 import (
+	"context"
 	"testing"
 
 	tassert "github.com/stretchr/testify/assert"
@@ -80,7 +81,7 @@ func TestHasExpectedNumContainers(t *testing.T) {
 	for _, tc := range testCases {
 		numContainersChecker := NewMinNumContainersCheck(&tc.pod, 2)
 
-		assert.Equal(tc.expectedError, numContainersChecker.Run().GetError())
+		assert.Equal(tc.expectedError, numContainersChecker.Run(context.TODO()).GetError())
 	}
 }
 
@@ -32,8 +32,8 @@ func (e EndpointsCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (e EndpointsCheck) Run() outcomes.Outcome {
-	eps, err := e.client.CoreV1().Endpoints(e.pod.Namespace).List(context.Background(), metav1.ListOptions{})
+func (e EndpointsCheck) Run(ctx context.Context) outcomes.Outcome {
+	eps, err := e.client.CoreV1().Endpoints(e.pod.Namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		return outcomes.Fail{Error: err}
 	}
@@ -1,6 +1,7 @@
 package podhelper
 
 import (
+	"context"
 	"testing"
 
 	tassert "github.com/stretchr/testify/assert"
@@ -240,7 +241,7 @@ func TestEndpointsCheck(t *testing.T) {
 			}
 			client := fake.NewSimpleClientset(objs...)
 			check := NewEndpointsCheck(client, test.pod)
-			out := check.Run()
+			out := check.Run(context.TODO())
 			if test.pass {
 				assert.Equal(outcomes.Pass{}, out)
 			} else {
@@ -1,28 +1,49 @@
 package podhelper
 
 import (
-	"errors"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
 )
 
 var (
 	// ErrExpectedEnvoyImageMissing is used when a pod is expected to have a container with an envoy sidecar image but does not
-	ErrExpectedEnvoyImageMissing = errors.New("expected envoy container image missing")
+	ErrExpectedEnvoyImageMissing = outcomes.NewError("EXPECTED_ENVOY_IMAGE_MISSING", "expected envoy container image missing")
 
 	// ErrExpectedOsmInitImageMissing is used when a pod is expected to have an init container with an osm init image but does not
-	ErrExpectedOsmInitImageMissing = errors.New("expected osm init container image missing")
+	ErrExpectedOsmInitImageMissing = outcomes.NewError("EXPECTED_OSM_INIT_IMAGE_MISSING", "expected osm init container image missing")
 
 	// ErrExpectedMinNumContainers is used when a pod is expected to have a container with an envoy sidecar image but does not
-	ErrExpectedMinNumContainers = errors.New("fewer containers than expected in pod")
+	ErrExpectedMinNumContainers = outcomes.NewError("EXPECTED_MIN_NUM_CONTAINERS", "fewer containers than expected in pod")
 
 	// ErrProxyUUIDLabelMissing is used when a pod is expected to have a valid proxy UUID label but does not
-	ErrProxyUUIDLabelMissing = errors.New("pod does not have expected valid proxy UUID label")
+	ErrProxyUUIDLabelMissing = outcomes.NewError("PROXY_UUID_LABEL_MISSING", "pod does not have expected valid proxy UUID label")
 
 	// ErrPodDoesNotHaveContainer is used when a pod does not have a container in the pod spec container list.
-	ErrPodDoesNotHaveContainer = errors.New("pod does not have container in pod spec container list")
+	ErrPodDoesNotHaveContainer = outcomes.NewError("POD_DOES_NOT_HAVE_CONTAINER", "pod does not have container in pod spec container list")
 
 	// ErrPodNotInEndpoints is used when a pod is expected to be referenced by any Kubernetes Endpoints resources but is not
-	ErrPodNotInEndpoints = errors.New("pod not referenced by any Kubernetes Endpoints resources")
+	ErrPodNotInEndpoints = outcomes.NewError("POD_NOT_IN_ENDPOINTS", "pod not referenced by any Kubernetes Endpoints resources")
 
 	// ErrNoService is used when there is no service associated with the pod
-	ErrNoService = errors.New("no service associated")
+	ErrNoService = outcomes.NewError("NO_SERVICE", "no service associated")
+
+	// ErrPodNotRunning is used when a pod is not in the Running phase
+	ErrPodNotRunning = outcomes.NewError("POD_NOT_RUNNING", "pod is not running")
+
+	// ErrPodNotReady is used when a pod does not have a true Ready condition
+	ErrPodNotReady = outcomes.NewError("POD_NOT_READY", "pod is not ready")
+
+	// ErrContainerCrashLoopBackOff is used when a container of a pod is waiting to be restarted after failing repeatedly
+	ErrContainerCrashLoopBackOff = outcomes.NewError("CONTAINER_CRASH_LOOP_BACK_OFF", "container is in CrashLoopBackOff")
+
+	// ErrContainerOOMKilled is used when a container of a pod was terminated for exceeding its memory limit
+	ErrContainerOOMKilled = outcomes.NewError("CONTAINER_OOM_KILLED", "container was OOMKilled")
+
+	// ErrOsmInitFailed is used when the osm-init container of a pod exited with a non-zero exit code
+	ErrOsmInitFailed = outcomes.NewError("OSM_INIT_FAILED", "osm-init container exited with a non-zero exit code")
+
+	// ErrOsmInitNotCompleted is used when the osm-init container of a pod has not completed
+	ErrOsmInitNotCompleted = outcomes.NewError("OSM_INIT_NOT_COMPLETED", "osm-init container has not completed")
+
+	// ErrProbeNotRewritten is used when an HTTP probe of a pod does not go through the Envoy sidecar as the OSM version expects
+	ErrProbeNotRewritten = outcomes.NewError("PROBE_NOT_REWRITTEN", "HTTP probe not rewritten to go through the Envoy sidecar")
 )
@@ -35,13 +35,13 @@ func (check PodEventsCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (check PodEventsCheck) Run() outcomes.Outcome {
+func (check PodEventsCheck) Run(ctx context.Context) outcomes.Outcome {
 	eventsInterface := check.client.CoreV1().Events(check.pod.Namespace)
 	var events *corev1.EventList
 
 	selectorString := "type!=Normal,involvedObject.apiVersion=v1,involvedObject.kind=Pod,involvedObject.name=" + check.pod.Name
 	options := metav1.ListOptions{FieldSelector: selectorString}
-	events, err := eventsInterface.List(context.TODO(), options)
+	events, err := eventsInterface.List(ctx, options)
 	if err != nil {
 		return outcomes.Fail{Error: fmt.Errorf("unable to search events of pod '%#v': %v", check.pod, err)}
 	}
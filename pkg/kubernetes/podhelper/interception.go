@@ -0,0 +1,254 @@
+package podhelper
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
+	"github.com/openservicemesh/osm-health/pkg/osm/annotations"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*OutboundInterceptionCheck)(nil)
+
+// OutboundInterceptionCheck implements common.Runnable
+type OutboundInterceptionCheck struct {
+	client           kubernetes.Interface
+	meshConfigGetter config.MeshConfigGetter
+	srcPod           *corev1.Pod
+	dstPod           *corev1.Pod
+}
+
+// NewOutboundInterceptionCheck creates an OutboundInterceptionCheck which checks whether the outbound traffic of the source pod
+// to the destination pod's services is intercepted by the source Envoy sidecar. It combines the MeshConfig outbound exclusion lists,
+// the source pod's port exclusion annotation and the iptables rules set up by the source pod's osm-init container.
+func NewOutboundInterceptionCheck(client kubernetes.Interface, meshConfigGetter config.MeshConfigGetter, srcPod *corev1.Pod, dstPod *corev1.Pod) OutboundInterceptionCheck {
+	return OutboundInterceptionCheck{
+		client:           client,
+		meshConfigGetter: meshConfigGetter,
+		srcPod:           srcPod,
+		dstPod:           dstPod,
+	}
+}
+
+// Description implements common.Runnable
+func (check OutboundInterceptionCheck) Description() string {
+	return fmt.Sprintf("Checking whether outbound traffic from pod %s to pod %s is intercepted by the source Envoy", check.srcPod.Name, check.dstPod.Name)
+}
+
+// destination is an IP address and port the source pod sends traffic to in order to reach the destination pod.
+type destination struct {
+	service string
+	ip      net.IP
+	port    int
+}
+
+func (d destination) String() string {
+	return fmt.Sprintf("%s (%s)", net.JoinHostPort(d.ip.String(), fmt.Sprint(d.port)), d.service)
+}
+
+// Run implements common.Runnable
+func (check OutboundInterceptionCheck) Run(ctx context.Context) outcomes.Outcome {
+	meshConfig, err := check.meshConfigGetter.GetMeshConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	destinations, err := check.getDestinations(ctx)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	if len(destinations) == 0 {
+		return outcomes.Info{Diagnostics: fmt.Sprintf("pod %s/%s has no service IP addresses and ports to check", check.dstPod.Namespace, check.dstPod.Name)}
+	}
+
+	annotatedPorts, err := annotations.GetPortExclusionList(check.srcPod.Annotations, annotations.OutboundPortExclusionList)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	rules, rulesSource := check.getOutboundRules(ctx)
+
+	var bypasses, pending []string
+	for _, dst := range destinations {
+		exclusions := check.getConfiguredExclusions(meshConfig.Spec.Traffic, dst, annotatedPorts)
+
+		if len(rules) == 0 {
+			// Without the iptables rules, the current configuration is the best indication of what was set up.
+			if len(exclusions) > 0 {
+				bypasses = append(bypasses, fmt.Sprintf("traffic to %s bypasses Envoy: %s", dst, exclusions.String()))
+			}
+			continue
+		}
+
+		rule, found := rules.evaluateOutbound(dst.ip, dst.port)
+		switch {
+		case !found:
+			bypasses = append(bypasses, fmt.Sprintf("traffic to %s bypasses Envoy: no %s rule matches it", dst, proxyOutputChain))
+		case rule.target != proxyRedirectChain:
+			reason := exclusions.explaining(rule).String()
+			if reason == "" {
+				reason = "it is no longer configured in MeshConfig or the pod annotations; restart the pod to update its iptables rules"
+			}
+			bypasses = append(bypasses, fmt.Sprintf("traffic to %s bypasses Envoy because of the osm-init rule %q: %s", dst, rule.text, reason))
+		case len(exclusions) > 0:
+			pending = append(pending, fmt.Sprintf("traffic to %s is intercepted, but will bypass Envoy once the pod is restarted: %s", dst, exclusions.String()))
+		}
+	}
+
+	if len(bypasses) > 0 || len(pending) > 0 {
+		diagnostics := bypasses
+		diagnostics = append(diagnostics, pending...)
+		if len(rules) == 0 {
+			diagnostics = append(diagnostics, fmt.Sprintf("no iptables rules were found in the %s container of pod %s/%s; only MeshConfig and the pod annotations were considered",
+				constants.InitContainerName, check.srcPod.Namespace, check.srcPod.Name))
+		}
+		return outcomes.Warning{Diagnostics: strings.Join(diagnostics, "\n")}
+	}
+
+	if len(rules) == 0 {
+		return outcomes.Info{Diagnostics: fmt.Sprintf("no iptables rules were found in the %s container of pod %s/%s; MeshConfig and the pod annotations do not exclude traffic to %s",
+			constants.InitContainerName, check.srcPod.Namespace, check.srcPod.Name, check.dstPod.Name)}
+	}
+	return outcomes.Pass{Diagnostics: fmt.Sprintf("traffic to the services of pod %s/%s is redirected to Envoy according to the iptables rules in %s", check.dstPod.Namespace, check.dstPod.Name, rulesSource)}
+}
+
+// Suggestion implements common.Runnable
+func (check OutboundInterceptionCheck) Suggestion() string {
+	return fmt.Sprintf("Remove the destination from the MeshConfig outboundIPRangeExclusionList and outboundPortExclusionList and from the %s annotation of pod %s/%s, then restart the pod",
+		annotations.OutboundPortExclusionList, check.srcPod.Namespace, check.srcPod.Name)
+}
+
+// FixIt implements common.Runnable
+func (check OutboundInterceptionCheck) FixIt() error {
+	panic("implement me")
+}
+
+// getDestinations returns the IP addresses and ports the source pod uses to reach the destination pod through its services.
+func (check OutboundInterceptionCheck) getDestinations(ctx context.Context) ([]destination, error) {
+	services, err := pod.GetMatchingServices(ctx, check.client, check.dstPod.Labels, check.dstPod.Namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to map pod %s/%s to Kubernetes services", check.dstPod.Namespace, check.dstPod.Name)
+	}
+
+	var destinations []destination
+	for _, svc := range services {
+		svcName := fmt.Sprintf("service %s/%s", svc.Namespace, svc.Name)
+		for _, svcPort := range svc.Spec.Ports {
+			// Headless services are addressed by pod IP and target port, other services by cluster IP and service port.
+			if svc.Spec.ClusterIP == corev1.ClusterIPNone {
+				ip := net.ParseIP(check.dstPod.Status.PodIP)
+				targetPort := getTargetPort(check.dstPod, svcPort)
+				if ip != nil && targetPort != 0 {
+					destinations = append(destinations, destination{service: svcName, ip: ip, port: targetPort})
+				}
+				continue
+			}
+			if ip := net.ParseIP(svc.Spec.ClusterIP); ip != nil {
+				destinations = append(destinations, destination{service: svcName, ip: ip, port: int(svcPort.Port)})
+			}
+		}
+	}
+	return destinations, nil
+}
+
+// exclusion is a reason why the current configuration excludes a destination from interception.
+type exclusion struct {
+	reason string
+	// byIP is true if the destination is excluded by IP address, false if it is excluded by port.
+	byIP bool
+}
+
+type exclusions []exclusion
+
+// explaining returns the exclusions which result in an iptables rule like the given one.
+func (e exclusions) explaining(rule iptablesRule) exclusions {
+	var explaining exclusions
+	for _, exclusion := range e {
+		if (exclusion.byIP && rule.destination != nil) || (!exclusion.byIP && len(rule.dstPorts) > 0) {
+			explaining = append(explaining, exclusion)
+		}
+	}
+	return explaining
+}
+
+func (e exclusions) String() string {
+	reasons := make([]string, len(e))
+	for i, exclusion := range e {
+		reasons[i] = exclusion.reason
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// getConfiguredExclusions returns why the current MeshConfig and pod annotations exclude the destination from interception.
+func (check OutboundInterceptionCheck) getConfiguredExclusions(traffic configv1alpha1.TrafficSpec, dst destination, annotatedPorts []int) exclusions {
+	var found exclusions
+	for _, cidr := range traffic.OutboundIPRangeExclusionList {
+		if ipNet := parseDestination(cidr); ipNet != nil && ipNet.Contains(dst.ip) {
+			found = append(found, exclusion{reason: fmt.Sprintf("MeshConfig outboundIPRangeExclusionList contains %s", cidr), byIP: true})
+		}
+	}
+	if containsPort(traffic.OutboundPortExclusionList, dst.port) {
+		found = append(found, exclusion{reason: fmt.Sprintf("MeshConfig outboundPortExclusionList contains port %d", dst.port)})
+	}
+	if containsPort(annotatedPorts, dst.port) {
+		found = append(found, exclusion{reason: fmt.Sprintf("the %s annotation of pod %s/%s contains port %d", annotations.OutboundPortExclusionList, check.srcPod.Namespace, check.srcPod.Name, dst.port)})
+	}
+	return found
+}
+
+// getOutboundRules returns the PROXY_OUTPUT rules set up by the osm-init container of the source pod and where they were found.
+// The rules printed in the osm-init container logs take precedence over the container command in the pod spec.
+func (check OutboundInterceptionCheck) getOutboundRules(ctx context.Context) (iptablesChain, string) {
+	initLogs, err := getContainerLogs(ctx, check.client, check.srcPod, constants.InitContainerName)
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to read %s container logs of pod %s/%s", constants.InitContainerName, check.srcPod.Namespace, check.srcPod.Name)
+	}
+	if rules := parseIptablesChain(initLogs, proxyOutputChain); len(rules) > 0 {
+		return rules, fmt.Sprintf("the %s container logs", constants.InitContainerName)
+	}
+
+	for _, container := range check.srcPod.Spec.InitContainers {
+		if container.Name != constants.InitContainerName {
+			continue
+		}
+		command := strings.Join(append(container.Command, container.Args...), "\n")
+		if rules := parseIptablesChain(command, proxyOutputChain); len(rules) > 0 {
+			return rules, fmt.Sprintf("the %s container command", constants.InitContainerName)
+		}
+	}
+	return nil, ""
+}
+
+// getContainerLogs returns all the logs of a container, falling back to the logs of its previous instance.
+func getContainerLogs(ctx context.Context, client kubernetes.Interface, p *corev1.Pod, containerName string) (string, error) {
+	podLogsOpt := corev1.PodLogOptions{Container: containerName}
+	podLogsReader, err := client.CoreV1().Pods(p.Namespace).GetLogs(p.Name, &podLogsOpt).Stream(ctx)
+	if err != nil {
+		podLogsOpt.Previous = true
+		podLogsReader, err = client.CoreV1().Pods(p.Namespace).GetLogs(p.Name, &podLogsOpt).Stream(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+	defer podLogsReader.Close() //nolint: errcheck,gosec
+
+	logs, err := ioutil.ReadAll(podLogsReader)
+	if err != nil {
+		return "", err
+	}
+	return string(logs), nil
+}
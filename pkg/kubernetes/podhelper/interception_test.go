@@ -0,0 +1,123 @@
+package podhelper
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/annotations"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+func TestOutboundInterceptionCheck(t *testing.T) {
+	dstPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bookstore-v1",
+			Namespace: "bookstore",
+			Labels:    map[string]string{"app": "bookstore"},
+		},
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bookstore",
+			Namespace: "bookstore",
+		},
+		Spec: corev1.ServiceSpec{
+			Selector:  map[string]string{"app": "bookstore"},
+			ClusterIP: "10.0.77.207",
+			Ports:     []corev1.ServicePort{{Port: 14001}},
+		},
+	}
+
+	tests := []struct {
+		name                   string
+		initCommand            string
+		podAnnotations         map[string]string
+		meshIPRangeExclusions  []string
+		meshPortExclusions     []int
+		expectedOutcome        outcomes.Outcome
+		expectedDiagnosticPart string
+	}{
+		{
+			name:            "traffic is redirected to envoy",
+			initCommand:     osmInitCommand,
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:                   "traffic bypasses envoy because of a MeshConfig IP range exclusion",
+			initCommand:            osmInitCommand + " && iptables -t nat -I PROXY_OUTPUT -d 10.0.77.0/24 -j RETURN",
+			meshIPRangeExclusions:  []string{"10.0.77.0/24"},
+			expectedOutcome:        outcomes.Warning{},
+			expectedDiagnosticPart: "MeshConfig outboundIPRangeExclusionList contains 10.0.77.0/24",
+		},
+		{
+			name:                   "traffic bypasses envoy because of a pod annotation",
+			initCommand:            osmInitCommand + " && iptables -t nat -I PROXY_OUTPUT -p tcp --match multiport --dports 14001 -j RETURN",
+			podAnnotations:         map[string]string{annotations.OutboundPortExclusionList: "14001"},
+			expectedOutcome:        outcomes.Warning{},
+			expectedDiagnosticPart: "annotation of pod bookbuyer/bookbuyer contains port 14001",
+		},
+		{
+			name:                   "exclusion added to MeshConfig after the pod was injected",
+			initCommand:            osmInitCommand,
+			meshPortExclusions:     []int{14001},
+			expectedOutcome:        outcomes.Warning{},
+			expectedDiagnosticPart: "will bypass Envoy once the pod is restarted",
+		},
+		{
+			name:                   "no iptables rules found",
+			meshPortExclusions:     []int{14001},
+			expectedOutcome:        outcomes.Warning{},
+			expectedDiagnosticPart: "MeshConfig outboundPortExclusionList contains port 14001",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			meshConfig := config.NewSnapshot(&configv1alpha1.MeshConfig{Spec: configv1alpha1.MeshConfigSpec{
+				Traffic: configv1alpha1.TrafficSpec{
+					OutboundIPRangeExclusionList: test.meshIPRangeExclusions,
+					OutboundPortExclusionList:    test.meshPortExclusions,
+				},
+			}})
+
+			srcPod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "bookbuyer",
+					Namespace:   "bookbuyer",
+					Annotations: test.podAnnotations,
+				},
+			}
+			if test.initCommand != "" {
+				srcPod.Spec.InitContainers = []corev1.Container{
+					{
+						Name:    constants.InitContainerName,
+						Command: []string{"/bin/sh"},
+						Args:    []string{"-c", test.initCommand},
+					},
+				}
+			}
+
+			outcome := NewOutboundInterceptionCheck(fake.NewSimpleClientset(svc), meshConfig, srcPod, dstPod).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.Contains(outcome.GetDiagnostics(), test.expectedDiagnosticPart)
+		})
+	}
+}
+
+func TestOutboundInterceptionCheckMeshConfigUnavailable(t *testing.T) {
+	assert := tassert.New(t)
+	srcPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "bookbuyer", Namespace: "bookbuyer"}}
+	dstPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "bookstore-v1", Namespace: "bookstore"}}
+
+	outcome := NewOutboundInterceptionCheck(fake.NewSimpleClientset(), config.NewSnapshot(nil), srcPod, dstPod).Run(context.TODO())
+	assert.ErrorIs(outcome.GetError(), config.ErrMeshConfigUnavailable)
+}
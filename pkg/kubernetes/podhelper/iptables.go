@@ -0,0 +1,162 @@
+package podhelper
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	// proxyOutputChain is the iptables chain the osm-init container creates to intercept outbound traffic.
+	// Source: https://github.com/openservicemesh/osm/blob/release-v0.9/pkg/injector/iptables.go
+	proxyOutputChain = "PROXY_OUTPUT"
+
+	// proxyRedirectChain is the iptables chain that redirects outbound traffic to the Envoy outbound listener.
+	proxyRedirectChain = "PROXY_REDIRECT"
+
+	iptablesReturn = "RETURN"
+)
+
+// iptablesRule is a rule of the nat table parsed from an iptables command or from iptables-save output.
+type iptablesRule struct {
+	// text is the rule as it was found, used to explain which rule matched.
+	text        string
+	chain       string
+	destination *net.IPNet
+	dstPorts    []int
+	uidOwner    string
+	target      string
+}
+
+// matches returns true if outbound TCP traffic from the application to the given IP and port matches the rule.
+func (r iptablesRule) matches(ip net.IP, port int) bool {
+	// Rules matching on the owner UID only apply to traffic sent by the Envoy sidecar itself.
+	if r.uidOwner != "" {
+		return false
+	}
+	if r.destination != nil && !r.destination.Contains(ip) {
+		return false
+	}
+	if len(r.dstPorts) > 0 && !containsPort(r.dstPorts, port) {
+		return false
+	}
+	return true
+}
+
+// iptablesChain is an ordered list of rules of a single iptables chain.
+type iptablesChain []iptablesRule
+
+// parseIptablesChain extracts the rules of the given chain from text containing iptables commands, for example
+// the osm-init container command, or iptables-save output, for example printed in the osm-init container logs.
+// The rules are ordered the way iptables evaluates them, taking inserts (-I) and appends (-A) into account.
+func parseIptablesChain(text string, chain string) iptablesChain {
+	var rules iptablesChain
+	for _, line := range strings.Split(text, "\n") {
+		for _, command := range strings.Split(line, "&&") {
+			rule, insertAt, ok := parseIptablesRule(command, chain)
+			if !ok {
+				continue
+			}
+			if insertAt < 0 || insertAt > len(rules) {
+				rules = append(rules, rule)
+				continue
+			}
+			rules = append(rules[:insertAt], append(iptablesChain{rule}, rules[insertAt:]...)...)
+		}
+	}
+	return rules
+}
+
+// parseIptablesRule parses a single iptables command or iptables-save line if it appends or inserts a rule into the given chain.
+// It returns the index the rule is inserted at, or -1 if the rule is appended.
+func parseIptablesRule(command string, chain string) (iptablesRule, int, bool) {
+	fields := strings.Fields(command)
+	rule := iptablesRule{text: strings.TrimSpace(command), chain: chain}
+	insertAt := -1
+	found := false
+
+	for i := 0; i < len(fields); i++ {
+		next := func() string {
+			if i+1 < len(fields) {
+				i++
+				return fields[i]
+			}
+			return ""
+		}
+
+		switch fields[i] {
+		case "-A", "--append":
+			if next() != chain {
+				return iptablesRule{}, 0, false
+			}
+			found = true
+		case "-I", "--insert":
+			if next() != chain {
+				return iptablesRule{}, 0, false
+			}
+			found = true
+			insertAt = 0
+			// An optional 1-based rule number may follow the chain name.
+			if i+1 < len(fields) {
+				if position, err := strconv.Atoi(fields[i+1]); err == nil {
+					insertAt = position - 1
+					i++
+				}
+			}
+		case "-d", "--destination":
+			rule.destination = parseDestination(next())
+		case "--dport", "--destination-port", "--dports", "--destination-ports":
+			rule.dstPorts = parsePorts(next())
+		case "--uid-owner":
+			rule.uidOwner = next()
+		case "-j", "--jump":
+			rule.target = next()
+		}
+	}
+
+	return rule, insertAt, found
+}
+
+func parseDestination(destination string) *net.IPNet {
+	if !strings.Contains(destination, "/") {
+		destination += "/32"
+	}
+	_, ipNet, err := net.ParseCIDR(destination)
+	if err != nil {
+		return nil
+	}
+	return ipNet
+}
+
+func parsePorts(ports string) []int {
+	var parsed []int
+	for _, port := range strings.Split(ports, ",") {
+		// Port ranges are written as first:last.
+		bounds := strings.SplitN(port, ":", 2)
+		first, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			continue
+		}
+		last := first
+		if len(bounds) == 2 {
+			if last, err = strconv.Atoi(bounds[1]); err != nil {
+				continue
+			}
+		}
+		for p := first; p <= last; p++ {
+			parsed = append(parsed, p)
+		}
+	}
+	return parsed
+}
+
+// evaluateOutbound returns the first rule of the chain matching outbound traffic to the given IP and port.
+// The traffic is intercepted if the matching rule jumps to the PROXY_REDIRECT chain.
+func (c iptablesChain) evaluateOutbound(ip net.IP, port int) (iptablesRule, bool) {
+	for _, rule := range c {
+		if rule.matches(ip, port) {
+			return rule, true
+		}
+	}
+	return iptablesRule{}, false
+}
@@ -0,0 +1,89 @@
+package podhelper
+
+import (
+	"net"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+const osmInitCommand = "iptables -t nat -N PROXY_INBOUND && iptables -t nat -N PROXY_OUTPUT && iptables -t nat -N PROXY_REDIRECT && " +
+	"iptables -t nat -A PROXY_REDIRECT -p tcp -j REDIRECT --to-port 15001 && " +
+	"iptables -t nat -A OUTPUT -p tcp -j PROXY_OUTPUT && " +
+	"iptables -t nat -A PROXY_OUTPUT -m owner --uid-owner 1500 -j RETURN && " +
+	"iptables -t nat -A PROXY_OUTPUT -d 127.0.0.1/32 -j RETURN && " +
+	"iptables -t nat -A PROXY_OUTPUT -j PROXY_REDIRECT && " +
+	"iptables -t nat -I PROXY_OUTPUT -d 10.100.0.0/16 -j RETURN && " +
+	"iptables -t nat -I PROXY_OUTPUT -p tcp --match multiport --dports 6379,7000:7002 -j RETURN"
+
+const iptablesSaveOutput = `*nat
+:PROXY_OUTPUT - [0:0]
+-A OUTPUT -p tcp -j PROXY_OUTPUT
+-A PROXY_OUTPUT -p tcp -m multiport --dports 6379 -j RETURN
+-A PROXY_OUTPUT -m owner --uid-owner 1500 -j RETURN
+-A PROXY_OUTPUT -d 127.0.0.1/32 -j RETURN
+-A PROXY_OUTPUT -j PROXY_REDIRECT
+COMMIT`
+
+func TestEvaluateOutbound(t *testing.T) {
+	tests := []struct {
+		name           string
+		text           string
+		ip             string
+		port           int
+		expectedTarget string
+		expectedRule   string
+	}{
+		{
+			name:           "traffic to a cluster IP is redirected",
+			text:           osmInitCommand,
+			ip:             "10.1.0.5",
+			port:           80,
+			expectedTarget: proxyRedirectChain,
+			expectedRule:   "iptables -t nat -A PROXY_OUTPUT -j PROXY_REDIRECT",
+		},
+		{
+			name:           "traffic to an excluded IP range returns",
+			text:           osmInitCommand,
+			ip:             "10.100.3.4",
+			port:           80,
+			expectedTarget: iptablesReturn,
+			expectedRule:   "iptables -t nat -I PROXY_OUTPUT -d 10.100.0.0/16 -j RETURN",
+		},
+		{
+			name:           "traffic to a port in an excluded range returns",
+			text:           osmInitCommand,
+			ip:             "10.1.0.5",
+			port:           7001,
+			expectedTarget: iptablesReturn,
+			expectedRule:   "iptables -t nat -I PROXY_OUTPUT -p tcp --match multiport --dports 6379,7000:7002 -j RETURN",
+		},
+		{
+			name:           "iptables-save output",
+			text:           iptablesSaveOutput,
+			ip:             "10.1.0.5",
+			port:           6379,
+			expectedTarget: iptablesReturn,
+			expectedRule:   "-A PROXY_OUTPUT -p tcp -m multiport --dports 6379 -j RETURN",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			rules := parseIptablesChain(test.text, proxyOutputChain)
+			rule, found := rules.evaluateOutbound(net.ParseIP(test.ip), test.port)
+			assert.True(found)
+			assert.Equal(test.expectedTarget, rule.target)
+			assert.Equal(test.expectedRule, rule.text)
+		})
+	}
+}
+
+func TestParseIptablesChainInsertPosition(t *testing.T) {
+	assert := tassert.New(t)
+	rules := parseIptablesChain("-A PROXY_OUTPUT -d 10.0.0.1 -j RETURN\n-A PROXY_OUTPUT -j PROXY_REDIRECT\n-I PROXY_OUTPUT 2 -d 10.0.0.2 -j RETURN", proxyOutputChain)
+	assert.Len(rules, 3)
+	assert.Equal("10.0.0.2/32", rules[1].destination.String())
+	assert.Equal(proxyRedirectChain, rules[2].target)
+}
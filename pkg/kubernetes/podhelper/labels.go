@@ -1,6 +1,7 @@
 package podhelper
 
 import (
+	"context"
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
@@ -31,7 +32,7 @@ func (check ProxyUUIDLabelCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (check ProxyUUIDLabelCheck) Run() outcomes.Outcome {
+func (check ProxyUUIDLabelCheck) Run(ctx context.Context) outcomes.Outcome {
 	if !mesh.ProxyLabelExists(*check.pod) {
 		return outcomes.Fail{Error: ErrProxyUUIDLabelMissing}
 	}
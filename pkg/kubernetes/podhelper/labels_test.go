@@ -1,6 +1,7 @@
 package podhelper
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/uuid"
@@ -55,6 +56,6 @@ func TestHasProxyUUIDLabel(t *testing.T) {
 	for _, tc := range testCases {
 		proxyUUIDLabelChecker := NewProxyUUIDLabelCheck(&tc.pod)
 
-		assert.Equal(tc.expectedError, proxyUUIDLabelChecker.Run().GetError())
+		assert.Equal(tc.expectedError, proxyUUIDLabelChecker.Run(context.TODO()).GetError())
 	}
 }
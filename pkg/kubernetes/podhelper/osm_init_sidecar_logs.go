@@ -1,12 +1,14 @@
 package podhelper
 
 import (
+	"context"
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/logs"
 	"github.com/openservicemesh/osm-health/pkg/runner"
 	"github.com/openservicemesh/osm/pkg/constants"
 )
@@ -16,26 +18,28 @@ var _ runner.Runnable = (*NoBadOsmInitLogsCheck)(nil)
 
 // NoBadOsmInitLogsCheck implements common.Runnable
 type NoBadOsmInitLogsCheck struct {
-	client kubernetes.Interface
-	pod    *corev1.Pod
+	client     kubernetes.Interface
+	pod        *corev1.Pod
+	logOptions logs.Options
 }
 
-// HasNoBadOsmInitLogsCheck checks whether the osm-init container of the pod has bad (fatal/error/warning/fail) log messages
-func HasNoBadOsmInitLogsCheck(client kubernetes.Interface, pod *corev1.Pod) NoBadOsmInitLogsCheck {
+// HasNoBadOsmInitLogsCheck checks whether the osm-init container of the pod has log messages which indicate problems
+func HasNoBadOsmInitLogsCheck(client kubernetes.Interface, pod *corev1.Pod, logOptions logs.Options) NoBadOsmInitLogsCheck {
 	return NoBadOsmInitLogsCheck{
-		client: client,
-		pod:    pod,
+		client:     client,
+		pod:        pod,
+		logOptions: logOptions,
 	}
 }
 
 // Description implements common.Runnable
 func (check NoBadOsmInitLogsCheck) Description() string {
-	return fmt.Sprintf("Checking whether pod %s has bad (fatal/error/warning/fail) logs in %s container", check.pod.Name, constants.InitContainerName)
+	return fmt.Sprintf("Checking whether pod %s has errors or warnings in %s container logs", check.pod.Name, constants.InitContainerName)
 }
 
 // Run implements common.Runnable
-func (check NoBadOsmInitLogsCheck) Run() outcomes.Outcome {
-	return HasNoBadLogs(check.client, check.pod, constants.InitContainerName)
+func (check NoBadOsmInitLogsCheck) Run(ctx context.Context) outcomes.Outcome {
+	return HasNoBadLogs(ctx, check.client, check.pod, constants.InitContainerName, logs.FormatPlain, check.logOptions)
 }
 
 // Suggestion implements common.Runnable.
@@ -0,0 +1,103 @@
+package podhelper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*ProbesRewrittenCheck)(nil)
+
+// ProbesRewrittenCheck implements common.Runnable
+type ProbesRewrittenCheck struct {
+	pod        *corev1.Pod
+	osmVersion version.ControllerVersion
+}
+
+// NewProbesRewrittenCheck creates a ProbesRewrittenCheck which checks whether the HTTP probes of the app containers of
+// a pod were rewritten to go through the Envoy sidecar, for OSM versions whose sidecar injector rewrites them
+func NewProbesRewrittenCheck(pod *corev1.Pod, osmVersion version.ControllerVersion) ProbesRewrittenCheck {
+	return ProbesRewrittenCheck{
+		pod:        pod,
+		osmVersion: osmVersion,
+	}
+}
+
+// Description implements common.Runnable
+func (check ProbesRewrittenCheck) Description() string {
+	return fmt.Sprintf("Checking whether the HTTP probes of pod %s go through its Envoy sidecar", check.pod.Name)
+}
+
+// Run implements common.Runnable
+func (check ProbesRewrittenCheck) Run(ctx context.Context) outcomes.Outcome {
+	sidecarProbes, known := version.SidecarProbes[check.osmVersion]
+	if !known {
+		return outcomes.Skipped{Reason: fmt.Sprintf("osm-health does not know how OSM %s rewrites the probes of meshed pods; describe them with --version-capabilities-file", check.osmVersion)}
+	}
+	if len(sidecarProbes) == 0 {
+		return outcomes.Skipped{Reason: fmt.Sprintf("OSM %s does not rewrite the probes of meshed pods", check.osmVersion)}
+	}
+
+	var notRewritten []string
+	for idx := range check.pod.Spec.Containers {
+		container := &check.pod.Spec.Containers[idx]
+		if container.Name == constants.EnvoyContainerName {
+			continue
+		}
+		for probeType, probe := range ContainerProbes(container) {
+			if !IsHTTPProbe(probe) {
+				continue
+			}
+			expected := sidecarProbes[probeType]
+			if int32(probe.HTTPGet.Port.IntValue()) != expected.Port || probe.HTTPGet.Path != expected.Path {
+				notRewritten = append(notRewritten, fmt.Sprintf("the %s probe of container %s is :%s%s instead of :%d%s",
+					probeType, container.Name, probe.HTTPGet.Port.String(), probe.HTTPGet.Path, expected.Port, expected.Path))
+			}
+		}
+	}
+
+	if len(notRewritten) > 0 {
+		return outcomes.Fail{Error: errors.Wrap(ErrProbeNotRewritten, strings.Join(notRewritten, "; "))}
+	}
+	return outcomes.Pass{}
+}
+
+// Suggestion implements common.Runnable
+func (check ProbesRewrittenCheck) Suggestion() string {
+	return fmt.Sprintf("Probes are rewritten when the Envoy sidecar is injected. Verify that the pod was created after sidecar injection was enabled for namespace %s, and recreate it otherwise. Try: \"kubectl get pod %s -n %s -o yaml\"",
+		check.pod.Namespace, check.pod.Name, check.pod.Namespace)
+}
+
+// FixIt implements common.Runnable
+func (check ProbesRewrittenCheck) FixIt() error {
+	panic("implement me")
+}
+
+// ContainerProbes returns the probes of a container by type.
+func ContainerProbes(container *corev1.Container) map[version.ProbeType]*corev1.Probe {
+	probes := make(map[version.ProbeType]*corev1.Probe)
+	if container.LivenessProbe != nil {
+		probes[version.LivenessProbe] = container.LivenessProbe
+	}
+	if container.ReadinessProbe != nil {
+		probes[version.ReadinessProbe] = container.ReadinessProbe
+	}
+	if container.StartupProbe != nil {
+		probes[version.StartupProbe] = container.StartupProbe
+	}
+	return probes
+}
+
+// IsHTTPProbe returns whether a probe is an HTTP GET probe, as opposed to an HTTPS, TCP or exec probe.
+func IsHTTPProbe(probe *corev1.Probe) bool {
+	return probe.HTTPGet != nil && (probe.HTTPGet.Scheme == "" || probe.HTTPGet.Scheme == corev1.URISchemeHTTP)
+}
@@ -0,0 +1,93 @@
+package podhelper
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+func TestProbesRewrittenCheck(t *testing.T) {
+	httpProbe := func(port int, path string) *corev1.Probe {
+		return &corev1.Probe{Handler: corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Port: intstr.FromInt(port), Path: path}}}
+	}
+
+	tests := []struct {
+		name             string
+		osmVersion       version.ControllerVersion
+		container        corev1.Container
+		expectedOutcome  outcomes.Outcome
+		expectedErrorMsg string
+	}{
+		{
+			name:       "rewritten probes",
+			osmVersion: "v0.9",
+			container: corev1.Container{
+				Name:           "bookstore",
+				LivenessProbe:  httpProbe(15901, "/osm-liveness-probe"),
+				ReadinessProbe: httpProbe(15902, "/osm-readiness-probe"),
+			},
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:       "readiness probe not rewritten",
+			osmVersion: "v0.9",
+			container: corev1.Container{
+				Name:           "bookstore",
+				LivenessProbe:  httpProbe(15901, "/osm-liveness-probe"),
+				ReadinessProbe: httpProbe(14001, "/ready"),
+			},
+			expectedOutcome:  outcomes.Fail{},
+			expectedErrorMsg: "the readiness probe of container bookstore is :14001/ready instead of :15902/osm-readiness-probe: HTTP probe not rewritten to go through the Envoy sidecar",
+		},
+		{
+			name:       "TCP and HTTPS probes are not rewritten",
+			osmVersion: "v0.9",
+			container: corev1.Container{
+				Name:          "bookstore",
+				LivenessProbe: &corev1.Probe{Handler: corev1.Handler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(14001)}}},
+				ReadinessProbe: &corev1.Probe{Handler: corev1.Handler{HTTPGet: &corev1.HTTPGetAction{
+					Port: intstr.FromInt(14001), Path: "/ready", Scheme: corev1.URISchemeHTTPS,
+				}}},
+			},
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:       "OSM version whose probes are unknown",
+			osmVersion: "v0.5",
+			container: corev1.Container{
+				Name:          "bookstore",
+				LivenessProbe: httpProbe(14001, "/liveness"),
+			},
+			expectedOutcome: outcomes.Skipped{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "bookstore-v1-a", Namespace: "bookstore"},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{
+					test.container,
+					{Name: constants.EnvoyContainerName, ReadinessProbe: httpProbe(15000, "/ready")},
+				}},
+			}
+
+			outcome := NewProbesRewrittenCheck(pod, test.osmVersion).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			if test.expectedErrorMsg != "" {
+				assert.EqualError(outcome.GetError(), test.expectedErrorMsg)
+			} else {
+				assert.Nil(outcome.GetError())
+			}
+		})
+	}
+}
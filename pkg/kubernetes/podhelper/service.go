@@ -1,6 +1,7 @@
 package podhelper
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -35,9 +36,9 @@ func (check ServiceCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (check ServiceCheck) Run() outcomes.Outcome {
+func (check ServiceCheck) Run(ctx context.Context) outcomes.Outcome {
 	ns := check.pod.Namespace
-	services, err := pod.GetMatchingServices(check.client, check.pod.ObjectMeta.GetLabels(), ns)
+	services, err := pod.GetMatchingServices(ctx, check.client, check.pod.ObjectMeta.GetLabels(), ns)
 	if err != nil {
 		return outcomes.Fail{Error: err}
 	}
@@ -48,7 +49,7 @@ func (check ServiceCheck) Run() outcomes.Outcome {
 	for _, s := range services {
 		svcNames = append(svcNames, s.ObjectMeta.Name)
 	}
-	return outcomes.Pass{Msg: fmt.Sprintf("found service(s) %v for destination pod '%s/%s'", svcNames, ns, check.pod.Name)}
+	return outcomes.Pass{Diagnostics: fmt.Sprintf("found service(s) %v for destination pod '%s/%s'", svcNames, ns, check.pod.Name)}
 }
 
 // Suggestion implements common.Runnable
@@ -0,0 +1,222 @@
+package podhelper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+const (
+	// reasonCrashLoopBackOff is the reason a container waits to be restarted after failing repeatedly.
+	reasonCrashLoopBackOff = "CrashLoopBackOff"
+
+	// reasonOOMKilled is the reason a container was terminated for exceeding its memory limit.
+	reasonOOMKilled = "OOMKilled"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*PodReadyCheck)(nil)
+
+// PodReadyCheck implements common.Runnable
+type PodReadyCheck struct {
+	pod *corev1.Pod
+}
+
+// NewPodReadyCheck creates a PodReadyCheck which checks whether a pod is running and ready to serve traffic
+func NewPodReadyCheck(pod *corev1.Pod) PodReadyCheck {
+	return PodReadyCheck{
+		pod: pod,
+	}
+}
+
+// Description implements common.Runnable
+func (check PodReadyCheck) Description() string {
+	return fmt.Sprintf("Checking whether pod %s is running and ready", check.pod.Name)
+}
+
+// Run implements common.Runnable
+func (check PodReadyCheck) Run(ctx context.Context) outcomes.Outcome {
+	status := check.pod.Status
+	if status.Phase != corev1.PodRunning {
+		return outcomes.Fail{
+			Error:   ErrPodNotRunning,
+			Details: map[string]interface{}{"phase": status.Phase, "reason": status.Reason},
+		}
+	}
+
+	var notReadyContainers []string
+	for _, containerStatus := range status.ContainerStatuses {
+		if !containerStatus.Ready {
+			notReadyContainers = append(notReadyContainers, containerStatus.Name)
+		}
+	}
+	for _, condition := range status.Conditions {
+		if condition.Type != corev1.PodReady {
+			continue
+		}
+		if condition.Status == corev1.ConditionTrue {
+			return outcomes.Pass{}
+		}
+		return outcomes.Fail{
+			Error: ErrPodNotReady,
+			Details: map[string]interface{}{
+				"reason":             condition.Reason,
+				"message":            condition.Message,
+				"notReadyContainers": strings.Join(notReadyContainers, ","),
+			},
+		}
+	}
+	return outcomes.Fail{Error: ErrPodNotReady}
+}
+
+// Suggestion implements common.Runnable
+func (check PodReadyCheck) Suggestion() string {
+	return fmt.Sprintf("Check the conditions, container states and events of the pod. Try: \"kubectl describe pod %s -n %s\"", check.pod.Name, check.pod.Namespace)
+}
+
+// FixIt implements common.Runnable
+func (check PodReadyCheck) FixIt() error {
+	panic("implement me")
+}
+
+// Verify interface compliance
+var _ runner.Runnable = (*ContainerRestartsCheck)(nil)
+
+// ContainerRestartsCheck implements common.Runnable
+type ContainerRestartsCheck struct {
+	pod *corev1.Pod
+}
+
+// NewContainerRestartsCheck creates a ContainerRestartsCheck which checks whether the app and envoy containers of a pod
+// are crash looping, were killed for running out of memory, or restarted
+func NewContainerRestartsCheck(pod *corev1.Pod) ContainerRestartsCheck {
+	return ContainerRestartsCheck{
+		pod: pod,
+	}
+}
+
+// Description implements common.Runnable
+func (check ContainerRestartsCheck) Description() string {
+	return fmt.Sprintf("Checking whether the containers of pod %s are not restarting", check.pod.Name)
+}
+
+// Run implements common.Runnable
+func (check ContainerRestartsCheck) Run(ctx context.Context) outcomes.Outcome {
+	var restarts []string
+	for _, containerStatus := range check.pod.Status.ContainerStatuses {
+		lastTerminated := containerStatus.LastTerminationState.Terminated
+		details := map[string]interface{}{
+			"container": containerStatus.Name,
+			"restarts":  containerStatus.RestartCount,
+		}
+		if lastTerminated != nil {
+			details["lastTerminationReason"] = lastTerminated.Reason
+			details["lastExitCode"] = lastTerminated.ExitCode
+		}
+
+		if waiting := containerStatus.State.Waiting; waiting != nil && waiting.Reason == reasonCrashLoopBackOff {
+			return outcomes.Fail{Error: ErrContainerCrashLoopBackOff, Details: details}
+		}
+		if terminated := containerStatus.State.Terminated; terminated != nil && terminated.Reason == reasonOOMKilled {
+			return outcomes.Fail{Error: ErrContainerOOMKilled, Details: details}
+		}
+		if lastTerminated != nil && lastTerminated.Reason == reasonOOMKilled {
+			return outcomes.Fail{Error: ErrContainerOOMKilled, Details: details}
+		}
+
+		if containerStatus.RestartCount > 0 {
+			restart := fmt.Sprintf("container %s restarted %d times", containerStatus.Name, containerStatus.RestartCount)
+			if lastTerminated != nil {
+				restart += fmt.Sprintf(", last terminated with reason %s and exit code %d", lastTerminated.Reason, lastTerminated.ExitCode)
+			}
+			restarts = append(restarts, restart)
+		}
+	}
+
+	if len(restarts) > 0 {
+		return outcomes.Warning{Diagnostics: strings.Join(restarts, "; ")}
+	}
+	return outcomes.Pass{}
+}
+
+// Suggestion implements common.Runnable
+func (check ContainerRestartsCheck) Suggestion() string {
+	return fmt.Sprintf("Check the logs of the previous run of the restarting container and its memory limits. Try: \"kubectl logs %s -n %s -c <container> --previous\" and \"kubectl describe pod %s -n %s\"",
+		check.pod.Name, check.pod.Namespace, check.pod.Name, check.pod.Namespace)
+}
+
+// FixIt implements common.Runnable
+func (check ContainerRestartsCheck) FixIt() error {
+	panic("implement me")
+}
+
+// Verify interface compliance
+var _ runner.Runnable = (*OsmInitExitCodeCheck)(nil)
+
+// OsmInitExitCodeCheck implements common.Runnable
+type OsmInitExitCodeCheck struct {
+	pod *corev1.Pod
+}
+
+// NewOsmInitExitCodeCheck creates an OsmInitExitCodeCheck which checks whether the osm-init container of a pod
+// completed successfully
+func NewOsmInitExitCodeCheck(pod *corev1.Pod) OsmInitExitCodeCheck {
+	return OsmInitExitCodeCheck{
+		pod: pod,
+	}
+}
+
+// Description implements common.Runnable
+func (check OsmInitExitCodeCheck) Description() string {
+	return fmt.Sprintf("Checking whether the %s container of pod %s completed successfully", constants.InitContainerName, check.pod.Name)
+}
+
+// Run implements common.Runnable
+func (check OsmInitExitCodeCheck) Run(ctx context.Context) outcomes.Outcome {
+	for _, containerStatus := range check.pod.Status.InitContainerStatuses {
+		if containerStatus.Name != constants.InitContainerName {
+			continue
+		}
+
+		terminated := containerStatus.State.Terminated
+		if terminated == nil {
+			// An init container that failed waits to be restarted, with its failure as the last termination state
+			terminated = containerStatus.LastTerminationState.Terminated
+		}
+		switch {
+		case terminated == nil:
+			return outcomes.Fail{Error: ErrOsmInitNotCompleted}
+		case terminated.ExitCode != 0:
+			return outcomes.Fail{
+				Error: ErrOsmInitFailed,
+				Details: map[string]interface{}{
+					"exitCode": terminated.ExitCode,
+					"reason":   terminated.Reason,
+					"restarts": containerStatus.RestartCount,
+				},
+			}
+		case containerStatus.State.Terminated == nil:
+			return outcomes.Fail{Error: ErrOsmInitNotCompleted}
+		default:
+			return outcomes.Pass{}
+		}
+	}
+	return outcomes.Skipped{Reason: fmt.Sprintf("pod %s has no status for a %s container", check.pod.Name, constants.InitContainerName)}
+}
+
+// Suggestion implements common.Runnable
+func (check OsmInitExitCodeCheck) Suggestion() string {
+	return fmt.Sprintf("Check the %s container logs for the iptables command that failed. Try: \"kubectl logs %s -n %s -c %s\"",
+		constants.InitContainerName, check.pod.Name, check.pod.Namespace, constants.InitContainerName)
+}
+
+// FixIt implements common.Runnable
+func (check OsmInitExitCodeCheck) FixIt() error {
+	panic("implement me")
+}
@@ -0,0 +1,197 @@
+package podhelper
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+func TestPodReadyCheck(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          corev1.PodStatus
+		expectedOutcome outcomes.Outcome
+		expectedError   error
+	}{
+		{
+			name: "running and ready",
+			status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "pending",
+			status:          corev1.PodStatus{Phase: corev1.PodPending},
+			expectedOutcome: outcomes.Fail{},
+			expectedError:   ErrPodNotRunning,
+		},
+		{
+			name: "running but not ready",
+			status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse, Reason: "ContainersNotReady"}},
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "bookstore", Ready: false},
+					{Name: constants.EnvoyContainerName, Ready: true},
+				},
+			},
+			expectedOutcome: outcomes.Fail{},
+			expectedError:   ErrPodNotReady,
+		},
+		{
+			name:            "running without a Ready condition",
+			status:          corev1.PodStatus{Phase: corev1.PodRunning},
+			expectedOutcome: outcomes.Fail{},
+			expectedError:   ErrPodNotReady,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "bookstore-v1-a"}, Status: test.status}
+
+			outcome := NewPodReadyCheck(pod).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.Equal(test.expectedError, outcome.GetError())
+		})
+	}
+}
+
+func TestContainerRestartsCheck(t *testing.T) {
+	tests := []struct {
+		name              string
+		containerStatuses []corev1.ContainerStatus
+		expectedOutcome   outcomes.Outcome
+		expectedError     error
+	}{
+		{
+			name: "no restarts",
+			containerStatuses: []corev1.ContainerStatus{
+				{Name: "bookstore"},
+				{Name: constants.EnvoyContainerName},
+			},
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name: "envoy in CrashLoopBackOff",
+			containerStatuses: []corev1.ContainerStatus{
+				{Name: "bookstore"},
+				{
+					Name:         constants.EnvoyContainerName,
+					RestartCount: 5,
+					State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+				},
+			},
+			expectedOutcome: outcomes.Fail{},
+			expectedError:   ErrContainerCrashLoopBackOff,
+		},
+		{
+			name: "app container last OOMKilled",
+			containerStatuses: []corev1.ContainerStatus{
+				{
+					Name:                 "bookstore",
+					RestartCount:         1,
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+				},
+			},
+			expectedOutcome: outcomes.Fail{},
+			expectedError:   ErrContainerOOMKilled,
+		},
+		{
+			name: "app container restarted",
+			containerStatuses: []corev1.ContainerStatus{
+				{
+					Name:                 "bookstore",
+					RestartCount:         2,
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}},
+				},
+			},
+			expectedOutcome: outcomes.Warning{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "bookstore-v1-a"},
+				Status:     corev1.PodStatus{ContainerStatuses: test.containerStatuses},
+			}
+
+			outcome := NewContainerRestartsCheck(pod).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.Equal(test.expectedError, outcome.GetError())
+		})
+	}
+}
+
+func TestOsmInitExitCodeCheck(t *testing.T) {
+	tests := []struct {
+		name                  string
+		initContainerStatuses []corev1.ContainerStatus
+		expectedOutcome       outcomes.Outcome
+		expectedError         error
+	}{
+		{
+			name: "osm-init completed",
+			initContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:  constants.InitContainerName,
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}},
+				},
+			},
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name: "osm-init failed and waits to be restarted",
+			initContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:                 constants.InitContainerName,
+					RestartCount:         3,
+					State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 2}},
+				},
+			},
+			expectedOutcome: outcomes.Fail{},
+			expectedError:   ErrOsmInitFailed,
+		},
+		{
+			name: "osm-init running",
+			initContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:  constants.InitContainerName,
+					State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				},
+			},
+			expectedOutcome: outcomes.Fail{},
+			expectedError:   ErrOsmInitNotCompleted,
+		},
+		{
+			name:            "no osm-init status",
+			expectedOutcome: outcomes.Skipped{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "bookstore-v1-a"},
+				Status:     corev1.PodStatus{InitContainerStatuses: test.initContainerStatuses},
+			}
+
+			outcome := NewOsmInitExitCodeCheck(pod).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.Equal(test.expectedError, outcome.GetError())
+		})
+	}
+}
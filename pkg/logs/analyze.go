@@ -0,0 +1,96 @@
+package logs
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+// maxUnknownLines is the maximum number of flagged log lines without a known signature shown in the diagnostics.
+const maxUnknownLines = 5
+
+// Analysis is the result of analyzing the logs of a container.
+type Analysis struct {
+	Container string
+	Findings  []Finding
+}
+
+// Analyze parses the given log lines and flags the entries which indicate problems: entries matching a signature in
+// the Catalog, and warning or more severe entries. Lines matching the built-in or the given allowlist are ignored.
+func Analyze(container string, lines []string, format Format, allowlist []*regexp.Regexp) Analysis {
+	analysis := Analysis{Container: container}
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" || isAllowed(line, allowlist) {
+			continue
+		}
+		entry := Parse(line, format)
+		log.Debug().Msgf("%s: [%s] %s", container, entry.Level, entry.Message)
+
+		signature := findSignature(entry, format)
+		if signature == nil && entry.Level < LevelWarning {
+			continue
+		}
+		analysis.Findings = append(analysis.Findings, Finding{Entry: entry, Signature: signature})
+	}
+	return analysis
+}
+
+// Outcome returns a Fail outcome if an error was found in the logs, a Warning outcome if only warnings were found,
+// and a Pass outcome otherwise.
+func (a Analysis) Outcome() outcomes.Outcome {
+	if len(a.Findings) == 0 {
+		return outcomes.Pass{}
+	}
+
+	var signatureDiagnostics, unknownLines []string
+	counts := make(map[*Signature]int)
+	var signatures []*Signature
+	level := LevelWarning
+	for _, finding := range a.Findings {
+		findingLevel := finding.Entry.Level
+		if finding.Signature != nil {
+			findingLevel = finding.Signature.Level
+			if counts[finding.Signature] == 0 {
+				signatures = append(signatures, finding.Signature)
+			}
+			counts[finding.Signature]++
+		} else {
+			unknownLines = append(unknownLines, finding.Entry.Raw)
+		}
+		if findingLevel > level {
+			level = findingLevel
+		}
+	}
+
+	sort.SliceStable(signatures, func(i, j int) bool { return signatures[i].Level > signatures[j].Level })
+	for _, signature := range signatures {
+		signatureDiagnostics = append(signatureDiagnostics, fmt.Sprintf("[%s] %s (%d occurrences) Suggestion: %s",
+			signature.ID, signature.Explanation, counts[signature], signature.Suggestion))
+	}
+	if len(unknownLines) > maxUnknownLines {
+		omitted := len(unknownLines) - maxUnknownLines
+		unknownLines = append(unknownLines[len(unknownLines)-maxUnknownLines:], fmt.Sprintf("(%d earlier lines omitted)", omitted))
+	}
+
+	diagnostics := strings.Join(append(signatureDiagnostics, unknownLines...), "\n")
+	if level < LevelError {
+		return outcomes.Warning{Diagnostics: diagnostics}
+	}
+	return outcomes.Fail{Error: errors.Errorf("%s container logs contain %d problems:\n%s", a.Container, len(a.Findings), diagnostics)}
+}
+
+func isAllowed(line string, allowlist []*regexp.Regexp) bool {
+	for _, allowlists := range [][]*regexp.Regexp{builtInAllowlist, allowlist} {
+		for _, allowed := range allowlists {
+			if allowed.MatchString(line) {
+				return true
+			}
+		}
+	}
+	return false
+}
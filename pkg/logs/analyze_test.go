@@ -0,0 +1,118 @@
+package logs
+
+import (
+	"regexp"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name                 string
+		lines                []string
+		format               Format
+		allowlist            []*regexp.Regexp
+		expectedOutcome      outcomes.Outcome
+		expectedSignatureIDs []string
+	}{
+		{
+			name: "informational logs",
+			lines: []string{
+				`{"level":"info","message":"Starting osm-controller"}`,
+				`{"level":"debug","message":"Error handling is enabled"}`,
+			},
+			format:          FormatZerolog,
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name: "known envoy warning",
+			lines: []string{
+				"[2021-09-20 10:12:13.456][15][warning][config] [source/common/config/grpc_stream.h:101] StreamAggregatedResources gRPC config stream closed: 14, no healthy upstream",
+				"[2021-09-20 10:12:13.456][15][info][main] [source/server/server.cc:801] all clusters initialized",
+			},
+			format:               FormatEnvoy,
+			expectedOutcome:      outcomes.Warning{},
+			expectedSignatureIDs: []string{"envoy.xds-stream-closed"},
+		},
+		{
+			name: "known osm error logged at info level",
+			lines: []string{
+				`{"level":"info","message":"Retrying: the server could not find the requested resource (get traffictargets.access.smi-spec.io)"}`,
+			},
+			format:               FormatZerolog,
+			expectedOutcome:      outcomes.Fail{},
+			expectedSignatureIDs: []string{"osm.smi-crd-missing"},
+		},
+		{
+			name: "unknown error",
+			lines: []string{
+				`{"level":"error","message":"something unexpected happened"}`,
+			},
+			format:               FormatZerolog,
+			expectedOutcome:      outcomes.Fail{},
+			expectedSignatureIDs: []string{""},
+		},
+		{
+			name: "built-in allowlist",
+			lines: []string{
+				"[2021-09-20 10:12:13.456][1][warning][misc] [source/common/protobuf/utility.cc:312] Using deprecated option 'envoy.config.cluster.v3.Cluster.tls_context'",
+			},
+			format:          FormatEnvoy,
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name: "user allowlist",
+			lines: []string{
+				`{"level":"warn","message":"Ignoring namespace kube-system"}`,
+			},
+			format:          FormatZerolog,
+			allowlist:       []*regexp.Regexp{regexp.MustCompile(`Ignoring namespace`)},
+			expectedOutcome: outcomes.Pass{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			analysis := Analyze("pod/container", test.lines, test.format, test.allowlist)
+
+			var signatureIDs []string
+			for _, finding := range analysis.Findings {
+				id := ""
+				if finding.Signature != nil {
+					id = finding.Signature.ID
+				}
+				signatureIDs = append(signatureIDs, id)
+			}
+			assert.Equal(test.expectedSignatureIDs, signatureIDs)
+			assert.IsType(test.expectedOutcome, analysis.Outcome())
+		})
+	}
+}
+
+func TestAnalysisOutcomeLimitsUnknownLines(t *testing.T) {
+	assert := tassert.New(t)
+	var lines []string
+	for i := 0; i < maxUnknownLines+3; i++ {
+		lines = append(lines, `{"level":"warn","message":"disk is slow"}`)
+	}
+	outcome := Analyze("pod/container", lines, FormatZerolog, nil).Outcome()
+	assert.IsType(outcomes.Warning{}, outcome)
+	assert.Contains(outcome.GetDiagnostics(), "(3 earlier lines omitted)")
+}
+
+func TestCatalogSignaturesAreUnique(t *testing.T) {
+	assert := tassert.New(t)
+	ids := make(map[string]struct{})
+	for _, signature := range Catalog {
+		_, exists := ids[signature.ID]
+		assert.Falsef(exists, "duplicate signature %s", signature.ID)
+		ids[signature.ID] = struct{}{}
+		assert.NotEmpty(signature.Formats)
+		assert.NotEmpty(signature.Explanation)
+		assert.NotEmpty(signature.Suggestion)
+	}
+}
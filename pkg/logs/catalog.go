@@ -0,0 +1,133 @@
+package logs
+
+import "regexp"
+
+// Catalog is the list of known error signatures of OSM components and Envoy.
+// A log entry matching a signature is flagged with the signature's explanation and suggestion, even when it is
+// logged at a level that is not otherwise flagged.
+var Catalog = []Signature{
+	// OSM control plane components
+	{
+		ID:          "osm.kube-api-unreachable",
+		Formats:     []Format{FormatZerolog},
+		Pattern:     regexp.MustCompile(`(?i)dial tcp [^ ]+:443: .*(connection refused|i/o timeout|no route to host)`),
+		Level:       LevelError,
+		Explanation: "The component cannot reach the Kubernetes API server, so it cannot watch mesh resources.",
+		Suggestion:  "Check the health of the Kubernetes API server and any network policies applied to the OSM control plane namespace.",
+	},
+	{
+		ID:          "osm.rbac-forbidden",
+		Formats:     []Format{FormatZerolog},
+		Pattern:     regexp.MustCompile(`(?i)is forbidden: User "system:serviceaccount:`),
+		Level:       LevelError,
+		Explanation: "The component's service account is not allowed to access a Kubernetes resource it needs.",
+		Suggestion:  "Make sure the OSM ClusterRoles and ClusterRoleBindings installed by the Helm chart exist and match the installed OSM version.",
+	},
+	{
+		ID:          "osm.smi-crd-missing",
+		Formats:     []Format{FormatZerolog},
+		Pattern:     regexp.MustCompile(`(?i)(could not find the requested resource|no matches for kind).*(TrafficTarget|HTTPRouteGroup|TCPRoute|TrafficSplit)`),
+		Level:       LevelError,
+		Explanation: "The SMI CustomResourceDefinitions are not installed at the versions OSM expects, so SMI policies are not applied.",
+		Suggestion:  "Install the SMI CRDs shipped with the installed OSM version.",
+	},
+	{
+		ID:          "osm.meshconfig-missing",
+		Formats:     []Format{FormatZerolog},
+		Pattern:     regexp.MustCompile(`(?i)meshconfig.*not found`),
+		Level:       LevelError,
+		Explanation: "The MeshConfig resource cannot be found, so the component runs with default settings.",
+		Suggestion:  "Make sure the osm-mesh-config MeshConfig exists in the OSM control plane namespace.",
+	},
+	{
+		ID:          "osm.certificate-invalid",
+		Formats:     []Format{FormatZerolog},
+		Pattern:     regexp.MustCompile(`(?i)x509: (certificate has expired|certificate signed by unknown authority|certificate is not valid)`),
+		Level:       LevelError,
+		Explanation: "A certificate presented to or by the component is expired or was not issued by the expected CA.",
+		Suggestion:  "Check the OSM CA bundle secret and the certificate validity duration in MeshConfig; rotated CAs require restarting the meshed pods.",
+	},
+	{
+		ID:          "osm.proxy-unknown",
+		Formats:     []Format{FormatZerolog},
+		Pattern:     regexp.MustCompile(`(?i)(error looking up (pod|proxy)|proxy .*not (found|registered)|unknown proxy)`),
+		Level:       LevelError,
+		Explanation: "The controller received a connection from an Envoy it cannot map to a meshed pod, so the Envoy gets no configuration.",
+		Suggestion:  "Check that the pod has the osm-proxy-uuid label and that its namespace is monitored by this mesh; restart the pod if it was injected by another mesh.",
+	},
+
+	// Envoy sidecars
+	{
+		ID:          "envoy.xds-stream-closed",
+		Formats:     []Format{FormatEnvoy},
+		Pattern:     regexp.MustCompile(`gRPC config stream (to \S+ )?closed`),
+		Level:       LevelWarning,
+		Explanation: "Envoy lost its xDS connection to osm-controller. Envoy keeps its last configuration until it reconnects.",
+		Suggestion:  "Check that the osm-controller pods are running and that the source pod can reach the osm-controller service on the ADS port.",
+	},
+	{
+		ID:          "envoy.config-rejected",
+		Formats:     []Format{FormatEnvoy},
+		Pattern:     regexp.MustCompile(`(?i)(gRPC config for \S+ rejected|Proto constraint validation failed|Unable to parse JSON as proto)`),
+		Level:       LevelError,
+		Explanation: "Envoy rejected the configuration sent by osm-controller, so it keeps running with an outdated configuration.",
+		Suggestion:  "Make sure the Envoy image in MeshConfig is the one supported by the installed OSM version.",
+	},
+	{
+		ID:          "envoy.tls-handshake-failed",
+		Formats:     []Format{FormatEnvoy},
+		Pattern:     regexp.MustCompile(`(?i)TLS error: .*(CERTIFICATE_VERIFY_FAILED|SSLV3_ALERT_[A-Z_]+|WRONG_VERSION_NUMBER)`),
+		Level:       LevelError,
+		Explanation: "An mTLS handshake between Envoys failed, usually because a certificate was issued by another CA or does not have the expected identity.",
+		Suggestion:  "Compare the root certificates of the source and destination Envoys and restart pods that still use certificates from a previous CA.",
+	},
+	{
+		ID:          "envoy.secret-missing",
+		Formats:     []Format{FormatEnvoy},
+		Pattern:     regexp.MustCompile(`(?i)(secret \S+ not found|unable to find secret|sds.*(failed|timed out))`),
+		Level:       LevelError,
+		Explanation: "Envoy has not received a certificate it needs over SDS, so listeners or clusters using it stay warming.",
+		Suggestion:  "Check the osm-controller logs for certificate issuance errors for this pod's service account.",
+	},
+	{
+		ID:          "envoy.upstream-connect-error",
+		Formats:     []Format{FormatEnvoy},
+		Pattern:     regexp.MustCompile(`(?i)(upstream connect error or disconnect/reset before headers|no healthy upstream)`),
+		Level:       LevelError,
+		Explanation: "Envoy could not open a connection to any endpoint of an upstream cluster.",
+		Suggestion:  "Check that the destination pods are ready and that the destination Envoy has an inbound listener for the service port.",
+	},
+}
+
+// builtInAllowlist matches log lines which are known to be benign.
+var builtInAllowlist = []*regexp.Regexp{
+	// Envoy warns about deprecated fields in configurations which are still valid.
+	regexp.MustCompile(`(?i)using deprecated option`),
+	// Envoy logs the shutdown sequence at warning level when the pod is terminated.
+	regexp.MustCompile(`(?i)caught (SIGTERM|SIGINT)`),
+	// Kubernetes client-go informers log expected reconnects after watch timeouts.
+	regexp.MustCompile(`(?i)watch of \S+ ended with: very short watch`),
+}
+
+// findSignature returns the first catalog signature for the given format that matches the entry.
+func findSignature(entry Entry, format Format) *Signature {
+	for i := range Catalog {
+		signature := &Catalog[i]
+		if !signature.appliesTo(format) {
+			continue
+		}
+		if signature.Pattern.MatchString(entry.Message) {
+			return signature
+		}
+	}
+	return nil
+}
+
+func (s *Signature) appliesTo(format Format) bool {
+	for _, f := range s.Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package logs
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// envoyLogLine matches the default Envoy application log format:
+// [%Y-%m-%d %T.%e][%t][%l][%n] [%g:%#] %v
+var envoyLogLine = regexp.MustCompile(`^\[[^\]]+\]\[\d+\]\[(\w+)\]\[[^\]]*\] (?:\[[^\]]*\] )?(.*)$`)
+
+// plainProblem matches unstructured log lines which mention a problem.
+var plainProblem = regexp.MustCompile(`(?i)\b(fatal|panic|error|fail(ed|ure)?)\b`)
+
+// plainWarning matches unstructured log lines which mention a warning.
+var plainWarning = regexp.MustCompile(`(?i)\bwarn(ing)?\b`)
+
+// Parse parses a log line written in the given format.
+func Parse(line string, format Format) Entry {
+	switch format {
+	case FormatZerolog:
+		return parseZerolog(line)
+	case FormatEnvoy:
+		return parseEnvoy(line)
+	default:
+		return parsePlain(line)
+	}
+}
+
+// parseZerolog parses a zerolog JSON log line. Lines which are not JSON are parsed as plain text,
+// e.g. a panic stack trace.
+func parseZerolog(line string) Entry {
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(line), &fields); err != nil {
+		return parsePlain(line)
+	}
+
+	message := fieldString(fields, "message")
+	if message == "" {
+		message = fieldString(fields, "msg")
+	}
+	if errorMessage := fieldString(fields, "error"); errorMessage != "" {
+		message = fmt.Sprintf("%s: %s", message, errorMessage)
+	}
+
+	return Entry{
+		Level:   parseLevel(fieldString(fields, "level")),
+		Message: message,
+		Raw:     line,
+	}
+}
+
+// parseEnvoy parses an Envoy application log line. Lines in another format, e.g. JSON access logs,
+// are informational.
+func parseEnvoy(line string) Entry {
+	matches := envoyLogLine.FindStringSubmatch(line)
+	if matches == nil {
+		return Entry{Level: LevelInfo, Message: line, Raw: line}
+	}
+	return Entry{
+		Level:   parseLevel(matches[1]),
+		Message: matches[2],
+		Raw:     line,
+	}
+}
+
+// parsePlain infers the level of an unstructured log line from its words.
+func parsePlain(line string) Entry {
+	level := LevelInfo
+	switch {
+	case plainProblem.MatchString(line):
+		level = LevelError
+	case plainWarning.MatchString(line):
+		level = LevelWarning
+	}
+	return Entry{Level: level, Message: line, Raw: line}
+}
+
+func parseLevel(level string) Level {
+	switch strings.ToLower(level) {
+	case "trace", "debug":
+		return LevelDebug
+	case "info":
+		return LevelInfo
+	case "warn", "warning":
+		return LevelWarning
+	case "error", "err":
+		return LevelError
+	case "fatal", "panic", "critical":
+		return LevelFatal
+	default:
+		return LevelUnknown
+	}
+}
+
+func fieldString(fields map[string]interface{}, key string) string {
+	value, ok := fields[key]
+	if !ok || value == nil {
+		return ""
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprint(value)
+}
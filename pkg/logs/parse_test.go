@@ -0,0 +1,89 @@
+package logs
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		line          string
+		format        Format
+		expectedEntry Entry
+	}{
+		{
+			name:   "zerolog entry with an error",
+			line:   `{"level":"error","component":"kube-controller","error":"connection refused","time":"2021-09-20T10:12:13Z","message":"Error listing pods"}`,
+			format: FormatZerolog,
+			expectedEntry: Entry{
+				Level:   LevelError,
+				Message: "Error listing pods: connection refused",
+			},
+		},
+		{
+			name:   "zerolog entry mentioning an error at info level",
+			line:   `{"level":"info","time":"2021-09-20T10:12:13Z","message":"Retrying after error"}`,
+			format: FormatZerolog,
+			expectedEntry: Entry{
+				Level:   LevelInfo,
+				Message: "Retrying after error",
+			},
+		},
+		{
+			name:   "non-JSON line in zerolog logs",
+			line:   "panic: runtime error: invalid memory address",
+			format: FormatZerolog,
+			expectedEntry: Entry{
+				Level:   LevelError,
+				Message: "panic: runtime error: invalid memory address",
+			},
+		},
+		{
+			name:   "envoy application log",
+			line:   "[2021-09-20 10:12:13.456][15][warning][config] [source/common/config/grpc_stream.h:101] StreamAggregatedResources gRPC config stream closed: 14, no healthy upstream",
+			format: FormatEnvoy,
+			expectedEntry: Entry{
+				Level:   LevelWarning,
+				Message: "StreamAggregatedResources gRPC config stream closed: 14, no healthy upstream",
+			},
+		},
+		{
+			name:   "envoy critical log",
+			line:   "[2021-09-20 10:12:13.456][1][critical][main] [source/server/server.cc:117] error initializing configuration",
+			format: FormatEnvoy,
+			expectedEntry: Entry{
+				Level:   LevelFatal,
+				Message: "error initializing configuration",
+			},
+		},
+		{
+			name:   "envoy access log",
+			line:   `{"method":"GET","path":"/books-bought","response_code":"503","response_flags":"UF"}`,
+			format: FormatEnvoy,
+			expectedEntry: Entry{
+				Level:   LevelInfo,
+				Message: `{"method":"GET","path":"/books-bought","response_code":"503","response_flags":"UF"}`,
+			},
+		},
+		{
+			name:   "plain line with a failure",
+			line:   "iptables: No chain/target/match by that name. Failed",
+			format: FormatPlain,
+			expectedEntry: Entry{
+				Level:   LevelError,
+				Message: "iptables: No chain/target/match by that name. Failed",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			entry := Parse(test.line, test.format)
+			test.expectedEntry.Raw = test.line
+			assert.Equal(test.expectedEntry, entry)
+		})
+	}
+}
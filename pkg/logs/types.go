@@ -0,0 +1,104 @@
+// Package logs parses the logs of OSM components and Envoy sidecars and flags the entries that indicate problems.
+package logs
+
+import (
+	"regexp"
+	"time"
+)
+
+// Format is the log format written by a container.
+type Format string
+
+const (
+	// FormatZerolog is the JSON log format of OSM control plane components, written with zerolog.
+	FormatZerolog Format = "zerolog"
+
+	// FormatEnvoy is the default Envoy application log format, e.g.
+	// [2021-09-20 10:12:13.456][15][warning][config] [source/common/config/grpc_stream.h:101] message
+	FormatEnvoy Format = "envoy"
+
+	// FormatPlain is unstructured text, e.g. the shell output of the osm-init container.
+	FormatPlain Format = "plain"
+)
+
+// Level is the severity of a log entry.
+type Level int
+
+const (
+	// LevelUnknown is used when the severity of a log entry cannot be determined.
+	LevelUnknown Level = iota
+	// LevelDebug is the level of debug and trace log entries.
+	LevelDebug
+	// LevelInfo is the level of informational log entries.
+	LevelInfo
+	// LevelWarning is the level of warning log entries.
+	LevelWarning
+	// LevelError is the level of error log entries.
+	LevelError
+	// LevelFatal is the level of fatal, panic and critical log entries.
+	LevelFatal
+)
+
+// String implements the stringer for Level.
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarning:
+		return "warning"
+	case LevelError:
+		return "error"
+	case LevelFatal:
+		return "fatal"
+	default:
+		return "unknown"
+	}
+}
+
+// Entry is a parsed log line.
+type Entry struct {
+	Level   Level
+	Message string
+	// Raw is the log line as it was read.
+	Raw string
+}
+
+// Options controls which logs are read and which entries are ignored.
+type Options struct {
+	// TailLines is the number of most recent log lines to read. Zero or less reads all the lines.
+	TailLines int64
+	// Since only reads log lines newer than this duration. Zero reads lines regardless of their age.
+	Since time.Duration
+	// Allowlist contains patterns of benign log lines, in addition to the built-in ones, which are never flagged.
+	Allowlist []*regexp.Regexp
+}
+
+// DefaultTailLines is the number of most recent log lines read when not configured otherwise.
+const DefaultTailLines int64 = 100
+
+// DefaultOptions returns the Options used when the user does not configure log analysis.
+func DefaultOptions() Options {
+	return Options{TailLines: DefaultTailLines}
+}
+
+// Signature is a known error pattern of an OSM component or Envoy, with an explanation of its cause.
+type Signature struct {
+	// ID uniquely identifies the signature.
+	ID string
+	// Formats are the log formats the signature applies to.
+	Formats []Format
+	// Pattern matches the log message.
+	Pattern *regexp.Regexp
+	// Level is the severity of the problem the signature indicates, regardless of the level it is logged at.
+	Level       Level
+	Explanation string
+	Suggestion  string
+}
+
+// Finding is a log entry flagged as a problem, and the signature it matches, if any.
+type Finding struct {
+	Entry     Entry
+	Signature *Signature
+}
@@ -0,0 +1,102 @@
+// Package annotations parses and validates the openservicemesh.io annotations set on namespaces and pods.
+package annotations
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+const (
+	// Prefix is the prefix of the annotations interpreted by OSM.
+	Prefix = "openservicemesh.io/"
+
+	// OutboundPortExclusionList is the pod annotation listing the outbound ports which bypass the Envoy sidecar.
+	// Source: https://github.com/openservicemesh/osm/blob/release-v0.9/pkg/injector/webhook.go
+	OutboundPortExclusionList = "openservicemesh.io/outbound-port-exclusion-list"
+
+	// InboundPortExclusionList is the pod annotation listing the inbound ports which bypass the Envoy sidecar.
+	// Source: https://github.com/openservicemesh/osm/blob/release-v0.9/pkg/injector/webhook.go
+	InboundPortExclusionList = "openservicemesh.io/inbound-port-exclusion-list"
+)
+
+// Validate checks that the given OSM annotations are supported by the given OSM version and have values OSM can interpret.
+// Annotations without the openservicemesh.io/ prefix are ignored.
+func Validate(osmVersion version.ControllerVersion, annotations map[string]string) error {
+	if unsupported := getUnsupported(osmVersion, annotations); len(unsupported) > 0 {
+		return errors.Wrapf(ErrUnsupportedAnnotation, "%s not supported by OSM %s", strings.Join(unsupported, ", "), osmVersion)
+	}
+
+	if value, ok := annotations[constants.MetricsAnnotation]; ok {
+		if _, err := parseEnabled(value); err != nil {
+			return errors.Wrapf(ErrInvalidAnnotationValue, "%s: %s", constants.MetricsAnnotation, err)
+		}
+	}
+
+	for _, key := range []string{OutboundPortExclusionList, InboundPortExclusionList} {
+		if _, err := GetPortExclusionList(annotations, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetPortExclusionList parses the port exclusion list annotation with the given key the same way the OSM sidecar injector does.
+// It returns no ports when the annotation is not set.
+// Source: https://github.com/openservicemesh/osm/blob/release-v0.9/pkg/injector/webhook.go
+func GetPortExclusionList(annotations map[string]string, key string) ([]int, error) {
+	value, ok := annotations[key]
+	if !ok {
+		return nil, nil
+	}
+
+	var ports []int
+	for _, portStr := range strings.Split(value, ",") {
+		portStr = strings.TrimSpace(portStr)
+		port, err := strconv.Atoi(portStr)
+		if err != nil || port <= 0 || port > 65535 {
+			return nil, errors.Wrapf(ErrInvalidAnnotationValue, "%s: invalid port %q", key, portStr)
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
+
+// getUnsupported returns the sorted keys of the OSM annotations which the given OSM version does not support.
+func getUnsupported(osmVersion version.ControllerVersion, annotations map[string]string) []string {
+	supported := make(map[version.Annotation]struct{})
+	for _, annotation := range version.SupportedAnnotations[osmVersion] {
+		supported[annotation] = struct{}{}
+	}
+
+	var unsupported []string
+	for key := range annotations {
+		if !strings.HasPrefix(key, Prefix) {
+			continue
+		}
+		if _, ok := supported[version.Annotation(key)]; !ok {
+			unsupported = append(unsupported, key)
+		}
+	}
+	sort.Strings(unsupported)
+	return unsupported
+}
+
+// parseEnabled parses an enabled/disabled annotation value the same way the OSM sidecar injector does.
+// Source: https://github.com/openservicemesh/osm/blob/release-v0.9/pkg/injector/metrics.go
+func parseEnabled(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "enabled", "yes", "true":
+		return true, nil
+	case "disabled", "no", "false":
+		return false, nil
+	default:
+		return false, errors.Errorf("expected enabled or disabled, got %q", value)
+	}
+}
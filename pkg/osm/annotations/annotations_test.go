@@ -0,0 +1,114 @@
+package annotations
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		osmVersion  version.ControllerVersion
+		annotations map[string]string
+		expectedErr error
+	}{
+		{
+			name:       "supported annotations with valid values",
+			osmVersion: "v0.9",
+			annotations: map[string]string{
+				"openservicemesh.io/sidecar-injection":            "enabled",
+				"openservicemesh.io/metrics":                      "Disabled",
+				"openservicemesh.io/outbound-port-exclusion-list": "6379, 7070",
+				"prometheus.io/scrape":                            "true",
+			},
+		},
+		{
+			name:       "misspelled annotation",
+			osmVersion: "v0.9",
+			annotations: map[string]string{
+				"openservicemesh.io/sidecar-injecton": "enabled",
+			},
+			expectedErr: ErrUnsupportedAnnotation,
+		},
+		{
+			name:       "annotation introduced in a later release",
+			osmVersion: "v0.8",
+			annotations: map[string]string{
+				"openservicemesh.io/inbound-port-exclusion-list": "8080",
+			},
+			expectedErr: ErrUnsupportedAnnotation,
+		},
+		{
+			name:       "invalid metrics value",
+			osmVersion: "v0.9",
+			annotations: map[string]string{
+				"openservicemesh.io/metrics": "on",
+			},
+			expectedErr: ErrInvalidAnnotationValue,
+		},
+		{
+			name:       "invalid port exclusion list",
+			osmVersion: "v0.9",
+			annotations: map[string]string{
+				"openservicemesh.io/inbound-port-exclusion-list": "8080;9090",
+			},
+			expectedErr: ErrInvalidAnnotationValue,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			assert.ErrorIs(Validate(test.osmVersion, test.annotations), test.expectedErr)
+		})
+	}
+}
+
+func TestGetPortExclusionList(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         *string
+		expectedPorts []int
+		expectedErr   error
+	}{
+		{
+			name:          "annotation not set",
+			expectedPorts: nil,
+		},
+		{
+			name:          "ports with whitespace",
+			value:         stringPtr(" 6379,7070 "),
+			expectedPorts: []int{6379, 7070},
+		},
+		{
+			name:        "port out of range",
+			value:       stringPtr("70000"),
+			expectedErr: ErrInvalidAnnotationValue,
+		},
+		{
+			name:        "empty value",
+			value:       stringPtr(""),
+			expectedErr: ErrInvalidAnnotationValue,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			podAnnotations := map[string]string{}
+			if test.value != nil {
+				podAnnotations[OutboundPortExclusionList] = *test.value
+			}
+			ports, err := GetPortExclusionList(podAnnotations, OutboundPortExclusionList)
+			assert.ErrorIs(err, test.expectedErr)
+			assert.Equal(test.expectedPorts, ports)
+		})
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
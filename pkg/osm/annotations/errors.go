@@ -0,0 +1,11 @@
+package annotations
+
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
+
+var (
+	// ErrUnsupportedAnnotation is used when an object has an OSM annotation which the running OSM version does not support.
+	ErrUnsupportedAnnotation = outcomes.NewError("UNSUPPORTED_ANNOTATION", "unsupported OSM annotation")
+
+	// ErrInvalidAnnotationValue is used when an OSM annotation has a value which OSM cannot interpret.
+	ErrInvalidAnnotationValue = outcomes.NewError("INVALID_ANNOTATION_VALUE", "invalid OSM annotation value")
+)
@@ -0,0 +1,8 @@
+package config
+
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
+
+var (
+	// ErrMeshConfigUnavailable denotes that the MeshConfig could not be read, so checks cannot rely on its settings.
+	ErrMeshConfigUnavailable = outcomes.NewError("MESH_CONFIG_UNAVAILABLE", "MeshConfig is unavailable")
+)
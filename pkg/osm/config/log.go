@@ -0,0 +1,5 @@
+package config
+
+import "github.com/openservicemesh/osm-health/pkg/logger"
+
+var log = logger.New("control-plane/config")
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+	configClient "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned"
+)
+
+const (
+	// DefaultReadTimeout is how long reading the MeshConfig is retried before checks report it as unavailable.
+	DefaultReadTimeout = 10 * time.Second
+
+	// readRetryInterval is the interval between attempts to read the MeshConfig.
+	readRetryInterval = time.Second
+)
+
+// MeshConfigGetter returns the MeshConfig the settings of a check are read from.
+type MeshConfigGetter interface {
+	// GetMeshConfig returns the MeshConfig, or the error that prevented reading it.
+	GetMeshConfig() (*configv1alpha1.MeshConfig, error)
+}
+
+// Verify interface compliance
+var _ MeshConfigGetter = (*Snapshot)(nil)
+
+// Snapshot is a copy of the MeshConfig read once per command, so that every check sees the same settings.
+type Snapshot struct {
+	meshConfig *configv1alpha1.MeshConfig
+	err        error
+}
+
+// NewSnapshot returns a Snapshot of a MeshConfig that was already read.
+func NewSnapshot(meshConfig *configv1alpha1.MeshConfig) Snapshot {
+	return Snapshot{
+		meshConfig: meshConfig,
+	}
+}
+
+// ReadSnapshot reads the MeshConfig of the OSM control plane in the given namespace with a direct GET, retrying
+// transient errors until the timeout expires. Unlike an informer-backed configurator, it never falls back to
+// default settings: when the MeshConfig cannot be read, the returned Snapshot reports why.
+func ReadSnapshot(ctx context.Context, client configClient.Interface, osmNamespace common.MeshNamespace, timeout time.Duration) Snapshot {
+	if client == nil {
+		return Snapshot{err: errors.Wrap(ErrMeshConfigUnavailable, "no OSM config client")}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var meshConfig *configv1alpha1.MeshConfig
+	var getErr error
+	err := wait.PollImmediateUntil(readRetryInterval, func() (bool, error) {
+		meshConfig, getErr = client.ConfigV1alpha1().MeshConfigs(osmNamespace.String()).Get(ctx, constants.OSMMeshConfig, metav1.GetOptions{})
+		switch {
+		case getErr == nil:
+			return true, nil
+		case apierrors.IsNotFound(getErr), apierrors.IsForbidden(getErr), apierrors.IsUnauthorized(getErr):
+			return false, getErr
+		default:
+			log.Debug().Err(getErr).Msgf("Error reading MeshConfig %s/%s; retrying", osmNamespace, constants.OSMMeshConfig)
+			return false, nil
+		}
+	}, ctx.Done())
+	if err != nil {
+		if getErr == nil {
+			getErr = err
+		}
+		log.Error().Err(getErr).Msgf("Error reading MeshConfig %s/%s", osmNamespace, constants.OSMMeshConfig)
+		return Snapshot{err: errors.Wrapf(ErrMeshConfigUnavailable, "%s/%s: %s", osmNamespace, constants.OSMMeshConfig, getErr)}
+	}
+	return Snapshot{meshConfig: meshConfig}
+}
+
+// GetMeshConfig implements MeshConfigGetter
+func (s Snapshot) GetMeshConfig() (*configv1alpha1.MeshConfig, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.meshConfig == nil {
+		return nil, ErrMeshConfigUnavailable
+	}
+	return s.meshConfig, nil
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+	configFake "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned/fake"
+)
+
+func TestReadSnapshot(t *testing.T) {
+	meshConfig := &configv1alpha1.MeshConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.OSMMeshConfig, Namespace: "osm-system"},
+		Spec: configv1alpha1.MeshConfigSpec{
+			Traffic: configv1alpha1.TrafficSpec{EnablePermissiveTrafficPolicyMode: true},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		objects     []runtime.Object
+		namespace   common.MeshNamespace
+		expectedErr error
+	}{
+		{
+			name:      "MeshConfig exists",
+			objects:   []runtime.Object{meshConfig},
+			namespace: "osm-system",
+		},
+		{
+			name:        "MeshConfig is missing",
+			namespace:   "osm-system",
+			expectedErr: ErrMeshConfigUnavailable,
+		},
+		{
+			name:        "MeshConfig is in another namespace",
+			objects:     []runtime.Object{meshConfig},
+			namespace:   "other-osm-system",
+			expectedErr: ErrMeshConfigUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			snapshot := ReadSnapshot(context.TODO(), configFake.NewSimpleClientset(test.objects...), test.namespace, time.Second)
+
+			actual, err := snapshot.GetMeshConfig()
+			assert.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				assert.Nil(actual)
+				return
+			}
+			assert.True(actual.Spec.Traffic.EnablePermissiveTrafficPolicyMode)
+		})
+	}
+}
+
+func TestReadSnapshotWithoutClient(t *testing.T) {
+	assert := tassert.New(t)
+	_, err := ReadSnapshot(context.TODO(), nil, "osm-system", time.Second).GetMeshConfig()
+	assert.ErrorIs(err, ErrMeshConfigUnavailable)
+}
+
+func TestNewSnapshot(t *testing.T) {
+	assert := tassert.New(t)
+
+	meshConfig := &configv1alpha1.MeshConfig{}
+	actual, err := NewSnapshot(meshConfig).GetMeshConfig()
+	assert.Nil(err)
+	assert.Same(meshConfig, actual)
+
+	_, err = NewSnapshot(nil).GetMeshConfig()
+	assert.ErrorIs(err, ErrMeshConfigUnavailable)
+}
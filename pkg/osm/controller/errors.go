@@ -1,8 +1,8 @@
 package controller
 
-import "errors"
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
 
 var (
 	// ErrorNoControllerPodsExistInNamespace denotes when no osm-controller pods exist in the specified namespace.
-	ErrorNoControllerPodsExistInNamespace = errors.New("no osm-controller pods exist in the specified namespace")
+	ErrorNoControllerPodsExistInNamespace = outcomes.NewError("NO_CONTROLLER_PODS_EXIST_IN_NAMESPACE", "no osm-controller pods exist in the specified namespace")
 )
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/cluster"
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
+	"github.com/openservicemesh/osm-health/pkg/utils"
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/k8s"
+)
+
+// httpServer is the HTTP server of an osm-controller pod.
+type httpServer struct {
+	namespace string
+	podName   string
+	// url is the base URL the server is reached at; it is empty when the responses are replayed.
+	url string
+}
+
+// withHTTPServer port-forwards to the HTTP server of the osm-controller pod and calls f with it. When a cluster snapshot
+// is replayed, f is called with the server of the pod as recorded in the snapshot instead.
+func withHTTPServer(client kubernetes.Interface, controllerPod corev1.Pod, localPort uint16, f func(httpServer) error) error {
+	server := httpServer{namespace: controllerPod.Namespace, podName: controllerPod.Name}
+	if cluster.ActiveReplay() != nil {
+		return f(server)
+	}
+
+	conf, err := pod.GetKubeConfig()
+	if err != nil {
+		return errors.Errorf("failed to get the kubeconfig: %s", err)
+	}
+	dialer, err := k8s.DialerToPod(conf, client, controllerPod.Name, controllerPod.Namespace)
+	if err != nil {
+		return errors.Errorf("error setting up port forwarding: %s", err)
+	}
+	portForwarder, err := k8s.NewPortForwarder(dialer, fmt.Sprintf("%d:%d", localPort, constants.OSMHTTPServerPort))
+	if err != nil {
+		return errors.Errorf("error setting up port forwarding: %s", err)
+	}
+
+	return portForwarder.Start(func(pf *k8s.PortForwarder) error {
+		defer pf.Stop()
+		server.url = fmt.Sprintf("http://localhost:%d", localPort)
+		return f(server)
+	})
+}
+
+// get returns the response of the server to a GET of the given path, recording it when a cluster snapshot is recorded.
+func (s httpServer) get(ctx context.Context, urlPath string) (cluster.HTTPResponse, error) {
+	if replay := cluster.ActiveReplay(); replay != nil {
+		return replay.HTTPResponse(s.namespace, s.podName, urlPath)
+	}
+
+	statusCode, body, err := utils.GetResponse(ctx, s.url+urlPath)
+	if err != nil {
+		return cluster.HTTPResponse{}, err
+	}
+	resp := cluster.HTTPResponse{StatusCode: statusCode, Body: body}
+	if recorder := cluster.ActiveRecorder(); recorder != nil {
+		recorder.RecordHTTPResponse(s.namespace, s.podName, urlPath, resp)
+	}
+	return resp, nil
+}
+
+// checkStatusOK checks whether the server responds to a GET of the given path with HTTP status code 200.
+func (s httpServer) checkStatusOK(ctx context.Context, urlPath string) error {
+	resp, err := s.get(ctx, urlPath)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("checking for HTTP status code: %d, but url returned HTTP status code: %d", http.StatusOK, resp.StatusCode)
+	}
+	return nil
+}
+
+// getBody returns the body of the response of the server to a GET of the given path, which must succeed.
+func (s httpServer) getBody(ctx context.Context, urlPath string) (string, error) {
+	resp, err := s.get(ctx, urlPath)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("url returned HTTP status code: %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
@@ -1,21 +1,16 @@
 package controller
 
 import (
-	"fmt"
-	"net/http"
+	"context"
 
 	"github.com/pkg/errors"
-	"helm.sh/helm/v3/pkg/action"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/openservicemesh/osm-health/pkg/common"
 	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
 	"github.com/openservicemesh/osm-health/pkg/runner"
-	"github.com/openservicemesh/osm-health/pkg/utils"
-	"github.com/openservicemesh/osm/pkg/constants"
 	httpserverconstants "github.com/openservicemesh/osm/pkg/httpserver/constants"
-	"github.com/openservicemesh/osm/pkg/k8s"
 )
 
 // Verify interface compliance
@@ -27,17 +22,15 @@ type HTTPServerHealthEndpointsCheck struct {
 	osmControlPlaneNamespace common.MeshNamespace
 	controllerPods           *corev1.PodList
 	localPort                uint16
-	actionConfig             *action.Configuration
 }
 
 // NewHTTPServerHealthEndpointsCheck checks whether the osm-controller's http server health endpoints return healthy status.
-func NewHTTPServerHealthEndpointsCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace, controllerPods *corev1.PodList, localPort uint16, actionConfig *action.Configuration) HTTPServerHealthEndpointsCheck {
+func NewHTTPServerHealthEndpointsCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace, controllerPods *corev1.PodList, localPort uint16) HTTPServerHealthEndpointsCheck {
 	return HTTPServerHealthEndpointsCheck{
 		client:                   client,
 		osmControlPlaneNamespace: osmControlPlaneNamespace,
 		controllerPods:           controllerPods,
 		localPort:                localPort,
-		actionConfig:             actionConfig,
 	}
 }
 
@@ -47,39 +40,16 @@ func (check HTTPServerHealthEndpointsCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (check HTTPServerHealthEndpointsCheck) Run() outcomes.Outcome {
+func (check HTTPServerHealthEndpointsCheck) Run(ctx context.Context) outcomes.Outcome {
 	anyControllerPodsExist := false
 	for _, controllerPod := range check.controllerPods.Items {
 		anyControllerPodsExist = true
 
-		conf, err := check.actionConfig.RESTClientGetter.ToRESTConfig()
-		if err != nil {
-			return outcomes.Fail{Error: errors.Errorf("failed to get REST config from Helm %s", err)}
-		}
-		dialer, err := k8s.DialerToPod(conf, check.client, controllerPod.Name, controllerPod.Namespace)
-		if err != nil {
-			return outcomes.Fail{Error: errors.Errorf("error setting up port forwarding: %s", err)}
-		}
-		portForwarder, err := k8s.NewPortForwarder(dialer, fmt.Sprintf("%d:%d", check.localPort, constants.OSMHTTPServerPort))
-		if err != nil {
-			return outcomes.Fail{Error: errors.Errorf("error setting up port forwarding: %s", err)}
-		}
-
-		err = portForwarder.Start(func(pf *k8s.PortForwarder) error {
-			defer pf.Stop()
-			controllerHTTPServerURL := fmt.Sprintf("http://localhost:%d", check.localPort)
-
-			err = checkControllerHealthReadiness(controllerHTTPServerURL)
-			if err != nil {
-				return err
-			}
-
-			err = checkControllerHealthLiveness(controllerHTTPServerURL)
-			if err != nil {
+		err := withHTTPServer(check.client, controllerPod, check.localPort, func(server httpServer) error {
+			if err := checkControllerHealthReadiness(ctx, server); err != nil {
 				return err
 			}
-
-			return nil
+			return checkControllerHealthLiveness(ctx, server)
 		})
 		if err != nil {
 			return outcomes.Fail{Error: err}
@@ -103,18 +73,16 @@ func (check HTTPServerHealthEndpointsCheck) FixIt() error {
 	panic("implement me")
 }
 
-func checkControllerHealthReadiness(controllerHTTPServerURL string) error {
-	url := fmt.Sprintf("%s%s", controllerHTTPServerURL, httpserverconstants.HealthReadinessPath)
-	if err := utils.CheckHTTPResponseCodeEquals(url, http.StatusOK); err != nil {
-		return errors.Errorf("osm-controller health readiness check failed: %s", err)
+func checkControllerHealthReadiness(ctx context.Context, server httpServer) error {
+	if err := server.checkStatusOK(ctx, httpserverconstants.HealthReadinessPath); err != nil {
+		return errors.Wrap(err, "osm-controller health readiness check failed")
 	}
 	return nil
 }
 
-func checkControllerHealthLiveness(controllerHTTPServerURL string) error {
-	url := fmt.Sprintf("%s%s", controllerHTTPServerURL, httpserverconstants.HealthLivenessPath)
-	if err := utils.CheckHTTPResponseCodeEquals(url, http.StatusOK); err != nil {
-		return errors.Errorf("osm-controller health liveness check failed: %s", err)
+func checkControllerHealthLiveness(ctx context.Context, server httpServer) error {
+	if err := server.checkStatusOK(ctx, httpserverconstants.HealthLivenessPath); err != nil {
+		return errors.Wrap(err, "osm-controller health liveness check failed")
 	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -34,9 +35,9 @@ func TestCheckControllerHealth(t *testing.T) {
 			}))
 			defer ts.Close()
 
-			err := checkControllerHealthReadiness(ts.URL)
+			err := checkControllerHealthReadiness(context.TODO(), httpServer{url: ts.URL})
 			assert.Equal(test.shouldError, err != nil)
-			err = checkControllerHealthLiveness(ts.URL)
+			err = checkControllerHealthLiveness(context.TODO(), httpServer{url: ts.URL})
 			assert.Equal(test.shouldError, err != nil)
 		})
 	}
@@ -7,7 +7,6 @@ import (
 	"strconv"
 
 	"github.com/pkg/errors"
-	"helm.sh/helm/v3/pkg/action"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -16,10 +15,7 @@ import (
 	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
 	osmutils "github.com/openservicemesh/osm-health/pkg/osm/utils"
 	"github.com/openservicemesh/osm-health/pkg/runner"
-	"github.com/openservicemesh/osm-health/pkg/utils"
-	"github.com/openservicemesh/osm/pkg/constants"
 	httpserverconstants "github.com/openservicemesh/osm/pkg/httpserver/constants"
-	"github.com/openservicemesh/osm/pkg/k8s"
 )
 
 // Verify interface compliance
@@ -31,17 +27,15 @@ type HTTPServerProxyConnectionMetricsCheck struct {
 	osmControlPlaneNamespace common.MeshNamespace
 	controllerPods           *corev1.PodList
 	localPort                uint16
-	actionConfig             *action.Configuration
 }
 
 // NewHTTPServerProxyConnectionMetricsCheck checks whether the osm-controller's http server returns valid metrics for proxy connection count.
-func NewHTTPServerProxyConnectionMetricsCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace, controllerPods *corev1.PodList, localPort uint16, actionConfig *action.Configuration) HTTPServerProxyConnectionMetricsCheck {
+func NewHTTPServerProxyConnectionMetricsCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace, controllerPods *corev1.PodList, localPort uint16) HTTPServerProxyConnectionMetricsCheck {
 	return HTTPServerProxyConnectionMetricsCheck{
 		client:                   client,
 		osmControlPlaneNamespace: osmControlPlaneNamespace,
 		controllerPods:           controllerPods,
 		localPort:                localPort,
-		actionConfig:             actionConfig,
 	}
 }
 
@@ -51,34 +45,13 @@ func (check HTTPServerProxyConnectionMetricsCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (check HTTPServerProxyConnectionMetricsCheck) Run() outcomes.Outcome {
+func (check HTTPServerProxyConnectionMetricsCheck) Run(ctx context.Context) outcomes.Outcome {
 	anyControllerPodsExist := false
 	for _, controllerPod := range check.controllerPods.Items {
 		anyControllerPodsExist = true
 
-		conf, err := check.actionConfig.RESTClientGetter.ToRESTConfig()
-		if err != nil {
-			return outcomes.Fail{Error: errors.Errorf("failed to get REST config from Helm %s", err)}
-		}
-		dialer, err := k8s.DialerToPod(conf, check.client, controllerPod.Name, controllerPod.Namespace)
-		if err != nil {
-			return outcomes.Fail{Error: errors.Errorf("error setting up port forwarding: %s", err)}
-		}
-		portForwarder, err := k8s.NewPortForwarder(dialer, fmt.Sprintf("%d:%d", check.localPort, constants.OSMHTTPServerPort))
-		if err != nil {
-			return outcomes.Fail{Error: errors.Errorf("error setting up port forwarding: %s", err)}
-		}
-
-		err = portForwarder.Start(func(pf *k8s.PortForwarder) error {
-			defer pf.Stop()
-			controllerHTTPServerURL := fmt.Sprintf("http://localhost:%d", check.localPort)
-
-			err = checkControllerProxyConnectionMetrics(check.client, controllerHTTPServerURL, check.osmControlPlaneNamespace)
-			if err != nil {
-				return err
-			}
-
-			return nil
+		err := withHTTPServer(check.client, controllerPod, check.localPort, func(server httpServer) error {
+			return checkControllerProxyConnectionMetrics(ctx, check.client, server, check.osmControlPlaneNamespace)
 		})
 		if err != nil {
 			return outcomes.Fail{Error: err}
@@ -102,14 +75,13 @@ func (check HTTPServerProxyConnectionMetricsCheck) FixIt() error {
 	panic("implement me")
 }
 
-func checkControllerProxyConnectionMetrics(client kubernetes.Interface, controllerHTTPServerURL string, osmControlPlaneNamespace common.MeshNamespace) error {
-	url := fmt.Sprintf("%s%s", controllerHTTPServerURL, httpserverconstants.MetricsPath)
-	metricsRespBody, err := utils.GetResponseBody(url)
+func checkControllerProxyConnectionMetrics(ctx context.Context, client kubernetes.Interface, server httpServer, osmControlPlaneNamespace common.MeshNamespace) error {
+	metricsRespBody, err := server.getBody(ctx, httpserverconstants.MetricsPath)
 	if err != nil {
-		return errors.Errorf("osm-controller metrics check failed: %s", err)
+		return errors.Wrap(err, "osm-controller metrics check failed")
 	}
 
-	monitoredNamespaces, err := osmutils.GetMonitoredNamespaces(client, osmControlPlaneNamespace)
+	monitoredNamespaces, err := osmutils.GetMonitoredNamespaces(ctx, client, osmControlPlaneNamespace)
 	if err != nil {
 		return errors.Errorf("osm-controller metrics check failed: %s", err)
 	}
@@ -120,7 +92,7 @@ func checkControllerProxyConnectionMetrics(client kubernetes.Interface, controll
 	// TODO - should we check for the metrics enabled annotation/label?
 	totalMeshMonitoredPodsCount := 0
 	for _, ns := range monitoredNamespaces.Items {
-		pods, err := client.CoreV1().Pods(ns.Name).List(context.TODO(), metav1.ListOptions{})
+		pods, err := client.CoreV1().Pods(ns.Name).List(ctx, metav1.ListOptions{})
 		if err != nil {
 			return errors.Errorf("unable to list pods in monitored namespace %s", ns.Name)
 		}
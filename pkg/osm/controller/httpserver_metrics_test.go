@@ -354,7 +354,7 @@ func TestCheckControllerProxyConnectionMetrics(t *testing.T) {
 				}
 			}
 
-			err := checkControllerProxyConnectionMetrics(client, ts.URL, common.MeshNamespace(osmControlPlaneNamespace))
+			err := checkControllerProxyConnectionMetrics(context.TODO(), client, httpServer{url: ts.URL}, common.MeshNamespace(osmControlPlaneNamespace))
 
 			assert.Equal(test.expectedError != nil, err != nil)
 			if test.expectedError != nil {
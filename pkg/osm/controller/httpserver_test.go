@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm-health/pkg/cluster"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	httpserverconstants "github.com/openservicemesh/osm/pkg/httpserver/constants"
+)
+
+func TestHTTPServerHealthEndpointsCheckReplay(t *testing.T) {
+	tests := []struct {
+		name            string
+		statusCode      int
+		expectedOutcome outcomes.Outcome
+	}{
+		{
+			name:            "recorded healthy controller",
+			statusCode:      http.StatusOK,
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "recorded unhealthy controller",
+			statusCode:      http.StatusServiceUnavailable,
+			expectedOutcome: outcomes.Fail{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			controllerPod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "osm-controller-5d8c9b7f4-q9w2z", Namespace: "osm-system"}}
+
+			// Record the responses of the controller, then replay them without port-forwarding to the pod
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.statusCode)
+			}))
+			recorder := cluster.NewRecorder()
+			cluster.SetRecorder(recorder)
+			server := httpServer{namespace: controllerPod.Namespace, podName: controllerPod.Name, url: ts.URL}
+			_, err := server.get(context.TODO(), httpserverconstants.HealthReadinessPath)
+			assert.Nil(err)
+			_, err = server.get(context.TODO(), httpserverconstants.HealthLivenessPath)
+			assert.Nil(err)
+			cluster.SetRecorder(nil)
+			ts.Close()
+
+			snapshotPath := t.TempDir()
+			assert.Nil(recorder.Save(snapshotPath))
+			replay, err := cluster.LoadReplay(snapshotPath)
+			assert.Nil(err)
+			cluster.SetReplay(replay)
+			defer cluster.SetReplay(nil)
+
+			controllerPods := &corev1.PodList{Items: []corev1.Pod{controllerPod}}
+			outcome := NewHTTPServerHealthEndpointsCheck(fake.NewSimpleClientset(), "osm-system", controllerPods, 9091).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+
+			// A controller pod whose responses were not recorded cannot be checked
+			controllerPods.Items[0].Name = "osm-controller-74c6d9f5b-p8r4s"
+			outcome = NewHTTPServerHealthEndpointsCheck(fake.NewSimpleClientset(), "osm-system", controllerPods, 9091).Run(context.TODO())
+			assert.ErrorIs(outcome.GetError(), cluster.ErrHTTPResponseNotRecorded)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package osm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm-health/pkg/smi"
+)
+
+const (
+	smiAccessGroup = "access.smi-spec.io"
+	smiSplitGroup  = "split.smi-spec.io"
+	smiSpecsGroup  = "specs.smi-spec.io"
+	osmConfigGroup = "config.openservicemesh.io"
+)
+
+// expectedCRD is a resource the running OSM version expects to be served at one of the listed group versions
+type expectedCRD struct {
+	resource      string
+	groupVersions []string
+}
+
+// Verify interface compliance
+var _ runner.Runnable = (*CRDVersionsCheck)(nil)
+
+// CRDVersionsCheck implements common.Runnable
+type CRDVersionsCheck struct {
+	client     kubernetes.Interface
+	osmVersion version.ControllerVersion
+}
+
+// NewCRDVersionsCheck checks whether the CRDs are installed at the versions the running OSM version expects
+func NewCRDVersionsCheck(client kubernetes.Interface, osmVersion version.ControllerVersion) CRDVersionsCheck {
+	return CRDVersionsCheck{
+		client:     client,
+		osmVersion: osmVersion,
+	}
+}
+
+// Description implements common.Runnable
+func (check CRDVersionsCheck) Description() string {
+	return fmt.Sprintf("Checking whether the CRDs are installed at the versions expected by OSM %s", check.osmVersion)
+}
+
+// Run implements common.Runnable
+func (check CRDVersionsCheck) Run(ctx context.Context) outcomes.Outcome {
+	expected, err := getExpectedCRDs(check.osmVersion)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	groups, err := check.client.Discovery().ServerGroups()
+	if err != nil {
+		return outcomes.Fail{Error: errors.Wrap(err, "unable to discover the API groups served by the cluster")}
+	}
+	servedGroupVersions := make(map[string]bool)
+	for _, group := range groups.Groups {
+		for _, groupVersion := range group.Versions {
+			servedGroupVersions[groupVersion.GroupVersion] = true
+		}
+	}
+
+	// Cache the served resources of each group version, since several CRDs share a group version.
+	served := make(map[string]map[string]bool)
+	isServed := func(groupVersion string, resource string) (bool, error) {
+		if !servedGroupVersions[groupVersion] {
+			return false, nil
+		}
+		if _, ok := served[groupVersion]; !ok {
+			resources, err := check.client.Discovery().ServerResourcesForGroupVersion(groupVersion)
+			if err != nil {
+				return false, errors.Wrapf(err, "unable to discover the resources of %s", groupVersion)
+			}
+			served[groupVersion] = make(map[string]bool)
+			for _, r := range resources.APIResources {
+				served[groupVersion][r.Name] = true
+			}
+		}
+		return served[groupVersion][resource], nil
+	}
+
+	var missing, found []string
+	for _, crd := range expected {
+		var servedAt string
+		for _, groupVersion := range crd.groupVersions {
+			ok, err := isServed(groupVersion, crd.resource)
+			if err != nil {
+				return outcomes.Fail{Error: err}
+			}
+			if ok {
+				servedAt = groupVersion
+				break
+			}
+		}
+		if servedAt == "" {
+			missing = append(missing, fmt.Sprintf("%s (%s)", crd.resource, strings.Join(crd.groupVersions, " or ")))
+			continue
+		}
+		found = append(found, fmt.Sprintf("%s (%s)", crd.resource, servedAt))
+	}
+
+	if len(missing) > 0 {
+		return outcomes.Fail{Error: errors.Wrapf(ErrCRDsMissing, "missing %s", strings.Join(missing, ", "))}
+	}
+	return outcomes.Pass{Diagnostics: strings.Join(found, ", ")}
+}
+
+// getExpectedCRDs returns the CRDs the given OSM version expects
+func getExpectedCRDs(osmVersion version.ControllerVersion) ([]expectedCRD, error) {
+	trafficTargetVersion, ok := version.SupportedTrafficTarget[osmVersion]
+	if !ok {
+		return nil, errors.Errorf("unknown TrafficTarget version for OSM %s", osmVersion)
+	}
+	trafficSplitVersion, ok := version.SupportedTrafficSplit[osmVersion]
+	if !ok {
+		return nil, errors.Errorf("unknown TrafficSplit version for OSM %s", osmVersion)
+	}
+	httpRouteVersions, ok := version.SupportedHTTPRouteVersion[osmVersion]
+	if !ok {
+		return nil, errors.Errorf("unknown HTTPRouteGroup versions for OSM %s", osmVersion)
+	}
+
+	var specsGroupVersions []string
+	for _, httpRouteVersion := range httpRouteVersions {
+		specsGroupVersions = append(specsGroupVersions, fmt.Sprintf("%s/%s", smiSpecsGroup, httpRouteVersion))
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(specsGroupVersions)))
+
+	expected := []expectedCRD{
+		{resource: "meshconfigs", groupVersions: []string{osmConfigGroup + "/v1alpha1"}},
+		{resource: "traffictargets", groupVersions: []string{fmt.Sprintf("%s/%s", smiAccessGroup, trafficTargetVersion)}},
+		{resource: "trafficsplits", groupVersions: []string{fmt.Sprintf("%s/%s", smiSplitGroup, trafficSplitVersion)}},
+		{resource: "httproutegroups", groupVersions: specsGroupVersions},
+	}
+	for _, routeKind := range version.SupportedTrafficTargetRouteKinds[osmVersion] {
+		if routeKind == smi.TCPRouteKind {
+			expected = append(expected, expectedCRD{resource: "tcproutes", groupVersions: specsGroupVersions})
+		}
+	}
+	return expected, nil
+}
+
+// Suggestion implements common.Runnable
+func (check CRDVersionsCheck) Suggestion() string {
+	return fmt.Sprintf("Install the CRDs of OSM %s, for example by upgrading OSM with Helm, and restart the osm-controller", check.osmVersion)
+}
+
+// FixIt implements common.Runnable
+func (check CRDVersionsCheck) FixIt() error {
+	panic("implement me")
+}
@@ -0,0 +1,82 @@
+package osm
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+)
+
+func TestCRDVersionsCheck(t *testing.T) {
+	newResourceList := func(groupVersion string, resources ...string) *metav1.APIResourceList {
+		list := &metav1.APIResourceList{GroupVersion: groupVersion}
+		for _, resource := range resources {
+			list.APIResources = append(list.APIResources, metav1.APIResource{Name: resource})
+		}
+		return list
+	}
+	v09Resources := []*metav1.APIResourceList{
+		newResourceList("config.openservicemesh.io/v1alpha1", "meshconfigs"),
+		newResourceList("access.smi-spec.io/v1alpha3", "traffictargets"),
+		newResourceList("split.smi-spec.io/v1alpha2", "trafficsplits"),
+		newResourceList("specs.smi-spec.io/v1alpha4", "httproutegroups", "tcproutes"),
+	}
+
+	tests := []struct {
+		name            string
+		osmVersion      version.ControllerVersion
+		resources       []*metav1.APIResourceList
+		expectedOutcome outcomes.Outcome
+		expectedErr     error
+	}{
+		{
+			name:            "CRDs are installed at the expected versions",
+			osmVersion:      "v0.9",
+			resources:       v09Resources,
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:       "TrafficTarget CRD is installed at an older version",
+			osmVersion: "v0.9",
+			resources: []*metav1.APIResourceList{
+				v09Resources[0],
+				newResourceList("access.smi-spec.io/v1alpha2", "traffictargets"),
+				v09Resources[2],
+				v09Resources[3],
+			},
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrCRDsMissing,
+		},
+		{
+			name:            "no CRDs are installed",
+			osmVersion:      "v0.9",
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrCRDsMissing,
+		},
+		{
+			name:            "unknown OSM version",
+			osmVersion:      "",
+			expectedOutcome: outcomes.Fail{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			client := fake.NewSimpleClientset()
+			client.Discovery().(*fakediscovery.FakeDiscovery).Resources = test.resources
+
+			outcome := NewCRDVersionsCheck(client, test.osmVersion).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			if test.expectedErr != nil {
+				assert.ErrorIs(outcome.GetError(), test.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package osm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+// osmBootstrapName is the name of the OSM Bootstrap component, which is only deployed by newer OSM releases.
+const osmBootstrapName = "osm-bootstrap"
+
+// Verify interface compliance
+var _ runner.Runnable = (*DeploymentReadinessCheck)(nil)
+
+// DeploymentReadinessCheck implements common.Runnable
+type DeploymentReadinessCheck struct {
+	client                   kubernetes.Interface
+	osmControlPlaneNamespace common.MeshNamespace
+	deploymentName           string
+	optional                 bool
+}
+
+// NewDeploymentReadinessCheck checks whether the OSM control plane deployment with the given app label is available,
+// has all its replicas ready and has pods that did not restart. Optional deployments that do not exist are reported as Info.
+func NewDeploymentReadinessCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace, deploymentName string, optional bool) DeploymentReadinessCheck {
+	return DeploymentReadinessCheck{
+		client:                   client,
+		osmControlPlaneNamespace: osmControlPlaneNamespace,
+		deploymentName:           deploymentName,
+		optional:                 optional,
+	}
+}
+
+// NewOsmControllerDeploymentCheck checks whether the osm-controller deployment is ready
+func NewOsmControllerDeploymentCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) DeploymentReadinessCheck {
+	return NewDeploymentReadinessCheck(client, osmControlPlaneNamespace, constants.OSMControllerName, false)
+}
+
+// NewOsmInjectorDeploymentCheck checks whether the osm-injector deployment is ready
+func NewOsmInjectorDeploymentCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) DeploymentReadinessCheck {
+	return NewDeploymentReadinessCheck(client, osmControlPlaneNamespace, constants.OSMInjectorName, false)
+}
+
+// NewOsmBootstrapDeploymentCheck checks whether the osm-bootstrap deployment is ready when it is present
+func NewOsmBootstrapDeploymentCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) DeploymentReadinessCheck {
+	return NewDeploymentReadinessCheck(client, osmControlPlaneNamespace, osmBootstrapName, true)
+}
+
+// Description implements common.Runnable
+func (check DeploymentReadinessCheck) Description() string {
+	return fmt.Sprintf("Checking whether the %s deployment in namespace %s is ready", check.deploymentName, check.osmControlPlaneNamespace)
+}
+
+// Run implements common.Runnable
+func (check DeploymentReadinessCheck) Run(ctx context.Context) outcomes.Outcome {
+	deployments, err := check.client.AppsV1().Deployments(check.osmControlPlaneNamespace.String()).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app=%s", check.deploymentName),
+	})
+	if err != nil {
+		return outcomes.Fail{Error: errors.Wrapf(err, "unable to list %s deployments in namespace %s", check.deploymentName, check.osmControlPlaneNamespace)}
+	}
+	if len(deployments.Items) == 0 {
+		if check.optional {
+			return outcomes.Info{Diagnostics: fmt.Sprintf("The %s deployment is not present in namespace %s", check.deploymentName, check.osmControlPlaneNamespace)}
+		}
+		// Without a required control plane component the whole mesh is down.
+		return outcomes.Fail{
+			Error:    errors.Wrapf(ErrDeploymentNotFound, "%s in namespace %s", check.deploymentName, check.osmControlPlaneNamespace),
+			Severity: outcomes.SeverityCritical,
+		}
+	}
+
+	var problems, restarts []string
+	readySummaries := make([]string, 0, len(deployments.Items))
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		desired := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desired = *deployment.Spec.Replicas
+		}
+		readySummaries = append(readySummaries, fmt.Sprintf("%s: %d/%d replicas ready", deployment.Name, deployment.Status.ReadyReplicas, desired))
+
+		if condition := getDeploymentCondition(deployment, appsv1.DeploymentAvailable); condition != nil && condition.Status != corev1.ConditionTrue {
+			problems = append(problems, fmt.Sprintf("deployment %s is not available: %s", deployment.Name, condition.Message))
+		}
+		if deployment.Status.ReadyReplicas < desired {
+			problems = append(problems, fmt.Sprintf("deployment %s has %d/%d replicas ready", deployment.Name, deployment.Status.ReadyReplicas, desired))
+		}
+
+		podRestarts, err := check.getPodRestarts(ctx, deployment)
+		if err != nil {
+			return outcomes.Fail{Error: err}
+		}
+		restarts = append(restarts, podRestarts...)
+	}
+
+	if len(problems) > 0 {
+		return outcomes.Fail{Error: errors.Wrapf(ErrDeploymentNotReady, "%s", strings.Join(append(problems, restarts...), "\n"))}
+	}
+	if len(restarts) > 0 {
+		return outcomes.Warning{Diagnostics: strings.Join(restarts, "\n")}
+	}
+	return outcomes.Pass{Diagnostics: strings.Join(readySummaries, ", ")}
+}
+
+// getPodRestarts returns a description of every container of the deployment's pods that restarted
+func (check DeploymentReadinessCheck) getPodRestarts(ctx context.Context, deployment *appsv1.Deployment) ([]string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid selector in deployment %s", deployment.Name)
+	}
+	pods, err := check.client.CoreV1().Pods(deployment.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list pods of deployment %s", deployment.Name)
+	}
+
+	var restarts []string
+	for _, pod := range pods.Items {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.RestartCount == 0 {
+				continue
+			}
+			restart := fmt.Sprintf("container %s of pod %s restarted %d times", containerStatus.Name, pod.Name, containerStatus.RestartCount)
+			if terminated := containerStatus.LastTerminationState.Terminated; terminated != nil {
+				restart += fmt.Sprintf(" (last termination: %s, exit code %d)", terminated.Reason, terminated.ExitCode)
+			}
+			restarts = append(restarts, restart)
+		}
+	}
+	sort.Strings(restarts)
+	return restarts, nil
+}
+
+func getDeploymentCondition(deployment *appsv1.Deployment, conditionType appsv1.DeploymentConditionType) *appsv1.DeploymentCondition {
+	for i := range deployment.Status.Conditions {
+		if deployment.Status.Conditions[i].Type == conditionType {
+			return &deployment.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// Suggestion implements common.Runnable
+func (check DeploymentReadinessCheck) Suggestion() string {
+	return fmt.Sprintf("Check the events and logs of the %s pods: kubectl describe pods -n %s -l app=%s", check.deploymentName, check.osmControlPlaneNamespace, check.deploymentName)
+}
+
+// FixIt implements common.Runnable
+func (check DeploymentReadinessCheck) FixIt() error {
+	panic("implement me")
+}
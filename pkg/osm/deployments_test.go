@@ -0,0 +1,119 @@
+package osm
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+func newDeployment(name string, replicas int32, readyReplicas int32, available corev1.ConditionStatus) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "osm-system",
+			Labels:    map[string]string{"app": name},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+		},
+		Status: appsv1.DeploymentStatus{
+			ReadyReplicas: readyReplicas,
+			Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: available, Message: "Deployment does not have minimum availability."},
+			},
+		},
+	}
+}
+
+func newControlPlanePod(name string, app string, restarts int32) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "osm-system",
+			Labels:    map[string]string{"app": app},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{Name: app, RestartCount: restarts}},
+		},
+	}
+	if restarts > 0 {
+		pod.Status.ContainerStatuses[0].LastTerminationState.Terminated = &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}
+	}
+	return pod
+}
+
+func TestDeploymentReadinessCheck(t *testing.T) {
+	tests := []struct {
+		name            string
+		objects         []runtime.Object
+		optional        bool
+		expectedOutcome outcomes.Outcome
+		expectedErr     error
+		expectedMessage string
+	}{
+		{
+			name: "deployment is ready",
+			objects: []runtime.Object{
+				newDeployment("osm-controller", 1, 1, corev1.ConditionTrue),
+				newControlPlanePod("osm-controller-1", "osm-controller", 0),
+			},
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "required deployment is missing",
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrDeploymentNotFound,
+		},
+		{
+			name:            "optional deployment is missing",
+			optional:        true,
+			expectedOutcome: outcomes.Info{},
+		},
+		{
+			name: "deployment has replicas that are not ready",
+			objects: []runtime.Object{
+				newDeployment("osm-controller", 2, 1, corev1.ConditionFalse),
+			},
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrDeploymentNotReady,
+			expectedMessage: "has 1/2 replicas ready",
+		},
+		{
+			name: "deployment pods restarted",
+			objects: []runtime.Object{
+				newDeployment("osm-controller", 1, 1, corev1.ConditionTrue),
+				newControlPlanePod("osm-controller-1", "osm-controller", 3),
+				newControlPlanePod("osm-injector-1", "osm-injector", 5),
+			},
+			expectedOutcome: outcomes.Warning{},
+			expectedMessage: "container osm-controller of pod osm-controller-1 restarted 3 times (last termination: OOMKilled, exit code 137)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			outcome := NewDeploymentReadinessCheck(fake.NewSimpleClientset(test.objects...), "osm-system", "osm-controller", test.optional).Run(context.TODO())
+
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.ErrorIs(outcome.GetError(), test.expectedErr)
+			if test.expectedMessage != "" {
+				message := outcome.GetDiagnostics()
+				if outcome.GetError() != nil {
+					message = outcome.GetError().Error()
+				}
+				assert.Contains(message, test.expectedMessage)
+				assert.NotContains(message, "osm-injector")
+			}
+		})
+	}
+}
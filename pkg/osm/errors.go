@@ -0,0 +1,47 @@
+package osm
+
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
+
+var (
+	// ErrDeploymentNotFound is returned when an OSM control plane deployment does not exist.
+	ErrDeploymentNotFound = outcomes.NewError("DEPLOYMENT_NOT_FOUND", "deployment not found")
+
+	// ErrDeploymentNotReady is returned when an OSM control plane deployment is not available or has replicas that are not ready.
+	ErrDeploymentNotReady = outcomes.NewError("DEPLOYMENT_NOT_READY", "deployment is not ready")
+
+	// ErrWebhookConfigurationNotFound is returned when no webhook configuration references an OSM control plane service.
+	ErrWebhookConfigurationNotFound = outcomes.NewError("WEBHOOK_CONFIGURATION_NOT_FOUND", "webhook configuration not found")
+
+	// ErrWebhookCABundleMismatch is returned when the CA bundle of a webhook does not match the certificate served by its OSM component.
+	ErrWebhookCABundleMismatch = outcomes.NewError("WEBHOOK_CA_BUNDLE_MISMATCH", "webhook CA bundle does not match the webhook serving certificate")
+
+	// ErrWebhookCABundleInvalid is returned when the CA bundle of a webhook is empty, malformed or expired.
+	ErrWebhookCABundleInvalid = outcomes.NewError("WEBHOOK_CA_BUNDLE_INVALID", "webhook CA bundle is invalid")
+
+	// ErrWebhookNamespaceSelectorMismatch is returned when a webhook selects different namespaces than the ones monitored by OSM.
+	ErrWebhookNamespaceSelectorMismatch = outcomes.NewError("WEBHOOK_NAMESPACE_SELECTOR_MISMATCH", "webhook namespace selector does not match the namespaces monitored by OSM")
+
+	// ErrMeshConfigNotFound is returned when the OSM MeshConfig resource does not exist.
+	ErrMeshConfigNotFound = outcomes.NewError("MESH_CONFIG_NOT_FOUND", "MeshConfig not found")
+
+	// ErrMeshConfigUnreadable is returned when the OSM MeshConfig resource cannot be read, e.g. for lack of access.
+	ErrMeshConfigUnreadable = outcomes.NewError("MESH_CONFIG_UNREADABLE", "unable to read MeshConfig")
+
+	// ErrMeshConfigInvalid is returned when the OSM MeshConfig resource has malformed values.
+	ErrMeshConfigInvalid = outcomes.NewError("MESH_CONFIG_INVALID", "MeshConfig is invalid")
+
+	// ErrCRDsMissing is returned when the CRDs the running OSM version expects are not installed.
+	ErrCRDsMissing = outcomes.NewError("CRDS_MISSING", "CRDs are not installed at the versions expected by OSM")
+
+	// ErrHelmNotConfigured is returned when the Helm action configuration could not be initialized.
+	ErrHelmNotConfigured = outcomes.NewError("HELM_NOT_CONFIGURED", "helm is not configured")
+
+	// ErrHelmReleaseNotFound is returned when no release of the osm chart exists in the control plane namespace.
+	ErrHelmReleaseNotFound = outcomes.NewError("HELM_RELEASE_NOT_FOUND", "osm helm release not found")
+
+	// ErrHelmReleaseNotDeployed is returned when the osm chart release does not have the deployed status.
+	ErrHelmReleaseNotDeployed = outcomes.NewError("HELM_RELEASE_NOT_DEPLOYED", "osm helm release is not deployed")
+
+	// ErrHelmChartVersionMismatch is returned when the osm chart version differs from the version of the running control plane.
+	ErrHelmChartVersionMismatch = outcomes.NewError("HELM_CHART_VERSION_MISMATCH", "osm helm chart version does not match the control plane version")
+)
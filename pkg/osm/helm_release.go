@@ -0,0 +1,293 @@
+package osm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/cluster"
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/utils"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm/pkg/constants"
+	configClient "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned"
+)
+
+// osmChartName is the name of the Helm chart OSM is installed with
+const osmChartName = "osm"
+
+// withSnapshotReleases returns a copy of actionConfig reading the Helm releases in the control plane namespace through
+// kubeClient when a cluster snapshot is recorded or replayed, so that the Helm checks are recorded and replayed with the
+// other checks. Otherwise actionConfig is returned as is.
+func withSnapshotReleases(actionConfig *action.Configuration, kubeClient kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) *action.Configuration {
+	if actionConfig == nil || kubeClient == nil || (cluster.ActiveRecorder() == nil && cluster.ActiveReplay() == nil) {
+		return actionConfig
+	}
+	snapshotConfig := *actionConfig
+	// Helm stores the releases in secrets by default
+	snapshotConfig.Releases = storage.Init(driver.NewSecrets(kubeClient.CoreV1().Secrets(osmControlPlaneNamespace.String())))
+	return &snapshotConfig
+}
+
+// getOSMRelease returns the latest release of the osm chart in the control plane namespace
+func getOSMRelease(actionConfig *action.Configuration, osmControlPlaneNamespace common.MeshNamespace) (*release.Release, error) {
+	if actionConfig == nil || actionConfig.Releases == nil || actionConfig.KubeClient == nil {
+		return nil, ErrHelmNotConfigured
+	}
+
+	list := action.NewList(actionConfig)
+	list.StateMask = action.ListAll
+	releases, err := list.Run()
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list helm releases in namespace %s", osmControlPlaneNamespace)
+	}
+
+	var osmReleases []*release.Release
+	for _, rel := range releases {
+		if rel.Namespace == osmControlPlaneNamespace.String() && rel.Chart != nil && rel.Chart.Metadata != nil && rel.Chart.Metadata.Name == osmChartName {
+			osmReleases = append(osmReleases, rel)
+		}
+	}
+	if len(osmReleases) == 0 {
+		return nil, errors.Wrapf(ErrHelmReleaseNotFound, "no release of the %s chart in namespace %s", osmChartName, osmControlPlaneNamespace)
+	}
+	if len(osmReleases) > 1 {
+		var names []string
+		for _, rel := range osmReleases {
+			names = append(names, rel.Name)
+		}
+		return nil, errors.Errorf("found more than one release of the %s chart in namespace %s: %s", osmChartName, osmControlPlaneNamespace, strings.Join(names, ", "))
+	}
+	return osmReleases[0], nil
+}
+
+// Verify interface compliance
+var _ runner.Runnable = (*HelmReleaseStatusCheck)(nil)
+
+// HelmReleaseStatusCheck implements common.Runnable
+type HelmReleaseStatusCheck struct {
+	actionConfig             *action.Configuration
+	osmControlPlaneNamespace common.MeshNamespace
+}
+
+// NewHelmReleaseStatusCheck checks whether the osm chart release exists in the control plane namespace and is deployed
+func NewHelmReleaseStatusCheck(actionConfig *action.Configuration, osmControlPlaneNamespace common.MeshNamespace) HelmReleaseStatusCheck {
+	return HelmReleaseStatusCheck{
+		actionConfig:             actionConfig,
+		osmControlPlaneNamespace: osmControlPlaneNamespace,
+	}
+}
+
+// Description implements common.Runnable
+func (check HelmReleaseStatusCheck) Description() string {
+	return fmt.Sprintf("Checking whether the %s helm release in namespace %s is deployed", osmChartName, check.osmControlPlaneNamespace)
+}
+
+// Run implements common.Runnable
+func (check HelmReleaseStatusCheck) Run(ctx context.Context) outcomes.Outcome {
+	rel, err := getOSMRelease(check.actionConfig, check.osmControlPlaneNamespace)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	if rel.Info == nil || rel.Info.Status != release.StatusDeployed {
+		status, description := release.StatusUnknown, ""
+		if rel.Info != nil {
+			status, description = rel.Info.Status, rel.Info.Description
+		}
+		return outcomes.Fail{Error: errors.Wrapf(ErrHelmReleaseNotDeployed, "release %s revision %d has status %s: %s", rel.Name, rel.Version, status, description)}
+	}
+	return outcomes.Pass{Diagnostics: fmt.Sprintf("release %s revision %d is deployed", rel.Name, rel.Version)}
+}
+
+// Suggestion implements common.Runnable
+func (check HelmReleaseStatusCheck) Suggestion() string {
+	return fmt.Sprintf("Inspect the release with `helm history -n %s` and roll back or upgrade it to a deployed revision", check.osmControlPlaneNamespace)
+}
+
+// FixIt implements common.Runnable
+func (check HelmReleaseStatusCheck) FixIt() error {
+	panic("implement me")
+}
+
+// Verify interface compliance
+var _ runner.Runnable = (*HelmChartVersionCheck)(nil)
+
+// HelmChartVersionCheck implements common.Runnable
+type HelmChartVersionCheck struct {
+	actionConfig             *action.Configuration
+	client                   kubernetes.Interface
+	osmControlPlaneNamespace common.MeshNamespace
+}
+
+// NewHelmChartVersionCheck checks whether the osm chart version matches the version label of the osm-controller deployment
+func NewHelmChartVersionCheck(actionConfig *action.Configuration, client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) HelmChartVersionCheck {
+	return HelmChartVersionCheck{
+		actionConfig:             actionConfig,
+		client:                   client,
+		osmControlPlaneNamespace: osmControlPlaneNamespace,
+	}
+}
+
+// Description implements common.Runnable
+func (check HelmChartVersionCheck) Description() string {
+	return fmt.Sprintf("Checking whether the %s helm chart version matches the OSM control plane version in namespace %s", osmChartName, check.osmControlPlaneNamespace)
+}
+
+// Run implements common.Runnable
+func (check HelmChartVersionCheck) Run(ctx context.Context) outcomes.Outcome {
+	rel, err := getOSMRelease(check.actionConfig, check.osmControlPlaneNamespace)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	deployment, err := utils.GetOSMControllerDeployment(ctx, check.client, check.osmControlPlaneNamespace)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	controlPlaneVersion := deployment.Labels[constants.OSMAppVersionLabelKey]
+	chartVersion := rel.Chart.Metadata.Version
+	if strings.TrimPrefix(chartVersion, "v") != strings.TrimPrefix(controlPlaneVersion, "v") {
+		return outcomes.Fail{Error: errors.Wrapf(ErrHelmChartVersionMismatch,
+			"release %s uses chart version %s (app version %s), but deployment %s has label %s=%s",
+			rel.Name, chartVersion, rel.Chart.Metadata.AppVersion, deployment.Name, constants.OSMAppVersionLabelKey, controlPlaneVersion)}
+	}
+	return outcomes.Pass{Diagnostics: fmt.Sprintf("chart version %s", chartVersion)}
+}
+
+// Suggestion implements common.Runnable
+func (check HelmChartVersionCheck) Suggestion() string {
+	return fmt.Sprintf("Upgrade OSM with the CLI or chart of the version it is running, or restart the control plane in namespace %s if an upgrade did not complete", check.osmControlPlaneNamespace)
+}
+
+// FixIt implements common.Runnable
+func (check HelmChartVersionCheck) FixIt() error {
+	panic("implement me")
+}
+
+// Verify interface compliance
+var _ runner.Runnable = (*HelmValuesDriftCheck)(nil)
+
+// HelmValuesDriftCheck implements common.Runnable
+type HelmValuesDriftCheck struct {
+	actionConfig             *action.Configuration
+	client                   kubernetes.Interface
+	configClient             configClient.Interface
+	osmControlPlaneNamespace common.MeshNamespace
+}
+
+// NewHelmValuesDriftCheck checks whether the values rendered by the osm chart release match the live MeshConfig and deployments
+func NewHelmValuesDriftCheck(actionConfig *action.Configuration, client kubernetes.Interface, configClient configClient.Interface, osmControlPlaneNamespace common.MeshNamespace) HelmValuesDriftCheck {
+	return HelmValuesDriftCheck{
+		actionConfig:             actionConfig,
+		client:                   client,
+		configClient:             configClient,
+		osmControlPlaneNamespace: osmControlPlaneNamespace,
+	}
+}
+
+// Description implements common.Runnable
+func (check HelmValuesDriftCheck) Description() string {
+	return fmt.Sprintf("Checking whether the %s helm release values match the live OSM configuration in namespace %s", osmChartName, check.osmControlPlaneNamespace)
+}
+
+// Run implements common.Runnable
+func (check HelmValuesDriftCheck) Run(ctx context.Context) outcomes.Outcome {
+	rel, err := getOSMRelease(check.actionConfig, check.osmControlPlaneNamespace)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	values, err := chartutil.CoalesceValues(rel.Chart, rel.Config)
+	if err != nil {
+		return outcomes.Fail{Error: errors.Wrapf(err, "unable to compute the values of release %s", rel.Name)}
+	}
+
+	deployment, err := utils.GetOSMControllerDeployment(ctx, check.client, check.osmControlPlaneNamespace)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	meshConfig, err := check.configClient.ConfigV1alpha1().MeshConfigs(check.osmControlPlaneNamespace.String()).Get(ctx, constants.OSMMeshConfig, metav1.GetOptions{})
+	if err != nil {
+		return outcomes.Fail{Error: errors.Wrapf(err, "unable to get MeshConfig %s/%s", check.osmControlPlaneNamespace, constants.OSMMeshConfig)}
+	}
+
+	comparisons := []valueComparison{
+		{"OpenServiceMesh.meshName", getValue(values, "OpenServiceMesh.meshName"),
+			fmt.Sprintf("deployment %s label %s", deployment.Name, constants.OSMAppInstanceLabelKey), deployment.Labels[constants.OSMAppInstanceLabelKey]},
+		{"OpenServiceMesh.enablePermissiveTrafficPolicy", getValue(values, "OpenServiceMesh.enablePermissiveTrafficPolicy"),
+			"MeshConfig spec.traffic.enablePermissiveTrafficPolicyMode", fmt.Sprint(meshConfig.Spec.Traffic.EnablePermissiveTrafficPolicyMode)},
+		{"OpenServiceMesh.enableEgress", getValue(values, "OpenServiceMesh.enableEgress"),
+			"MeshConfig spec.traffic.enableEgress", fmt.Sprint(meshConfig.Spec.Traffic.EnableEgress)},
+		{"OpenServiceMesh.sidecarImage", getValue(values, "OpenServiceMesh.sidecarImage"),
+			"MeshConfig spec.sidecar.envoyImage", meshConfig.Spec.Sidecar.EnvoyImage},
+		{"OpenServiceMesh.envoyLogLevel", getValue(values, "OpenServiceMesh.envoyLogLevel"),
+			"MeshConfig spec.sidecar.logLevel", meshConfig.Spec.Sidecar.LogLevel},
+		{"OpenServiceMesh.controllerLogLevel", getValue(values, "OpenServiceMesh.controllerLogLevel"),
+			"MeshConfig spec.observability.osmLogLevel", meshConfig.Spec.Observability.OSMLogLevel},
+		{"OpenServiceMesh.certificateProvider.serviceCertValidityDuration", getValue(values, "OpenServiceMesh.certificateProvider.serviceCertValidityDuration"),
+			"MeshConfig spec.certificate.serviceCertValidityDuration", meshConfig.Spec.Certificate.ServiceCertValidityDuration},
+	}
+
+	if registry, tag := getValue(values, "OpenServiceMesh.image.registry"), getValue(values, "OpenServiceMesh.image.tag"); registry != "" && tag != "" {
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			if container.Name == constants.OSMControllerName {
+				comparisons = append(comparisons, valueComparison{"OpenServiceMesh.image", fmt.Sprintf("%s/%s:%s", registry, constants.OSMControllerName, tag),
+					fmt.Sprintf("deployment %s container %s image", deployment.Name, container.Name), container.Image})
+			}
+		}
+	}
+	// The osm-controller replicas are managed by a HorizontalPodAutoscaler when auto scaling is enabled.
+	if getValue(values, "OpenServiceMesh.osmController.autoScale.enable") != "true" && deployment.Spec.Replicas != nil {
+		comparisons = append(comparisons, valueComparison{"OpenServiceMesh.osmController.replicaCount", getValue(values, "OpenServiceMesh.osmController.replicaCount"),
+			fmt.Sprintf("deployment %s spec.replicas", deployment.Name), fmt.Sprint(*deployment.Spec.Replicas)})
+	}
+
+	var drift []string
+	for _, comparison := range comparisons {
+		// Values unknown to the chart version of the release are not compared.
+		if comparison.rendered == "" || comparison.rendered == comparison.live {
+			continue
+		}
+		drift = append(drift, fmt.Sprintf("%s is %q in release %s, but %s is %q", comparison.path, comparison.rendered, rel.Name, comparison.source, comparison.live))
+	}
+	if len(drift) > 0 {
+		return outcomes.Warning{Diagnostics: strings.Join(drift, "\n")}
+	}
+	return outcomes.Pass{}
+}
+
+// getValue returns the string form of the value at the given path, or an empty string if the path is not set
+func getValue(values chartutil.Values, path string) string {
+	value, err := values.PathValue(path)
+	if err != nil || value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// valueComparison compares the rendered value at a path of the release values to the live value of its source
+type valueComparison struct {
+	path     string
+	rendered string
+	source   string
+	live     string
+}
+
+// Suggestion implements common.Runnable
+func (check HelmValuesDriftCheck) Suggestion() string {
+	return fmt.Sprintf("Changes made directly to the MeshConfig or deployments are reverted by the next `helm upgrade`; set them as values of the %s release instead", osmChartName)
+}
+
+// FixIt implements common.Runnable
+func (check HelmValuesDriftCheck) FixIt() error {
+	panic("implement me")
+}
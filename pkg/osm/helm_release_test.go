@@ -0,0 +1,237 @@
+package osm
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	restclient "k8s.io/client-go/rest"
+
+	"github.com/openservicemesh/osm-health/pkg/cluster"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+	configFake "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned/fake"
+)
+
+func newHelmActionConfig(t *testing.T, releases ...*release.Release) *action.Configuration {
+	actionConfig := &action.Configuration{
+		Releases:     storage.Init(driver.NewMemory()),
+		KubeClient:   &kubefake.PrintingKubeClient{Out: ioutil.Discard},
+		Capabilities: chartutil.DefaultCapabilities,
+		Log:          func(string, ...interface{}) {},
+	}
+	for _, rel := range releases {
+		if err := actionConfig.Releases.Create(rel); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return actionConfig
+}
+
+func newOSMRelease(status release.Status, chartVersion string, config map[string]interface{}) *release.Release {
+	return &release.Release{
+		Name:      "osm",
+		Namespace: "osm-system",
+		Version:   1,
+		Info:      &release.Info{Status: status, Description: "Install complete"},
+		Chart: &chart.Chart{
+			Metadata: &chart.Metadata{Name: osmChartName, Version: chartVersion, AppVersion: "v" + chartVersion},
+			Values: map[string]interface{}{
+				"OpenServiceMesh": map[string]interface{}{
+					"meshName":                      "osm",
+					"enablePermissiveTrafficPolicy": false,
+					"sidecarImage":                  "envoyproxy/envoy-alpine:v1.19.0",
+					"image":                         map[string]interface{}{"registry": "openservicemesh", "tag": "v" + chartVersion},
+					"osmController":                 map[string]interface{}{"replicaCount": 1},
+				},
+			},
+		},
+		Config: config,
+	}
+}
+
+func TestHelmReleaseStatusCheck(t *testing.T) {
+	tests := []struct {
+		name            string
+		releases        []*release.Release
+		expectedOutcome outcomes.Outcome
+		expectedErr     error
+	}{
+		{
+			name:            "release is deployed",
+			releases:        []*release.Release{newOSMRelease(release.StatusDeployed, "0.9.2", nil)},
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "release failed",
+			releases:        []*release.Release{newOSMRelease(release.StatusFailed, "0.9.2", nil)},
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrHelmReleaseNotDeployed,
+		},
+		{
+			name:            "release is missing",
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrHelmReleaseNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			outcome := NewHelmReleaseStatusCheck(newHelmActionConfig(t, test.releases...), "osm-system").Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.ErrorIs(outcome.GetError(), test.expectedErr)
+		})
+	}
+
+	outcome := NewHelmReleaseStatusCheck(nil, "osm-system").Run(context.TODO())
+	tassert.ErrorIs(t, outcome.GetError(), ErrHelmNotConfigured)
+}
+
+func TestHelmReleaseStatusCheckReplay(t *testing.T) {
+	assert := tassert.New(t)
+
+	// Helm stores the release in a secret of the control plane namespace
+	helmClient := fake.NewSimpleClientset()
+	assert.Nil(storage.Init(driver.NewSecrets(helmClient.CoreV1().Secrets("osm-system"))).Create(newOSMRelease(release.StatusDeployed, "0.9.2", nil)))
+	secrets, err := helmClient.CoreV1().Secrets("osm-system").List(context.TODO(), metav1.ListOptions{})
+	assert.Nil(err)
+	secrets.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "SecretList"}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/namespaces/osm-system/secrets" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(json.NewEncoder(w).Encode(secrets))
+	}))
+	defer ts.Close()
+
+	// Record the release read by the check, then replay it
+	recorder := cluster.NewRecorder()
+	cluster.SetRecorder(recorder)
+	kubeConfig := &restclient.Config{Host: ts.URL}
+	kubeConfig.Wrap(recorder.WrapTransport)
+	actionConfig := withSnapshotReleases(newHelmActionConfig(t), kubernetes.NewForConfigOrDie(kubeConfig), "osm-system")
+	outcome := NewHelmReleaseStatusCheck(actionConfig, "osm-system").Run(context.TODO())
+	cluster.SetRecorder(nil)
+	assert.Equal(outcomes.Pass{Diagnostics: "release osm revision 1 is deployed"}, outcome)
+
+	snapshotPath := t.TempDir()
+	assert.Nil(recorder.Save(snapshotPath))
+	replay, err := cluster.LoadReplay(snapshotPath)
+	assert.Nil(err)
+	cluster.SetReplay(replay)
+	defer cluster.SetReplay(nil)
+
+	actionConfig = withSnapshotReleases(newHelmActionConfig(t), replay.KubeClient(), "osm-system")
+	outcome = NewHelmReleaseStatusCheck(actionConfig, "osm-system").Run(context.TODO())
+	assert.Equal(outcomes.Pass{Diagnostics: "release osm revision 1 is deployed"}, outcome)
+}
+
+func TestHelmChartVersionCheck(t *testing.T) {
+	tests := []struct {
+		name            string
+		chartVersion    string
+		expectedOutcome outcomes.Outcome
+		expectedErr     error
+	}{
+		{
+			name:            "chart version matches the control plane version",
+			chartVersion:    "0.9.2",
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "chart version differs from the control plane version",
+			chartVersion:    "0.10.0",
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrHelmChartVersionMismatch,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			deployment := newDeployment(constants.OSMControllerName, 1, 1, corev1.ConditionTrue)
+			deployment.Labels[constants.OSMAppVersionLabelKey] = "v0.9.2"
+
+			actionConfig := newHelmActionConfig(t, newOSMRelease(release.StatusDeployed, test.chartVersion, nil))
+			outcome := NewHelmChartVersionCheck(actionConfig, fake.NewSimpleClientset(deployment), "osm-system").Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.ErrorIs(outcome.GetError(), test.expectedErr)
+		})
+	}
+}
+
+func TestHelmValuesDriftCheck(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          map[string]interface{}
+		permissive      bool
+		replicas        int32
+		expectedOutcome outcomes.Outcome
+		expectedDrift   []string
+	}{
+		{
+			name:            "live configuration matches the release values",
+			replicas:        1,
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "MeshConfig and deployment were changed outside of helm",
+			permissive:      true,
+			replicas:        3,
+			expectedOutcome: outcomes.Warning{},
+			expectedDrift: []string{
+				`OpenServiceMesh.enablePermissiveTrafficPolicy is "false" in release osm, but MeshConfig spec.traffic.enablePermissiveTrafficPolicyMode is "true"`,
+				`OpenServiceMesh.osmController.replicaCount is "1" in release osm, but deployment osm-controller spec.replicas is "3"`,
+			},
+		},
+		{
+			name:            "user-supplied values override the chart defaults",
+			config:          map[string]interface{}{"OpenServiceMesh": map[string]interface{}{"enablePermissiveTrafficPolicy": true}},
+			permissive:      true,
+			replicas:        1,
+			expectedOutcome: outcomes.Pass{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			deployment := newDeployment(constants.OSMControllerName, test.replicas, test.replicas, corev1.ConditionTrue)
+			deployment.Labels[constants.OSMAppInstanceLabelKey] = "osm"
+			deployment.Spec.Template.Spec.Containers = []corev1.Container{{Name: constants.OSMControllerName, Image: "openservicemesh/osm-controller:v0.9.2"}}
+			meshConfig := &configv1alpha1.MeshConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: constants.OSMMeshConfig, Namespace: "osm-system"},
+				Spec: configv1alpha1.MeshConfigSpec{
+					Sidecar: configv1alpha1.SidecarSpec{EnvoyImage: "envoyproxy/envoy-alpine:v1.19.0"},
+					Traffic: configv1alpha1.TrafficSpec{EnablePermissiveTrafficPolicyMode: test.permissive},
+				},
+			}
+
+			actionConfig := newHelmActionConfig(t, newOSMRelease(release.StatusDeployed, "0.9.2", test.config))
+			outcome := NewHelmValuesDriftCheck(actionConfig, fake.NewSimpleClientset(deployment), configFake.NewSimpleClientset(meshConfig), "osm-system").Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			for _, drift := range test.expectedDrift {
+				assert.Contains(outcome.GetDiagnostics(), drift)
+			}
+		})
+	}
+}
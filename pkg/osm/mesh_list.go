@@ -0,0 +1,48 @@
+package osm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
+	"github.com/openservicemesh/osm-health/pkg/osm/utils"
+)
+
+// ListMeshes prints every OSM control plane in the cluster with its version and monitored namespaces.
+func ListMeshes(ctx context.Context) error {
+	client, err := pod.GetKubeClient()
+	if err != nil {
+		return err
+	}
+
+	meshes, err := utils.ListMeshes(ctx, client)
+	if err != nil {
+		return err
+	}
+	if len(meshes) == 0 {
+		return utils.ErrNoMeshesFound
+	}
+	return printMeshes(os.Stdout, meshes)
+}
+
+// printMeshes prints a table of meshes
+func printMeshes(out io.Writer, meshes []utils.Mesh) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	if _, err := fmt.Fprintln(w, "MESH NAME\tNAMESPACE\tVERSION\tMONITORED NAMESPACES"); err != nil {
+		return err
+	}
+	for _, mesh := range meshes {
+		monitoredNamespaces := strings.Join(mesh.MonitoredNamespaces, ",")
+		if monitoredNamespaces == "" {
+			monitoredNamespaces = "<none>"
+		}
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", mesh.Name, mesh.Namespace, mesh.DetectedOSMVersion, monitoredNamespaces); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
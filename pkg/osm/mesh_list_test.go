@@ -0,0 +1,30 @@
+package osm
+
+import (
+	"bytes"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/osm/utils"
+)
+
+func TestPrintMeshes(t *testing.T) {
+	assert := tassert.New(t)
+	var out bytes.Buffer
+	err := printMeshes(&out, []utils.Mesh{
+		{
+			MeshInfo:            utils.MeshInfo{Name: "edge", Namespace: "osm-edge", DetectedOSMVersion: "v0.10"},
+			MonitoredNamespaces: nil,
+		},
+		{
+			MeshInfo:            utils.MeshInfo{Name: "osm", Namespace: "osm-system", DetectedOSMVersion: "v0.9"},
+			MonitoredNamespaces: []string{"bookbuyer", "bookstore"},
+		},
+	})
+	assert.Nil(err)
+	assert.Equal(`MESH NAME   NAMESPACE    VERSION   MONITORED NAMESPACES
+edge        osm-edge     v0.10     <none>
+osm         osm-system   v0.9      bookbuyer,bookstore
+`, out.String())
+}
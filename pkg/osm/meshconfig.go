@@ -0,0 +1,88 @@
+package osm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm/pkg/constants"
+	configClient "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*MeshConfigExistsCheck)(nil)
+
+// MeshConfigExistsCheck implements common.Runnable
+type MeshConfigExistsCheck struct {
+	configClient             configClient.Interface
+	osmControlPlaneNamespace common.MeshNamespace
+}
+
+// NewMeshConfigExistsCheck checks whether the OSM MeshConfig resource exists in the control plane namespace and parses
+func NewMeshConfigExistsCheck(configClient configClient.Interface, osmControlPlaneNamespace common.MeshNamespace) MeshConfigExistsCheck {
+	return MeshConfigExistsCheck{
+		configClient:             configClient,
+		osmControlPlaneNamespace: osmControlPlaneNamespace,
+	}
+}
+
+// Description implements common.Runnable
+func (check MeshConfigExistsCheck) Description() string {
+	return fmt.Sprintf("Checking whether MeshConfig %s/%s exists and parses", check.osmControlPlaneNamespace, constants.OSMMeshConfig)
+}
+
+// Run implements common.Runnable
+func (check MeshConfigExistsCheck) Run(ctx context.Context) outcomes.Outcome {
+	meshConfig, err := check.configClient.ConfigV1alpha1().MeshConfigs(check.osmControlPlaneNamespace.String()).Get(ctx, constants.OSMMeshConfig, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return outcomes.Fail{Error: errors.Wrapf(ErrMeshConfigNotFound, "%s/%s", check.osmControlPlaneNamespace, constants.OSMMeshConfig)}
+	}
+	if err != nil {
+		return outcomes.Fail{Error: errors.Wrapf(ErrMeshConfigUnreadable, "%s/%s: %s", check.osmControlPlaneNamespace, constants.OSMMeshConfig, err)}
+	}
+
+	durations := [][2]string{
+		{"spec.sidecar.configResyncInterval", meshConfig.Spec.Sidecar.ConfigResyncInterval},
+		{"spec.certificate.serviceCertValidityDuration", meshConfig.Spec.Certificate.ServiceCertValidityDuration},
+		{"spec.traffic.inboundExternalAuthorization.timeout", meshConfig.Spec.Traffic.InboundExternalAuthorization.Timeout},
+	}
+	if meshConfig.Spec.Certificate.IngressGateway != nil {
+		durations = append(durations, [2]string{"spec.certificate.ingressGateway.validityDuration", meshConfig.Spec.Certificate.IngressGateway.ValidityDuration})
+	}
+
+	var malformed []string
+	for _, duration := range durations {
+		field, value := duration[0], duration[1]
+		if value == "" {
+			continue
+		}
+		if _, err := time.ParseDuration(value); err != nil {
+			malformed = append(malformed, fmt.Sprintf("%s: %q is not a duration", field, value))
+		}
+	}
+	if len(malformed) > 0 {
+		return outcomes.Fail{Error: errors.Wrapf(ErrMeshConfigInvalid, "%s/%s:\n%s", check.osmControlPlaneNamespace, constants.OSMMeshConfig, strings.Join(malformed, "\n"))}
+	}
+	return outcomes.Pass{}
+}
+
+// Suggestion implements common.Runnable
+func (check MeshConfigExistsCheck) Suggestion() string {
+	return fmt.Sprintf("Restore MeshConfig %s/%s with `helm upgrade` or fix its malformed values with `kubectl edit meshconfig %s -n %s`; "+
+		"if it cannot be read, check that `kubectl get meshconfig %s -n %s` succeeds with your credentials",
+		check.osmControlPlaneNamespace, constants.OSMMeshConfig, constants.OSMMeshConfig, check.osmControlPlaneNamespace,
+		constants.OSMMeshConfig, check.osmControlPlaneNamespace)
+}
+
+// FixIt implements common.Runnable
+func (check MeshConfigExistsCheck) FixIt() error {
+	panic("implement me")
+}
@@ -0,0 +1,108 @@
+package meshconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+const (
+	// minCertValidityDuration is the validity duration below which certificates are rotated often enough to load the control plane
+	minCertValidityDuration = time.Hour
+
+	// maxCertValidityDuration is the validity duration above which a leaked certificate remains usable for too long
+	maxCertValidityDuration = 365 * 24 * time.Hour
+
+	// minConfigResyncInterval is the resync interval below which periodic proxy updates load the control plane
+	minConfigResyncInterval = time.Minute
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*DurationsCheck)(nil)
+
+// DurationsCheck implements common.Runnable
+type DurationsCheck struct {
+	meshConfigGetter config.MeshConfigGetter
+}
+
+// NewDurationsCheck checks whether the certificate validity durations and the proxy config resync interval in the MeshConfig are sensible
+func NewDurationsCheck(meshConfigGetter config.MeshConfigGetter) DurationsCheck {
+	return DurationsCheck{
+		meshConfigGetter: meshConfigGetter,
+	}
+}
+
+// Description implements common.Runnable
+func (check DurationsCheck) Description() string {
+	return "Checking the certificate validity durations and the proxy config resync interval"
+}
+
+// Run implements common.Runnable
+func (check DurationsCheck) Run(ctx context.Context) outcomes.Outcome {
+	meshConfig, err := check.meshConfigGetter.GetMeshConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	certDurations := [][2]string{
+		{"spec.certificate.serviceCertValidityDuration", meshConfig.Spec.Certificate.ServiceCertValidityDuration},
+	}
+	if meshConfig.Spec.Certificate.IngressGateway != nil {
+		certDurations = append(certDurations, [2]string{"spec.certificate.ingressGateway.validityDuration", meshConfig.Spec.Certificate.IngressGateway.ValidityDuration})
+	}
+
+	var invalid, warnings []string
+	for _, certDuration := range certDurations {
+		field, value := certDuration[0], certDuration[1]
+		if value == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration <= 0 {
+			invalid = append(invalid, fmt.Sprintf("%s: %q is not a positive duration", field, value))
+			continue
+		}
+		if duration < minCertValidityDuration {
+			warnings = append(warnings, fmt.Sprintf("%s is %s: certificates shorter-lived than %s are rotated often, which loads the osm-controller and the proxies", field, value, minCertValidityDuration))
+		}
+		if duration > maxCertValidityDuration {
+			warnings = append(warnings, fmt.Sprintf("%s is %s: a leaked certificate remains valid for longer than %s", field, value, maxCertValidityDuration))
+		}
+	}
+
+	if resyncInterval := meshConfig.Spec.Sidecar.ConfigResyncInterval; resyncInterval != "" {
+		duration, err := time.ParseDuration(resyncInterval)
+		switch {
+		case err != nil || duration < 0:
+			invalid = append(invalid, fmt.Sprintf("spec.sidecar.configResyncInterval: %q is not a duration", resyncInterval))
+		case duration > 0 && duration < minConfigResyncInterval:
+			warnings = append(warnings, fmt.Sprintf("spec.sidecar.configResyncInterval is %s: resyncing every proxy more often than every %s loads the osm-controller", resyncInterval, minConfigResyncInterval))
+		}
+	}
+
+	if len(invalid) > 0 {
+		return outcomes.Fail{Error: errors.Wrap(ErrInvalidDuration, strings.Join(invalid, "\n"))}
+	}
+	if len(warnings) > 0 {
+		return outcomes.Warning{Diagnostics: strings.Join(warnings, "\n")}
+	}
+	return outcomes.Pass{}
+}
+
+// Suggestion implements common.Runnable
+func (check DurationsCheck) Suggestion() string {
+	return fmt.Sprintf("Set certificate validity durations between %s and %s, and spec.sidecar.configResyncInterval to 0s (disabled) or at least %s with `kubectl edit meshconfig`",
+		minCertValidityDuration, maxCertValidityDuration, minConfigResyncInterval)
+}
+
+// FixIt implements common.Runnable
+func (check DurationsCheck) FixIt() error {
+	panic("implement me")
+}
@@ -0,0 +1,64 @@
+package meshconfig
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+)
+
+func TestDurationsCheck(t *testing.T) {
+	newMeshConfig := func(certValidity, resyncInterval string) *configv1alpha1.MeshConfig {
+		return &configv1alpha1.MeshConfig{Spec: configv1alpha1.MeshConfigSpec{
+			Sidecar:     configv1alpha1.SidecarSpec{ConfigResyncInterval: resyncInterval},
+			Certificate: configv1alpha1.CertificateSpec{ServiceCertValidityDuration: certValidity},
+		}}
+	}
+
+	tests := []struct {
+		name            string
+		meshConfig      *configv1alpha1.MeshConfig
+		expectedOutcome outcomes.Outcome
+		expectedErr     error
+	}{
+		{
+			name:            "sensible durations",
+			meshConfig:      newMeshConfig("24h", "0s"),
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "short-lived certificates",
+			meshConfig:      newMeshConfig("5m", "0s"),
+			expectedOutcome: outcomes.Warning{},
+		},
+		{
+			name:            "frequent config resync",
+			meshConfig:      newMeshConfig("24h", "5s"),
+			expectedOutcome: outcomes.Warning{},
+		},
+		{
+			name:            "malformed certificate validity duration",
+			meshConfig:      newMeshConfig("1 day", ""),
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrInvalidDuration,
+		},
+		{
+			name:            "MeshConfig is unavailable",
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     config.ErrMeshConfigUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			outcome := NewDurationsCheck(config.NewSnapshot(test.meshConfig)).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.ErrorIs(outcome.GetError(), test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package meshconfig
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+// envoyTagRegex matches the Envoy minor version at the start of an image tag, such as v1.19 in v1.19.1
+var envoyTagRegex = regexp.MustCompile(`^v?(\d+)\.(\d+)`)
+
+// Verify interface compliance
+var _ runner.Runnable = (*EnvoyImageCheck)(nil)
+
+// EnvoyImageCheck implements common.Runnable
+type EnvoyImageCheck struct {
+	meshConfigGetter config.MeshConfigGetter
+	osmVersion       version.ControllerVersion
+}
+
+// NewEnvoyImageCheck checks whether the Envoy image in the MeshConfig is a version supported by the OSM Controller
+func NewEnvoyImageCheck(meshConfigGetter config.MeshConfigGetter, osmVersion version.ControllerVersion) EnvoyImageCheck {
+	return EnvoyImageCheck{
+		meshConfigGetter: meshConfigGetter,
+		osmVersion:       osmVersion,
+	}
+}
+
+// Description implements common.Runnable
+func (check EnvoyImageCheck) Description() string {
+	return fmt.Sprintf("Checking whether the Envoy image is supported by OSM %s", check.osmVersion)
+}
+
+// Run implements common.Runnable
+func (check EnvoyImageCheck) Run(ctx context.Context) outcomes.Outcome {
+	meshConfig, err := check.meshConfigGetter.GetMeshConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	image := meshConfig.Spec.Sidecar.EnvoyImage
+	if image == "" {
+		return outcomes.Info{Diagnostics: "spec.sidecar.envoyImage is not set; the osm-injector uses its default Envoy image"}
+	}
+
+	supported, ok := version.SupportedEnvoyVersions[check.osmVersion]
+	if !ok {
+		return outcomes.Unknown{Reason: fmt.Sprintf("The Envoy versions supported by OSM %s are not known", check.osmVersion)}
+	}
+
+	envoyVersion, ok := getEnvoyVersion(image)
+	if !ok {
+		return outcomes.Info{Diagnostics: fmt.Sprintf("Cannot determine the Envoy version of image %s from its tag", image)}
+	}
+
+	var supportedVersions []string
+	for _, supportedVersion := range supported {
+		if supportedVersion == envoyVersion {
+			return outcomes.Pass{Diagnostics: fmt.Sprintf("Envoy %s", envoyVersion)}
+		}
+		supportedVersions = append(supportedVersions, string(supportedVersion))
+	}
+	return outcomes.Fail{Error: errors.Wrapf(ErrEnvoyVersionUnsupported,
+		"image %s is Envoy %s, but OSM %s supports Envoy %s", image, envoyVersion, check.osmVersion, strings.Join(supportedVersions, ", "))}
+}
+
+// getEnvoyVersion returns the Envoy minor version of an image from its tag.
+// It returns false for an image pinned by digest only or whose tag is not a version.
+func getEnvoyVersion(image string) (version.EnvoyVersion, bool) {
+	image = strings.SplitN(image, "@", 2)[0]
+	tagIdx := strings.LastIndex(image, ":")
+	if tagIdx == -1 || tagIdx < strings.LastIndex(image, "/") {
+		return "", false
+	}
+	matches := envoyTagRegex.FindStringSubmatch(image[tagIdx+1:])
+	if matches == nil {
+		return "", false
+	}
+	return version.EnvoyVersion(fmt.Sprintf("v%s.%s", matches[1], matches[2])), true
+}
+
+// Suggestion implements common.Runnable
+func (check EnvoyImageCheck) Suggestion() string {
+	return fmt.Sprintf("Set spec.sidecar.envoyImage to an Envoy image supported by OSM %s, or reset it to the default with `helm upgrade`, then restart the meshed pods", check.osmVersion)
+}
+
+// FixIt implements common.Runnable
+func (check EnvoyImageCheck) FixIt() error {
+	panic("implement me")
+}
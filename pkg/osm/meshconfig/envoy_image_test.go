@@ -0,0 +1,75 @@
+package meshconfig
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+)
+
+func TestEnvoyImageCheck(t *testing.T) {
+	newMeshConfig := func(image string) *configv1alpha1.MeshConfig {
+		return &configv1alpha1.MeshConfig{Spec: configv1alpha1.MeshConfigSpec{
+			Sidecar: configv1alpha1.SidecarSpec{EnvoyImage: image},
+		}}
+	}
+
+	tests := []struct {
+		name            string
+		meshConfig      *configv1alpha1.MeshConfig
+		osmVersion      version.ControllerVersion
+		expectedOutcome outcomes.Outcome
+		expectedErr     error
+	}{
+		{
+			name:            "supported Envoy version",
+			meshConfig:      newMeshConfig("envoyproxy/envoy-alpine:v1.19.1"),
+			osmVersion:      "v0.10",
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "supported Envoy version from a registry with a port",
+			meshConfig:      newMeshConfig("registry.local:5000/envoy:1.18.3"),
+			osmVersion:      "v0.8",
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "unsupported Envoy version",
+			meshConfig:      newMeshConfig("envoyproxy/envoy-alpine:v1.17.2"),
+			osmVersion:      "v0.10",
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrEnvoyVersionUnsupported,
+		},
+		{
+			name:            "image pinned by digest",
+			meshConfig:      newMeshConfig("envoyproxy/envoy-alpine@sha256:6502a637c6c5fba4d03d0672d878d12da4bcc7a0d0fb3f1d506982dde0039abd"),
+			osmVersion:      "v0.10",
+			expectedOutcome: outcomes.Info{},
+		},
+		{
+			name:            "unknown OSM version",
+			meshConfig:      newMeshConfig("envoyproxy/envoy-alpine:v1.19.1"),
+			expectedOutcome: outcomes.Unknown{},
+		},
+		{
+			name:            "MeshConfig is unavailable",
+			osmVersion:      "v0.10",
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     config.ErrMeshConfigUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			outcome := NewEnvoyImageCheck(config.NewSnapshot(test.meshConfig), test.osmVersion).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.ErrorIs(outcome.GetError(), test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package meshconfig
+
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
+
+var (
+	// ErrInvalidLogLevel denotes a log level that the component it configures does not recognize.
+	ErrInvalidLogLevel = outcomes.NewError("INVALID_LOG_LEVEL", "invalid log level")
+
+	// ErrEnvoyVersionUnsupported denotes an Envoy image whose version the OSM Controller does not support.
+	ErrEnvoyVersionUnsupported = outcomes.NewError("ENVOY_VERSION_UNSUPPORTED", "Envoy version is not supported by the OSM version")
+
+	// ErrInvalidDuration denotes a MeshConfig duration that does not parse.
+	ErrInvalidDuration = outcomes.NewError("INVALID_DURATION", "invalid duration")
+
+	// ErrTracingAddressMissing denotes that tracing is enabled without an address to send spans to.
+	ErrTracingAddressMissing = outcomes.NewError("TRACING_ADDRESS_MISSING", "tracing is enabled but no tracing address is set")
+
+	// ErrTracingServiceNotFound denotes that the tracing address does not resolve to a Kubernetes service.
+	ErrTracingServiceNotFound = outcomes.NewError("TRACING_SERVICE_NOT_FOUND", "tracing service not found")
+
+	// ErrTracingPortNotExposed denotes that the tracing service does not expose the tracing port.
+	ErrTracingPortNotExposed = outcomes.NewError("TRACING_PORT_NOT_EXPOSED", "tracing service does not expose the tracing port")
+)
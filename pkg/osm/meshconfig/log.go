@@ -0,0 +1,5 @@
+package meshconfig
+
+import "github.com/openservicemesh/osm-health/pkg/logger"
+
+var log = logger.New("control-plane/meshconfig")
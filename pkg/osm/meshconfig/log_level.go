@@ -0,0 +1,95 @@
+package meshconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mapset "github.com/deckarep/golang-set"
+	"github.com/pkg/errors"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+var (
+	// envoyLogLevels are the log levels accepted by Envoy
+	envoyLogLevels = mapset.NewSetWith("trace", "debug", "info", "warning", "warn", "error", "critical", "off")
+
+	// osmLogLevels are the log levels accepted by the OSM control plane components
+	osmLogLevels = mapset.NewSetWith("trace", "debug", "info", "warn", "error", "fatal", "panic", "disabled")
+
+	// verboseLogLevels are log levels that are expected to slow down the component they configure
+	verboseLogLevels = mapset.NewSetWith("trace", "debug")
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*LogLevelCheck)(nil)
+
+// LogLevelCheck implements common.Runnable
+type LogLevelCheck struct {
+	meshConfigGetter config.MeshConfigGetter
+}
+
+// NewLogLevelCheck checks whether the Envoy and OSM control plane log levels in the MeshConfig are valid and not verbose
+func NewLogLevelCheck(meshConfigGetter config.MeshConfigGetter) LogLevelCheck {
+	return LogLevelCheck{
+		meshConfigGetter: meshConfigGetter,
+	}
+}
+
+// Description implements common.Runnable
+func (check LogLevelCheck) Description() string {
+	return "Checking whether the Envoy and OSM control plane log levels are valid"
+}
+
+// Run implements common.Runnable
+func (check LogLevelCheck) Run(ctx context.Context) outcomes.Outcome {
+	meshConfig, err := check.meshConfigGetter.GetMeshConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	levels := []struct {
+		field  string
+		value  string
+		levels mapset.Set
+	}{
+		{"spec.sidecar.logLevel", meshConfig.Spec.Sidecar.LogLevel, envoyLogLevels},
+		{"spec.observability.osmLogLevel", meshConfig.Spec.Observability.OSMLogLevel, osmLogLevels},
+	}
+
+	var invalid, verbose []string
+	for _, level := range levels {
+		value := strings.ToLower(level.value)
+		if value == "" {
+			continue
+		}
+		if !level.levels.Contains(value) {
+			invalid = append(invalid, fmt.Sprintf("%s: %q is not one of %s", level.field, level.value, joinSorted(level.levels)))
+			continue
+		}
+		if verboseLogLevels.Contains(value) {
+			verbose = append(verbose, fmt.Sprintf("%s is %q", level.field, level.value))
+		}
+	}
+
+	if len(invalid) > 0 {
+		return outcomes.Fail{Error: errors.Wrap(ErrInvalidLogLevel, strings.Join(invalid, "\n"))}
+	}
+	if len(verbose) > 0 {
+		return outcomes.Warning{Diagnostics: fmt.Sprintf("Verbose logging slows down the mesh and should only be enabled while debugging:\n%s", strings.Join(verbose, "\n"))}
+	}
+	return outcomes.Pass{}
+}
+
+// Suggestion implements common.Runnable
+func (check LogLevelCheck) Suggestion() string {
+	return "Set spec.sidecar.logLevel and spec.observability.osmLogLevel to a valid level such as `error` or `info` with `kubectl edit meshconfig`"
+}
+
+// FixIt implements common.Runnable
+func (check LogLevelCheck) FixIt() error {
+	panic("implement me")
+}
@@ -0,0 +1,59 @@
+package meshconfig
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+)
+
+func TestLogLevelCheck(t *testing.T) {
+	tests := []struct {
+		name            string
+		meshConfig      *configv1alpha1.MeshConfig
+		expectedOutcome outcomes.Outcome
+		expectedErr     error
+	}{
+		{
+			name: "valid log levels",
+			meshConfig: &configv1alpha1.MeshConfig{Spec: configv1alpha1.MeshConfigSpec{
+				Sidecar:       configv1alpha1.SidecarSpec{LogLevel: "error"},
+				Observability: configv1alpha1.ObservabilitySpec{OSMLogLevel: "info"},
+			}},
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name: "verbose Envoy log level",
+			meshConfig: &configv1alpha1.MeshConfig{Spec: configv1alpha1.MeshConfigSpec{
+				Sidecar: configv1alpha1.SidecarSpec{LogLevel: "debug"},
+			}},
+			expectedOutcome: outcomes.Warning{},
+		},
+		{
+			name: "Envoy log level is not an OSM log level",
+			meshConfig: &configv1alpha1.MeshConfig{Spec: configv1alpha1.MeshConfigSpec{
+				Observability: configv1alpha1.ObservabilitySpec{OSMLogLevel: "critical"},
+			}},
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrInvalidLogLevel,
+		},
+		{
+			name:            "MeshConfig is unavailable",
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     config.ErrMeshConfigUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			outcome := NewLogLevelCheck(config.NewSnapshot(test.meshConfig)).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.ErrorIs(outcome.GetError(), test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package meshconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*TracingCheck)(nil)
+
+// TracingCheck implements common.Runnable
+type TracingCheck struct {
+	client           kubernetes.Interface
+	meshConfigGetter config.MeshConfigGetter
+}
+
+// NewTracingCheck checks whether the tracing address in the MeshConfig resolves to a Kubernetes service exposing the tracing port
+func NewTracingCheck(client kubernetes.Interface, meshConfigGetter config.MeshConfigGetter) TracingCheck {
+	return TracingCheck{
+		client:           client,
+		meshConfigGetter: meshConfigGetter,
+	}
+}
+
+// Description implements common.Runnable
+func (check TracingCheck) Description() string {
+	return "Checking whether the tracing configuration points at a resolvable service"
+}
+
+// Run implements common.Runnable
+func (check TracingCheck) Run(ctx context.Context) outcomes.Outcome {
+	meshConfig, err := check.meshConfigGetter.GetMeshConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	tracing := meshConfig.Spec.Observability.Tracing
+	if !tracing.Enable {
+		return outcomes.Pass{Diagnostics: "tracing is disabled"}
+	}
+	if tracing.Address == "" {
+		return outcomes.Fail{Error: ErrTracingAddressMissing}
+	}
+
+	name, namespace, ok := parseServiceAddress(tracing.Address)
+	if !ok {
+		return outcomes.Info{Diagnostics: fmt.Sprintf("Tracing address %s is not a Kubernetes service address and cannot be verified", tracing.Address)}
+	}
+
+	service, err := check.client.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return outcomes.Fail{Error: errors.Wrapf(ErrTracingServiceNotFound, "address %s refers to service %s/%s", tracing.Address, namespace, name)}
+	}
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	for _, port := range service.Spec.Ports {
+		if port.Port == int32(tracing.Port) {
+			return outcomes.Pass{Diagnostics: fmt.Sprintf("service %s/%s:%d", namespace, name, tracing.Port)}
+		}
+	}
+	return outcomes.Fail{Error: errors.Wrapf(ErrTracingPortNotExposed, "service %s/%s does not expose port %d", namespace, name, tracing.Port)}
+}
+
+// parseServiceAddress returns the name and namespace of the Kubernetes service referred to by a cluster DNS name
+// such as jaeger.osm-system, jaeger.osm-system.svc or jaeger.osm-system.svc.cluster.local.
+func parseServiceAddress(address string) (string, string, bool) {
+	labels := strings.Split(strings.TrimSuffix(address, "."), ".")
+	if len(labels) < 2 || labels[0] == "" || labels[1] == "" {
+		return "", "", false
+	}
+	if len(labels) > 2 && labels[2] != "svc" {
+		return "", "", false
+	}
+	return labels[0], labels[1], true
+}
+
+// Suggestion implements common.Runnable
+func (check TracingCheck) Suggestion() string {
+	return "Set spec.observability.tracing.address to <service>.<namespace>.svc.cluster.local and spec.observability.tracing.port to a port of that service, or disable tracing"
+}
+
+// FixIt implements common.Runnable
+func (check TracingCheck) FixIt() error {
+	panic("implement me")
+}
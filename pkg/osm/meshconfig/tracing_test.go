@@ -0,0 +1,83 @@
+package meshconfig
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+)
+
+func TestTracingCheck(t *testing.T) {
+	newMeshConfig := func(enable bool, address string, port int16) *configv1alpha1.MeshConfig {
+		return &configv1alpha1.MeshConfig{Spec: configv1alpha1.MeshConfigSpec{
+			Observability: configv1alpha1.ObservabilitySpec{
+				Tracing: configv1alpha1.TracingSpec{Enable: enable, Address: address, Port: port, Endpoint: "/api/v2/spans"},
+			},
+		}}
+	}
+	jaeger := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "jaeger", Namespace: "osm-system"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 9411}}},
+	}
+
+	tests := []struct {
+		name            string
+		objects         []runtime.Object
+		meshConfig      *configv1alpha1.MeshConfig
+		expectedOutcome outcomes.Outcome
+		expectedErr     error
+	}{
+		{
+			name:            "tracing is disabled",
+			meshConfig:      newMeshConfig(false, "", 0),
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "tracing service exposes the tracing port",
+			objects:         []runtime.Object{jaeger},
+			meshConfig:      newMeshConfig(true, "jaeger.osm-system.svc.cluster.local", 9411),
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "tracing service does not expose the tracing port",
+			objects:         []runtime.Object{jaeger},
+			meshConfig:      newMeshConfig(true, "jaeger.osm-system", 14268),
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrTracingPortNotExposed,
+		},
+		{
+			name:            "tracing service does not exist",
+			meshConfig:      newMeshConfig(true, "jaeger.osm-system.svc.cluster.local", 9411),
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrTracingServiceNotFound,
+		},
+		{
+			name:            "tracing address is missing",
+			meshConfig:      newMeshConfig(true, "", 9411),
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrTracingAddressMissing,
+		},
+		{
+			name:            "tracing address is outside the cluster",
+			meshConfig:      newMeshConfig(true, "tracing.example.com", 9411),
+			expectedOutcome: outcomes.Info{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			outcome := NewTracingCheck(fake.NewSimpleClientset(test.objects...), config.NewSnapshot(test.meshConfig)).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.ErrorIs(outcome.GetError(), test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package meshconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	mapset "github.com/deckarep/golang-set"
+	smiAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm-health/pkg/smi/access"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*TrafficPolicyCheck)(nil)
+
+// TrafficPolicyCheck implements common.Runnable
+type TrafficPolicyCheck struct {
+	meshConfigGetter config.MeshConfigGetter
+	osmVersion       version.ControllerVersion
+	accessClient     smiAccessClient.Interface
+}
+
+// NewTrafficPolicyCheck describes how the permissive mode, egress and feature flags in the MeshConfig affect traffic policies,
+// and warns on risky combinations of them with the SMI policies in the cluster
+func NewTrafficPolicyCheck(meshConfigGetter config.MeshConfigGetter, osmVersion version.ControllerVersion, accessClient smiAccessClient.Interface) TrafficPolicyCheck {
+	return TrafficPolicyCheck{
+		meshConfigGetter: meshConfigGetter,
+		osmVersion:       osmVersion,
+		accessClient:     accessClient,
+	}
+}
+
+// Description implements common.Runnable
+func (check TrafficPolicyCheck) Description() string {
+	return "Checking how the permissive mode, egress and feature flags affect traffic policies"
+}
+
+// Run implements common.Runnable
+func (check TrafficPolicyCheck) Run(ctx context.Context) outcomes.Outcome {
+	meshConfig, err := check.meshConfigGetter.GetMeshConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	trafficTargets, err := access.GetTrafficTargets(ctx, check.osmVersion, check.accessClient, metav1.NamespaceAll)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	trafficTargetNamespaces := mapset.NewSet()
+	for _, trafficTarget := range trafficTargets {
+		trafficTargetNamespaces.Add(trafficTarget.Namespace)
+	}
+
+	spec := meshConfig.Spec
+	var effects, warnings []string
+
+	if spec.Traffic.EnablePermissiveTrafficPolicyMode {
+		effects = append(effects, "Permissive traffic policy mode is enabled: every meshed pod can reach every other meshed pod and SMI access policies are ignored")
+		if len(trafficTargets) > 0 {
+			warnings = append(warnings, fmt.Sprintf("%d TrafficTarget(s) in namespace(s) %s are ignored because permissive traffic policy mode is enabled",
+				len(trafficTargets), joinSorted(trafficTargetNamespaces)))
+		}
+	} else {
+		effects = append(effects, "SMI access policies are enforced: traffic between meshed pods is denied unless a TrafficTarget allows it")
+		if len(trafficTargets) == 0 {
+			warnings = append(warnings, "Permissive traffic policy mode is disabled and there are no TrafficTargets, so all traffic between meshed pods is denied")
+		}
+	}
+
+	if spec.Traffic.EnableEgress {
+		effects = append(effects, "Global egress is enabled: meshed pods can reach any destination outside the mesh")
+		if spec.FeatureFlags.EnableEgressPolicy {
+			warnings = append(warnings, "Egress policies have no effect on reachability because global egress is enabled")
+		}
+	} else {
+		effects = append(effects, "Global egress is disabled: meshed pods can only reach destinations outside the mesh that are allowed by an Egress policy or excluded from interception")
+		if !spec.FeatureFlags.EnableEgressPolicy && len(spec.Traffic.OutboundIPRangeExclusionList) == 0 && len(spec.Traffic.OutboundPortExclusionList) == 0 {
+			warnings = append(warnings, "Global egress is disabled, Egress policies are disabled and no outbound traffic is excluded from interception, so meshed pods cannot reach any destination outside the mesh")
+		}
+	}
+
+	if enabled := getEnabledFeatureFlags(spec.FeatureFlags); len(enabled) > 0 {
+		effects = append(effects, fmt.Sprintf("Enabled feature flags: %s", strings.Join(enabled, ", ")))
+	}
+
+	if len(warnings) > 0 {
+		return outcomes.Warning{Diagnostics: strings.Join(append(effects, warnings...), "\n")}
+	}
+	return outcomes.Info{Diagnostics: strings.Join(effects, "\n")}
+}
+
+// getEnabledFeatureFlags returns the names of the feature flags that are enabled, in the order they are declared in the MeshConfig
+func getEnabledFeatureFlags(featureFlags configv1alpha1.FeatureFlags) []string {
+	flags := []struct {
+		name    string
+		enabled bool
+	}{
+		{"enableWASMStats", featureFlags.EnableWASMStats},
+		{"enableEgressPolicy", featureFlags.EnableEgressPolicy},
+		{"enableMulticlusterMode", featureFlags.EnableMulticlusterMode},
+		{"enableSnapshotCacheMode", featureFlags.EnableSnapshotCacheMode},
+		{"enableAsyncProxyServiceMapping", featureFlags.EnableAsyncProxyServiceMapping},
+		{"enableValidatingWebhook", featureFlags.EnableValidatingWebhook},
+		{"enableIngressBackendPolicy", featureFlags.EnableIngressBackendPolicy},
+		{"enableEnvoyActiveHealthChecks", featureFlags.EnableEnvoyActiveHealthChecks},
+		{"enableRetryPolicy", featureFlags.EnableRetryPolicy},
+	}
+
+	var enabled []string
+	for _, flag := range flags {
+		if flag.enabled {
+			enabled = append(enabled, flag.name)
+		}
+	}
+	return enabled
+}
+
+// Suggestion implements common.Runnable
+func (check TrafficPolicyCheck) Suggestion() string {
+	return "Disable permissive traffic policy mode to enforce existing TrafficTargets, or create TrafficTargets before disabling it, with `kubectl edit meshconfig`"
+}
+
+// FixIt implements common.Runnable
+func (check TrafficPolicyCheck) FixIt() error {
+	panic("implement me")
+}
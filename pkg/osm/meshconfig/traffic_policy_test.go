@@ -0,0 +1,85 @@
+package meshconfig
+
+import (
+	"context"
+	"testing"
+
+	accessV1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
+	fakeAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned/fake"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+)
+
+func TestTrafficPolicyCheck(t *testing.T) {
+	trafficTarget := &accessV1alpha3.TrafficTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "bookstore"},
+	}
+	newMeshConfig := func(permissive, egress, egressPolicy bool) *configv1alpha1.MeshConfig {
+		return &configv1alpha1.MeshConfig{Spec: configv1alpha1.MeshConfigSpec{
+			Traffic:      configv1alpha1.TrafficSpec{EnablePermissiveTrafficPolicyMode: permissive, EnableEgress: egress},
+			FeatureFlags: configv1alpha1.FeatureFlags{EnableEgressPolicy: egressPolicy},
+		}}
+	}
+
+	tests := []struct {
+		name                string
+		objects             []runtime.Object
+		meshConfig          *configv1alpha1.MeshConfig
+		expectedOutcome     outcomes.Outcome
+		expectedDiagnostics string
+	}{
+		{
+			name:                "permissive mode without TrafficTargets",
+			meshConfig:          newMeshConfig(true, true, false),
+			expectedOutcome:     outcomes.Info{},
+			expectedDiagnostics: "SMI access policies are ignored",
+		},
+		{
+			name:                "permissive mode with TrafficTargets present",
+			objects:             []runtime.Object{trafficTarget},
+			meshConfig:          newMeshConfig(true, true, false),
+			expectedOutcome:     outcomes.Warning{},
+			expectedDiagnostics: "1 TrafficTarget(s) in namespace(s) bookstore are ignored",
+		},
+		{
+			name:                "SMI mode with TrafficTargets and Egress policies",
+			objects:             []runtime.Object{trafficTarget},
+			meshConfig:          newMeshConfig(false, false, true),
+			expectedOutcome:     outcomes.Info{},
+			expectedDiagnostics: "Enabled feature flags: enableEgressPolicy",
+		},
+		{
+			name:                "SMI mode without TrafficTargets",
+			meshConfig:          newMeshConfig(false, true, false),
+			expectedOutcome:     outcomes.Warning{},
+			expectedDiagnostics: "all traffic between meshed pods is denied",
+		},
+		{
+			name:                "Egress policies with global egress",
+			objects:             []runtime.Object{trafficTarget},
+			meshConfig:          newMeshConfig(false, true, true),
+			expectedOutcome:     outcomes.Warning{},
+			expectedDiagnostics: "Egress policies have no effect",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			outcome := NewTrafficPolicyCheck(config.NewSnapshot(test.meshConfig), "v0.10", fakeAccessClient.NewSimpleClientset(test.objects...)).Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.Contains(outcome.GetDiagnostics(), test.expectedDiagnostics)
+		})
+	}
+}
+
+func TestTrafficPolicyCheckMeshConfigUnavailable(t *testing.T) {
+	assert := tassert.New(t)
+	outcome := NewTrafficPolicyCheck(config.NewSnapshot(nil), "v0.10", fakeAccessClient.NewSimpleClientset()).Run(context.TODO())
+	assert.ErrorIs(outcome.GetError(), config.ErrMeshConfigUnavailable)
+}
@@ -0,0 +1,92 @@
+package meshconfig
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	mapset "github.com/deckarep/golang-set"
+
+	"github.com/openservicemesh/osm-health/pkg/cluster"
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/diagnosis"
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
+	"github.com/openservicemesh/osm-health/pkg/osm"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	"github.com/openservicemesh/osm-health/pkg/osm/utils"
+	"github.com/openservicemesh/osm-health/pkg/plugins"
+	"github.com/openservicemesh/osm-health/pkg/printer"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+// Validate validates the settings of the MeshConfig of the OSM control plane in the given namespace.
+func Validate(ctx context.Context, osmControlPlaneNamespace common.MeshNamespace, meshConfigTimeout time.Duration) error {
+	log.Info().Msgf("Validating the MeshConfig of the OSM control plane in namespace %s", osmControlPlaneNamespace)
+
+	client, err := pod.GetKubeClient()
+	if err != nil {
+		log.Error().Err(err).Msg("Error creating Kubernetes client")
+	}
+
+	configClient, err := pod.GetOsmConfigClient()
+	if err != nil {
+		log.Error().Err(err).Msg("Error creating OSM config client")
+	}
+
+	accessClient, err := pod.GetSMIAccessClient()
+	if err != nil {
+		log.Err(err).Msg("Error initializing SMI access client")
+	}
+
+	// All checks share one view of the cluster
+	snapshot := cluster.NewSnapshot(client, accessClient, nil, nil)
+	client = snapshot.KubeClient()
+	accessClient = snapshot.AccessClient()
+
+	meshInfo, err := utils.GetMeshInfo(ctx, client, osmControlPlaneNamespace)
+	if err != nil {
+		log.Err(err).Msg("Error getting OSM info")
+		meshInfo = &utils.MeshInfo{}
+	}
+
+	meshConfig := config.ReadSnapshot(ctx, configClient, osmControlPlaneNamespace, meshConfigTimeout)
+
+	checks := []runner.Runnable{
+		osm.NewMeshConfigExistsCheck(configClient, osmControlPlaneNamespace),
+		NewLogLevelCheck(meshConfig),
+		NewEnvoyImageCheck(meshConfig, meshInfo.OSMVersion),
+		NewDurationsCheck(meshConfig),
+		NewTracingCheck(client, meshConfig),
+		NewTrafficPolicyCheck(meshConfig, meshInfo.OSMVersion, accessClient),
+	}
+	checks = append(checks, plugins.Checks(plugins.Target{
+		Command:      "meshconfig",
+		OSMNamespace: osmControlPlaneNamespace.String(),
+		MeshName:     meshInfo.Name.String(),
+	})...)
+
+	outcomes := runner.Run(ctx, checks...)
+
+	diagnoses := diagnosis.Diagnose(diagnosis.Rules(), outcomes...)
+	printer.Print(outcomes...)
+	printer.PrintDiagnoses(diagnoses...)
+	printer.Record(printer.Report{
+		Command:    "meshconfig",
+		Mesh:       meshInfo,
+		Printables: outcomes,
+		Diagnoses:  diagnoses,
+	})
+
+	return nil
+}
+
+// joinSorted returns the string elements of the set sorted and joined with commas
+func joinSorted(set mapset.Set) string {
+	var elements []string
+	for element := range set.Iter() {
+		elements = append(elements, element.(string))
+	}
+	sort.Strings(elements)
+	return strings.Join(elements, ", ")
+}
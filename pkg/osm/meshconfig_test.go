@@ -0,0 +1,78 @@
+package osm
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+	configFake "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned/fake"
+)
+
+func TestMeshConfigExistsCheck(t *testing.T) {
+	newMeshConfig := func(spec configv1alpha1.MeshConfigSpec) *configv1alpha1.MeshConfig {
+		return &configv1alpha1.MeshConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: constants.OSMMeshConfig, Namespace: "osm-system"},
+			Spec:       spec,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		objects         []runtime.Object
+		readErr         error
+		expectedOutcome outcomes.Outcome
+		expectedErr     error
+	}{
+		{
+			name: "MeshConfig exists and parses",
+			objects: []runtime.Object{newMeshConfig(configv1alpha1.MeshConfigSpec{
+				Sidecar:     configv1alpha1.SidecarSpec{ConfigResyncInterval: "30s"},
+				Certificate: configv1alpha1.CertificateSpec{ServiceCertValidityDuration: "24h"},
+			})},
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "MeshConfig is missing",
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrMeshConfigNotFound,
+		},
+		{
+			name: "MeshConfig has a malformed duration",
+			objects: []runtime.Object{newMeshConfig(configv1alpha1.MeshConfigSpec{
+				Certificate: configv1alpha1.CertificateSpec{ServiceCertValidityDuration: "1 day"},
+			})},
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrMeshConfigInvalid,
+		},
+		{
+			name:            "MeshConfig cannot be read",
+			objects:         []runtime.Object{newMeshConfig(configv1alpha1.MeshConfigSpec{})},
+			readErr:         apierrors.NewForbidden(configv1alpha1.SchemeGroupVersion.WithResource("meshconfigs").GroupResource(), constants.OSMMeshConfig, nil),
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrMeshConfigUnreadable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			client := configFake.NewSimpleClientset(test.objects...)
+			if test.readErr != nil {
+				client.PrependReactor("get", "meshconfigs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, test.readErr
+				})
+			}
+			outcome := NewMeshConfigExistsCheck(client, "osm-system").Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.ErrorIs(outcome.GetError(), test.expectedErr)
+		})
+	}
+}
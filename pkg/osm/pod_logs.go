@@ -3,6 +3,7 @@ package osm
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +13,7 @@ import (
 	"github.com/openservicemesh/osm-health/pkg/common"
 	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
 	"github.com/openservicemesh/osm-health/pkg/kubernetes/podhelper"
+	"github.com/openservicemesh/osm-health/pkg/logs"
 	"github.com/openservicemesh/osm-health/pkg/runner"
 	"github.com/openservicemesh/osm/pkg/constants"
 )
@@ -26,64 +28,75 @@ type NoBadOsmPodLogsCheck struct {
 	podLabelSelector         metav1.LabelSelector
 	podName                  string
 	containerName            string
+	logOptions               logs.Options
 }
 
-// HasNoBadOsmPodLogsCheck checks whether the specified osm pods in the namespace have bad (fatal/error/warning/fail) log messages
-func HasNoBadOsmPodLogsCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace, podLabelSelector metav1.LabelSelector, podName string, containerName string) NoBadOsmPodLogsCheck {
+// HasNoBadOsmPodLogsCheck checks whether the specified osm pods in the namespace have log messages which indicate problems
+func HasNoBadOsmPodLogsCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace, podLabelSelector metav1.LabelSelector, podName string, containerName string, logOptions logs.Options) NoBadOsmPodLogsCheck {
 	return NoBadOsmPodLogsCheck{
 		client:                   client,
 		osmControlPlaneNamespace: osmControlPlaneNamespace,
 		podLabelSelector:         podLabelSelector,
 		podName:                  podName,
 		containerName:            containerName,
+		logOptions:               logOptions,
 	}
 }
 
-// HasNoBadOsmControllerLogsCheck checks whether the osm controller pods in the namespace have bad (fatal/error/warning/fail) log messages
-func HasNoBadOsmControllerLogsCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) NoBadOsmPodLogsCheck {
+// HasNoBadOsmControllerLogsCheck checks whether the osm controller pods in the namespace have log messages which indicate problems
+func HasNoBadOsmControllerLogsCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace, logOptions logs.Options) NoBadOsmPodLogsCheck {
 	return HasNoBadOsmPodLogsCheck(
 		client,
 		osmControlPlaneNamespace,
 		metav1.LabelSelector{MatchLabels: map[string]string{"app": constants.OSMControllerName}},
 		constants.OSMControllerName,
-		constants.OSMControllerName)
+		constants.OSMControllerName,
+		logOptions)
 }
 
-// HasNoBadOsmInjectorLogsCheck checks whether the osm controller pods in the namespace have bad (fatal/error/warning/fail) log messages
-func HasNoBadOsmInjectorLogsCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) NoBadOsmPodLogsCheck {
+// HasNoBadOsmInjectorLogsCheck checks whether the osm injector pods in the namespace have log messages which indicate problems
+func HasNoBadOsmInjectorLogsCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace, logOptions logs.Options) NoBadOsmPodLogsCheck {
 	return HasNoBadOsmPodLogsCheck(
 		client,
 		osmControlPlaneNamespace,
 		metav1.LabelSelector{MatchLabels: map[string]string{"app": constants.OSMInjectorName}},
 		constants.OSMInjectorName,
-		constants.OSMInjectorName)
+		constants.OSMInjectorName,
+		logOptions)
 }
 
 // Description implements common.Runnable
 func (check NoBadOsmPodLogsCheck) Description() string {
-	return fmt.Sprintf("Checking whether namespace %s has bad (fatal/error/warning/fail) logs in %s pods (container: %s)", check.osmControlPlaneNamespace, check.podName, check.containerName)
+	return fmt.Sprintf("Checking whether namespace %s has errors or warnings in the logs of %s pods (container: %s)", check.osmControlPlaneNamespace, check.podName, check.containerName)
 }
 
 // Run implements common.Runnable
-func (check NoBadOsmPodLogsCheck) Run() outcomes.Outcome {
+func (check NoBadOsmPodLogsCheck) Run(ctx context.Context) outcomes.Outcome {
 	listOptions := metav1.ListOptions{
 		LabelSelector: labels.Set(check.podLabelSelector.MatchLabels).String(),
 	}
-	pods, err := check.client.CoreV1().Pods(check.osmControlPlaneNamespace.String()).List(context.TODO(), listOptions)
+	pods, err := check.client.CoreV1().Pods(check.osmControlPlaneNamespace.String()).List(ctx, listOptions)
 	if err != nil {
 		return outcomes.Fail{Error: fmt.Errorf("unable to list %s pods in namespace %s", check.podName, check.osmControlPlaneNamespace)}
 	}
 
-	osmPodErrCount := 0
+	var podErrors, podWarnings []string
 	for i := range pods.Items {
-		if err := podhelper.HasNoBadLogs(check.client, &pods.Items[i], check.podName).GetError(); err != nil {
-			osmPodErrCount++
-			log.Error().Err(err)
+		outcome := podhelper.HasNoBadLogs(ctx, check.client, &pods.Items[i], check.containerName, logs.FormatZerolog, check.logOptions)
+		switch o := outcome.(type) {
+		case outcomes.Fail:
+			podErrors = append(podErrors, o.Error.Error())
+		case outcomes.Warning:
+			podWarnings = append(podWarnings, fmt.Sprintf("%s: %s", pods.Items[i].Name, o.Diagnostics))
 		}
 	}
 
-	if osmPodErrCount != 0 {
-		return outcomes.Fail{Error: errors.Errorf("%s pods (container: %s) in namespace %s have %d errors", check.podName, check.containerName, check.osmControlPlaneNamespace, osmPodErrCount)}
+	if len(podErrors) != 0 {
+		return outcomes.Fail{Error: errors.Errorf("%d %s pods (container: %s) in namespace %s have errors in their logs:\n%s",
+			len(podErrors), check.podName, check.containerName, check.osmControlPlaneNamespace, strings.Join(append(podErrors, podWarnings...), "\n"))}
+	}
+	if len(podWarnings) != 0 {
+		return outcomes.Warning{Diagnostics: strings.Join(podWarnings, "\n")}
 	}
 
 	return outcomes.Pass{}
@@ -1,18 +1,25 @@
 package osm
 
 import (
+	"context"
+
 	"helm.sh/helm/v3/pkg/action"
 
+	"github.com/openservicemesh/osm-health/pkg/cluster"
 	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/diagnosis"
 	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
+	"github.com/openservicemesh/osm-health/pkg/logs"
 	"github.com/openservicemesh/osm-health/pkg/osm/controller"
+	"github.com/openservicemesh/osm-health/pkg/osm/utils"
+	"github.com/openservicemesh/osm-health/pkg/plugins"
 	"github.com/openservicemesh/osm-health/pkg/printer"
 	"github.com/openservicemesh/osm-health/pkg/runner"
 	"github.com/openservicemesh/osm/pkg/k8s"
 )
 
 // ControlPlaneStatus determines the status of the OSM control plane.
-func ControlPlaneStatus(osmControlPlaneNamespace common.MeshNamespace, localPort uint16, actionConfig *action.Configuration) error {
+func ControlPlaneStatus(ctx context.Context, osmControlPlaneNamespace common.MeshNamespace, localPort uint16, actionConfig *action.Configuration, logOptions logs.Options) error {
 	log.Info().Msgf("Determining the status of the OSM control plane in namespace %s", osmControlPlaneNamespace)
 
 	client, err := pod.GetKubeClient()
@@ -20,26 +27,65 @@ func ControlPlaneStatus(osmControlPlaneNamespace common.MeshNamespace, localPort
 		log.Error().Err(err).Msg("Error creating Kubernetes client")
 	}
 
+	configClient, err := pod.GetOsmConfigClient()
+	if err != nil {
+		log.Error().Err(err).Msg("Error creating OSM config client")
+	}
+
+	actionConfig = withSnapshotReleases(actionConfig, client, osmControlPlaneNamespace)
+
+	// All checks share one view of the cluster
+	client = cluster.NewSnapshot(client, nil, nil, nil).KubeClient()
+
+	meshInfo, err := utils.GetMeshInfo(ctx, client, osmControlPlaneNamespace)
+	if err != nil {
+		log.Err(err).Msg("Error getting OSM info")
+		meshInfo = &utils.MeshInfo{}
+	}
+
 	controllerPods := k8s.GetOSMControllerPods(client, osmControlPlaneNamespace.String())
 
-	outcomes := runner.Run(
-		HasNoBadOsmControllerLogsCheck(client, osmControlPlaneNamespace),
-		HasNoBadOsmInjectorLogsCheck(client, osmControlPlaneNamespace),
+	checks := []runner.Runnable{
+		NewOsmControllerDeploymentCheck(client, osmControlPlaneNamespace),
+		NewOsmInjectorDeploymentCheck(client, osmControlPlaneNamespace),
+		NewOsmBootstrapDeploymentCheck(client, osmControlPlaneNamespace),
+		NewMutatingWebhookCheck(client, osmControlPlaneNamespace),
+		NewValidatingWebhookCheck(client, osmControlPlaneNamespace),
+		NewMeshConfigExistsCheck(configClient, osmControlPlaneNamespace),
+		NewCRDVersionsCheck(client, meshInfo.OSMVersion),
+		NewHelmReleaseStatusCheck(actionConfig, osmControlPlaneNamespace),
+		NewHelmChartVersionCheck(actionConfig, client, osmControlPlaneNamespace),
+		NewHelmValuesDriftCheck(actionConfig, client, configClient, osmControlPlaneNamespace),
+		HasNoBadOsmControllerLogsCheck(client, osmControlPlaneNamespace, logOptions),
+		HasNoBadOsmInjectorLogsCheck(client, osmControlPlaneNamespace, logOptions),
 		controller.NewHTTPServerHealthEndpointsCheck(
 			client,
 			osmControlPlaneNamespace,
 			controllerPods,
-			localPort,
-			actionConfig),
+			localPort),
 		controller.NewHTTPServerProxyConnectionMetricsCheck(
 			client,
 			osmControlPlaneNamespace,
 			controllerPods,
-			localPort,
-			actionConfig),
-	)
+			localPort),
+	}
+	checks = append(checks, plugins.Checks(plugins.Target{
+		Command:      "control-plane status",
+		OSMNamespace: osmControlPlaneNamespace.String(),
+		MeshName:     meshInfo.Name.String(),
+	})...)
+
+	outcomes := runner.Run(ctx, checks...)
 
+	diagnoses := diagnosis.Diagnose(diagnosis.Rules(), outcomes...)
 	printer.Print(outcomes...)
+	printer.PrintDiagnoses(diagnoses...)
+	printer.Record(printer.Report{
+		Command:    "control-plane status",
+		Mesh:       meshInfo,
+		Printables: outcomes,
+		Diagnoses:  diagnoses,
+	})
 
 	return nil
 }
@@ -0,0 +1,14 @@
+package utils
+
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
+
+var (
+	// ErrNoMeshesFound is returned when no OSM control plane is found in the cluster.
+	ErrNoMeshesFound = outcomes.NewError("NO_MESHES_FOUND", "no OSM control planes found in the cluster")
+
+	// ErrNamespaceNotMonitored is returned when a namespace is not monitored by any mesh.
+	ErrNamespaceNotMonitored = outcomes.NewError("NAMESPACE_NOT_MONITORED", "namespace is not monitored by a mesh")
+
+	// ErrMeshNotFound is returned when the control plane of a mesh is not found in the cluster.
+	ErrMeshNotFound = outcomes.NewError("MESH_NOT_FOUND", "mesh control plane not found in the cluster")
+)
@@ -0,0 +1,5 @@
+package utils
+
+import "github.com/openservicemesh/osm-health/pkg/logger"
+
+var log = logger.New("control-plane/utils")
@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+// Mesh describes an OSM control plane found in the cluster
+type Mesh struct {
+	MeshInfo
+
+	// MonitoredNamespaces holds the names of the namespaces monitored by the mesh
+	MonitoredNamespaces []string
+}
+
+// ListMeshes returns every OSM control plane in the cluster, found by the osm-controller deployments across namespaces,
+// sorted by namespace and mesh name. Deployments whose OSM version cannot be parsed are skipped with a warning.
+func ListMeshes(ctx context.Context, client kubernetes.Interface) ([]Mesh, error) {
+	deployments, err := client.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app=%s", constants.OSMControllerName),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list %s deployments in the cluster", constants.OSMControllerName)
+	}
+
+	var meshes []Mesh
+	for i := range deployments.Items {
+		meshInfo, err := newMeshInfo(&deployments.Items[i])
+		if err != nil {
+			// One broken control plane should not hide the other meshes of the cluster.
+			log.Warn().Err(err).Msgf("Skipping %s deployment %s/%s", constants.OSMControllerName, deployments.Items[i].Namespace, deployments.Items[i].Name)
+			continue
+		}
+
+		monitoredNamespaces, err := getMonitoredNamespaces(ctx, client, meshInfo.Name, meshInfo.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		mesh := Mesh{MeshInfo: *meshInfo}
+		for _, ns := range monitoredNamespaces.Items {
+			mesh.MonitoredNamespaces = append(mesh.MonitoredNamespaces, ns.Name)
+		}
+		sort.Strings(mesh.MonitoredNamespaces)
+		meshes = append(meshes, mesh)
+	}
+
+	sort.Slice(meshes, func(i, j int) bool {
+		if meshes[i].Namespace != meshes[j].Namespace {
+			return meshes[i].Namespace < meshes[j].Namespace
+		}
+		return meshes[i].Name < meshes[j].Name
+	})
+	return meshes, nil
+}
+
+// GetMeshName returns the name of the mesh monitoring the namespace, given by its monitored-by label
+func GetMeshName(ctx context.Context, client kubernetes.Interface, namespace string) (common.MeshName, error) {
+	ns, err := client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to get namespace %s", namespace)
+	}
+	meshName, ok := ns.Labels[constants.OSMKubeResourceMonitorAnnotation]
+	if !ok || meshName == "" {
+		return "", errors.Wrapf(ErrNamespaceNotMonitored, "namespace %s has no %s label", namespace, constants.OSMKubeResourceMonitorAnnotation)
+	}
+	return common.MeshName(meshName), nil
+}
+
+// GetMeshInfoForNamespace returns the MeshInfo of the mesh monitoring the namespace. When several control planes share
+// the mesh name, the one in osmControlPlaneNamespace is preferred.
+func GetMeshInfoForNamespace(ctx context.Context, client kubernetes.Interface, namespace string, osmControlPlaneNamespace common.MeshNamespace) (*MeshInfo, error) {
+	meshName, err := GetMeshName(ctx, client, namespace)
+	if err != nil {
+		return nil, err
+	}
+	meshes, err := ListMeshes(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []Mesh
+	for _, mesh := range meshes {
+		if mesh.Name == meshName {
+			found = append(found, mesh)
+		}
+	}
+	if len(found) == 0 {
+		return nil, errors.Wrapf(ErrMeshNotFound, "mesh %s monitoring namespace %s", meshName, namespace)
+	}
+	for _, mesh := range found {
+		if mesh.Namespace == osmControlPlaneNamespace {
+			return &mesh.MeshInfo, nil
+		}
+	}
+	if len(found) > 1 {
+		log.Warn().Msgf("Found %d control planes for mesh %s; using the one in namespace %s", len(found), meshName, found[0].Namespace)
+	}
+	return &found[0].MeshInfo, nil
+}
+
+// GetMeshInfoForPod returns the MeshInfo of the mesh monitoring the pod's namespace. When the mesh cannot be detected
+// from the namespace, it falls back to the mesh with its control plane in osmControlPlaneNamespace.
+func GetMeshInfoForPod(ctx context.Context, client kubernetes.Interface, podNamespace string, osmControlPlaneNamespace common.MeshNamespace) (*MeshInfo, error) {
+	meshInfo, err := GetMeshInfoForNamespace(ctx, client, podNamespace, osmControlPlaneNamespace)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to detect the mesh of namespace %s; using the control plane in namespace %s", podNamespace, osmControlPlaneNamespace)
+		return GetMeshInfo(ctx, client, osmControlPlaneNamespace)
+	}
+	if meshInfo.Namespace != osmControlPlaneNamespace {
+		log.Info().Msgf("Namespace %s is monitored by mesh %s with its control plane in namespace %s", podNamespace, meshInfo.Name, meshInfo.Namespace)
+	}
+	return meshInfo, nil
+}
@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+func newController(namespace string, meshName string, version string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      constants.OSMControllerName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app":                            constants.OSMControllerName,
+				constants.OSMAppInstanceLabelKey: meshName,
+				constants.OSMAppVersionLabelKey:  version,
+			},
+		},
+	}
+}
+
+func newMonitoredNamespace(name string, meshName string) *corev1.Namespace {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}}}
+	if meshName != "" {
+		ns.Labels[constants.OSMKubeResourceMonitorAnnotation] = meshName
+	}
+	return ns
+}
+
+func TestListMeshes(t *testing.T) {
+	assert := tassert.New(t)
+	client := fake.NewSimpleClientset(
+		newController("osm-system", "osm", "v0.9.2"),
+		newController("osm-edge", "edge", "v0.10.0"),
+		newMonitoredNamespace("bookstore", "osm"),
+		newMonitoredNamespace("bookbuyer", "osm"),
+		newMonitoredNamespace("edge-app", "edge"),
+		newMonitoredNamespace("default", ""),
+	)
+
+	meshes, err := ListMeshes(context.TODO(), client)
+	assert.Nil(err)
+	assert.Len(meshes, 2)
+	assert.Equal(common.MeshName("edge"), meshes[0].Name)
+	assert.Equal(common.MeshNamespace("osm-edge"), meshes[0].Namespace)
+	assert.Equal([]string{"edge-app"}, meshes[0].MonitoredNamespaces)
+	assert.Equal(common.MeshName("osm"), meshes[1].Name)
+	assert.Equal("v0.9", meshes[1].DetectedOSMVersion.String())
+	assert.Equal([]string{"bookbuyer", "bookstore"}, meshes[1].MonitoredNamespaces)
+}
+
+func TestListMeshesSkipsUnparsableVersion(t *testing.T) {
+	assert := tassert.New(t)
+	client := fake.NewSimpleClientset(
+		newController("osm-system", "osm", "v0.9.2"),
+		newController("osm-broken", "broken", "not-a-version"),
+	)
+
+	meshes, err := ListMeshes(context.TODO(), client)
+	assert.Nil(err)
+	assert.Len(meshes, 1)
+	assert.Equal(common.MeshName("osm"), meshes[0].Name)
+}
+
+func TestGetMeshInfoForPod(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		newController("osm-system", "osm", "v0.9.2"),
+		newController("osm-edge", "edge", "v0.10.0"),
+		newMonitoredNamespace("bookstore", "osm"),
+		newMonitoredNamespace("edge-app", "edge"),
+		newMonitoredNamespace("orphan", "removed-mesh"),
+		newMonitoredNamespace("default", ""),
+	)
+
+	tests := []struct {
+		name                      string
+		podNamespace              string
+		expectedMeshName          common.MeshName
+		expectedControlPlaneNs    common.MeshNamespace
+		expectedNamespaceErr      error
+		expectedFallbackMeshName  common.MeshName
+		expectedFallbackNamespace common.MeshNamespace
+	}{
+		{
+			name:                      "namespace monitored by the mesh in the default control plane namespace",
+			podNamespace:              "bookstore",
+			expectedMeshName:          "osm",
+			expectedControlPlaneNs:    "osm-system",
+			expectedFallbackMeshName:  "osm",
+			expectedFallbackNamespace: "osm-system",
+		},
+		{
+			name:                      "namespace monitored by a mesh in another control plane namespace",
+			podNamespace:              "edge-app",
+			expectedMeshName:          "edge",
+			expectedControlPlaneNs:    "osm-edge",
+			expectedFallbackMeshName:  "edge",
+			expectedFallbackNamespace: "osm-edge",
+		},
+		{
+			name:                      "namespace is not monitored",
+			podNamespace:              "default",
+			expectedNamespaceErr:      ErrNamespaceNotMonitored,
+			expectedFallbackMeshName:  "osm",
+			expectedFallbackNamespace: "osm-system",
+		},
+		{
+			name:                      "namespace is monitored by a mesh without a control plane",
+			podNamespace:              "orphan",
+			expectedNamespaceErr:      ErrMeshNotFound,
+			expectedFallbackMeshName:  "osm",
+			expectedFallbackNamespace: "osm-system",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			meshInfo, err := GetMeshInfoForNamespace(context.TODO(), client, test.podNamespace, "osm-system")
+			assert.ErrorIs(err, test.expectedNamespaceErr)
+			if test.expectedNamespaceErr == nil {
+				assert.Equal(test.expectedMeshName, meshInfo.Name)
+				assert.Equal(test.expectedControlPlaneNs, meshInfo.Namespace)
+			}
+
+			meshInfo, err = GetMeshInfoForPod(context.TODO(), client, test.podNamespace, "osm-system")
+			assert.Nil(err)
+			assert.Equal(test.expectedFallbackMeshName, meshInfo.Name)
+			assert.Equal(test.expectedFallbackNamespace, meshInfo.Namespace)
+		})
+	}
+}
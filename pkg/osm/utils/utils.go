@@ -21,38 +21,52 @@ const versionDelimiter string = "."
 
 // MeshInfo is the type used to represent service mesh information
 type MeshInfo struct {
-	Name       common.MeshName
-	Namespace  common.MeshNamespace
+	Name      common.MeshName
+	Namespace common.MeshNamespace
+	// OSMVersion is the OSM version whose capabilities are used by the checks.
+	// It differs from DetectedOSMVersion when the detected version is not known to osm-health.
 	OSMVersion version.ControllerVersion
+	// DetectedOSMVersion is the OSM version reported by the osm-controller deployment.
+	DetectedOSMVersion version.ControllerVersion
 }
 
 // GetMeshInfo returns the MeshInfo for a service mesh with its control plane in the given namespace
-func GetMeshInfo(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) (*MeshInfo, error) {
-	osmControllerDeployment, err := GetOSMControllerDeployment(client, osmControlPlaneNamespace)
+func GetMeshInfo(ctx context.Context, client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) (*MeshInfo, error) {
+	osmControllerDeployment, err := GetOSMControllerDeployment(ctx, client, osmControlPlaneNamespace)
 	if err != nil {
 		return nil, err
 	}
+	return newMeshInfo(osmControllerDeployment)
+}
+
+// newMeshInfo returns the MeshInfo of the mesh whose osm-controller deployment is given
+func newMeshInfo(osmControllerDeployment *v1.Deployment) (*MeshInfo, error) {
 	osmVersion, err := FormatReleaseVersion(osmControllerDeployment.Labels[constants.OSMAppVersionLabelKey])
 	if err != nil {
 		return nil, err
 	}
+	resolvedVersion, err := version.ResolveControllerVersion(version.ControllerVersion(osmVersion))
+	if err != nil {
+		return nil, err
+	}
 
 	mesh := &MeshInfo{
-		Name:       common.MeshName(osmControllerDeployment.Labels[constants.OSMAppInstanceLabelKey]),
-		Namespace:  osmControlPlaneNamespace,
-		OSMVersion: version.ControllerVersion(osmVersion),
+		Name:               common.MeshName(osmControllerDeployment.Labels[constants.OSMAppInstanceLabelKey]),
+		Namespace:          common.MeshNamespace(osmControllerDeployment.Namespace),
+		OSMVersion:         resolvedVersion,
+		DetectedOSMVersion: version.ControllerVersion(osmVersion),
 	}
 	return mesh, nil
 }
 
 // GetOSMControllerDeployment returns the OSM controller deployment in a given namespace
-func GetOSMControllerDeployment(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) (*v1.Deployment, error) {
+func GetOSMControllerDeployment(ctx context.Context, client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) (*v1.Deployment, error) {
 	deploymentsClient := client.AppsV1().Deployments(osmControlPlaneNamespace.String())
 	labelSelector := metav1.LabelSelector{MatchLabels: map[string]string{"app": constants.OSMControllerName}}
 	listOptions := metav1.ListOptions{
 		LabelSelector: labels.Set(labelSelector.MatchLabels).String(),
 	}
-	deployments, err := deploymentsClient.List(context.TODO(), listOptions)
+	deployments, err := deploymentsClient.List(ctx, listOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -78,13 +92,16 @@ func FormatReleaseVersion(version string) (string, error) {
 }
 
 // GetMonitoredNamespaces returns a list of namespaces monitored by the osm control plane.
-func GetMonitoredNamespaces(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) (*corev1.NamespaceList, error) {
-	meshInfo, err := GetMeshInfo(client, osmControlPlaneNamespace)
+func GetMonitoredNamespaces(ctx context.Context, client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) (*corev1.NamespaceList, error) {
+	meshInfo, err := GetMeshInfo(ctx, client, osmControlPlaneNamespace)
 	if err != nil {
 		return &corev1.NamespaceList{}, err
 	}
-	meshName := meshInfo.Name
+	return getMonitoredNamespaces(ctx, client, meshInfo.Name, osmControlPlaneNamespace)
+}
 
+// getMonitoredNamespaces returns a list of namespaces monitored by the mesh with the given name and control plane namespace.
+func getMonitoredNamespaces(ctx context.Context, client kubernetes.Interface, meshName common.MeshName, osmControlPlaneNamespace common.MeshNamespace) (*corev1.NamespaceList, error) {
 	// Criteria that is used to determine if a namespace is monitored by osm (from osm's mutating webhook):
 	// 		kubectl get MutatingWebhookConfiguration osm-webhook-osm -o json | jq '.webhooks[0].namespaceSelector'
 	/*
@@ -118,7 +135,7 @@ func GetMonitoredNamespaces(client kubernetes.Interface, osmControlPlaneNamespac
 		LabelSelector: labels.Set(labelSelector.MatchLabels).String(),
 	}
 
-	nsList, err := client.CoreV1().Namespaces().List(context.TODO(), listOptions)
+	nsList, err := client.CoreV1().Namespaces().List(ctx, listOptions)
 	if err != nil {
 		return &corev1.NamespaceList{}, errors.Errorf("unable to list namespaces in the cluster: %s", err.Error())
 	}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"testing"
 
 	mapset "github.com/deckarep/golang-set"
@@ -20,6 +21,8 @@ func TestGetMeshInfo(t *testing.T) {
 	osmMeshName := "test-osm-mesh-name"
 	osmVersion := "v999.888.777"
 	expectedOsmMajorMinorVersion := "v999.888"
+	// v999.888 is not a known OSM release, so the checks fall back to the newest known release.
+	expectedResolvedOsmVersion := "v0.11"
 
 	tests := []struct {
 		name                  string
@@ -91,14 +94,15 @@ func TestGetMeshInfo(t *testing.T) {
 				objs[i] = test.deployments[i]
 			}
 			k8s := fake.NewSimpleClientset(objs...)
-			meshInfo, err := GetMeshInfo(k8s, test.controlPlaneNamespace)
+			meshInfo, err := GetMeshInfo(context.TODO(), k8s, test.controlPlaneNamespace)
 
 			assert.Equal(test.expErr, err != nil)
 			if !test.expErr {
 				assert.NotNil(meshInfo)
 				assert.Equal(osmMeshName, meshInfo.Name.String())
 				assert.Equal(osmControlPlaneNamespace, meshInfo.Namespace.String())
-				assert.Equal(expectedOsmMajorMinorVersion, meshInfo.OSMVersion.String())
+				assert.Equal(expectedOsmMajorMinorVersion, meshInfo.DetectedOSMVersion.String())
+				assert.Equal(expectedResolvedOsmVersion, meshInfo.OSMVersion.String())
 			}
 		})
 	}
@@ -210,7 +214,7 @@ func TestGetOSMControllerDeployment(t *testing.T) {
 				objs[i] = test.deployments[i]
 			}
 			k8s := fake.NewSimpleClientset(objs...)
-			deployment, err := GetOSMControllerDeployment(k8s, test.namespace)
+			deployment, err := GetOSMControllerDeployment(context.TODO(), k8s, test.namespace)
 
 			assert.Equal(test.expErr, err != nil)
 			if !test.expErr {
@@ -457,7 +461,7 @@ func TestGetMonitoredNamespaces(t *testing.T) {
 			}
 			client := fake.NewSimpleClientset(objs...)
 
-			monitoredNamespaces, err := GetMonitoredNamespaces(client, common.MeshNamespace(osmControlPlaneNamespace))
+			monitoredNamespaces, err := GetMonitoredNamespaces(context.TODO(), client, common.MeshNamespace(osmControlPlaneNamespace))
 			assert.Nil(err)
 			assert.Equal(test.expectedMonitoredNamespaceNames.Cardinality(), len(monitoredNamespaces.Items))
 			for _, ns := range monitoredNamespaces.Items {
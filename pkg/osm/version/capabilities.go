@@ -0,0 +1,166 @@
+package version
+
+import (
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// CapabilitiesFile is the format of a user-supplied version capabilities file. It describes the capabilities of
+// OSM releases osm-health was not built with, so that a new OSM release does not require a new osm-health build.
+//
+// Example:
+//
+//	versions:
+//	  v0.12:
+//	    envoyAdminPort: 15000
+//	    envoyVersions: [v1.19]
+//	    outboundListenerName: outbound-listener
+//	    inboundListenerName: inbound-listener
+//	    trafficTarget: v1alpha3
+//	    trafficTargetRouteKinds: [HTTPRouteGroup, TCPRoute]
+//	    trafficSplit: v1alpha2
+//	    httpRouteGroup: [v1alpha4]
+//	    annotations: [openservicemesh.io/sidecar-injection]
+//	    ingress: [networking/v1]
+//	    probes:
+//	      liveness: {port: 15901, path: /osm-liveness-probe, listenerName: liveness_listener}
+type CapabilitiesFile struct {
+	Versions map[ControllerVersion]Capabilities `json:"versions"`
+}
+
+// Capabilities describes what a given version of the OSM Controller supports.
+// Fields that are left empty are inherited from the nearest known OSM release.
+type Capabilities struct {
+	EnvoyAdminPort          uint16                   `json:"envoyAdminPort,omitempty"`
+	EnvoyVersions           []EnvoyVersion           `json:"envoyVersions,omitempty"`
+	OutboundListenerName    string                   `json:"outboundListenerName,omitempty"`
+	InboundListenerName     string                   `json:"inboundListenerName,omitempty"`
+	TrafficTarget           TrafficTargetVersion     `json:"trafficTarget,omitempty"`
+	TrafficTargetRouteKinds []TrafficTargetRouteKind `json:"trafficTargetRouteKinds,omitempty"`
+	TrafficSplit            TrafficSplitVersion      `json:"trafficSplit,omitempty"`
+	HTTPRouteGroup          []HTTPRouteVersion       `json:"httpRouteGroup,omitempty"`
+	Annotations             []Annotation             `json:"annotations,omitempty"`
+	Ingress                 []IngressVersion         `json:"ingress,omitempty"`
+
+	// Probes describes how HTTP probes are rewritten to go through the Envoy sidecar. An empty map describes a version
+	// that does not rewrite probes.
+	Probes map[ProbeType]SidecarProbe `json:"probes,omitempty"`
+}
+
+// LoadCapabilitiesFile reads a version capabilities file and registers the capabilities of every OSM version it describes.
+func LoadCapabilitiesFile(path string) error {
+	content, err := ioutil.ReadFile(path) // #nosec G304 -- the path is supplied by the user running osm-health
+	if err != nil {
+		return errors.Wrapf(ErrCapabilitiesFileInvalid, "%s: %s", path, err)
+	}
+
+	var capabilitiesFile CapabilitiesFile
+	if err := yaml.UnmarshalStrict(content, &capabilitiesFile); err != nil {
+		return errors.Wrapf(ErrCapabilitiesFileInvalid, "%s: %s", path, err)
+	}
+
+	// Validate every version before registering any, so that a bad file leaves the known versions untouched.
+	versions := make(map[ControllerVersion]Capabilities, len(capabilitiesFile.Versions))
+	for osmVersion, capabilities := range capabilitiesFile.Versions {
+		if !strings.HasPrefix(osmVersion.String(), "v") {
+			osmVersion = "v" + osmVersion
+		}
+		if _, _, err := parseMajorMinor(osmVersion); err != nil {
+			return errors.Wrapf(ErrCapabilitiesFileInvalid, "%s: %s", path, err)
+		}
+		versions[osmVersion] = capabilities
+	}
+	for _, osmVersion := range sortedVersions(versions) {
+		if err := RegisterCapabilities(osmVersion, versions[osmVersion]); err != nil {
+			return errors.Wrapf(ErrCapabilitiesFileInvalid, "%s: %s", path, err)
+		}
+		log.Info().Msgf("Loaded capabilities of OSM %s from %s", osmVersion, path)
+	}
+	return nil
+}
+
+// RegisterCapabilities records the capabilities of the given OSM Controller version, replacing any existing ones.
+// Capabilities that are not set are inherited from the nearest known OSM release.
+func RegisterCapabilities(osmVersion ControllerVersion, capabilities Capabilities) error {
+	base, err := nearestKnownVersion(osmVersion)
+	if err != nil {
+		return err
+	}
+
+	EnvoyAdminPort[osmVersion] = EnvoyAdminPort[base]
+	if capabilities.EnvoyAdminPort != 0 {
+		EnvoyAdminPort[osmVersion] = capabilities.EnvoyAdminPort
+	}
+
+	SupportedEnvoyVersions[osmVersion] = SupportedEnvoyVersions[base]
+	if len(capabilities.EnvoyVersions) != 0 {
+		SupportedEnvoyVersions[osmVersion] = capabilities.EnvoyVersions
+	}
+
+	OutboundListenerNames[osmVersion] = OutboundListenerNames[base]
+	if capabilities.OutboundListenerName != "" {
+		OutboundListenerNames[osmVersion] = capabilities.OutboundListenerName
+	}
+
+	InboundListenerNames[osmVersion] = InboundListenerNames[base]
+	if capabilities.InboundListenerName != "" {
+		InboundListenerNames[osmVersion] = capabilities.InboundListenerName
+	}
+
+	SupportedTrafficTarget[osmVersion] = SupportedTrafficTarget[base]
+	if capabilities.TrafficTarget != "" {
+		SupportedTrafficTarget[osmVersion] = capabilities.TrafficTarget
+	}
+
+	SupportedTrafficTargetRouteKinds[osmVersion] = SupportedTrafficTargetRouteKinds[base]
+	if len(capabilities.TrafficTargetRouteKinds) != 0 {
+		SupportedTrafficTargetRouteKinds[osmVersion] = capabilities.TrafficTargetRouteKinds
+	}
+
+	SupportedTrafficSplit[osmVersion] = SupportedTrafficSplit[base]
+	if capabilities.TrafficSplit != "" {
+		SupportedTrafficSplit[osmVersion] = capabilities.TrafficSplit
+	}
+
+	SupportedHTTPRouteVersion[osmVersion] = SupportedHTTPRouteVersion[base]
+	if len(capabilities.HTTPRouteGroup) != 0 {
+		SupportedHTTPRouteVersion[osmVersion] = capabilities.HTTPRouteGroup
+	}
+
+	SupportedAnnotations[osmVersion] = SupportedAnnotations[base]
+	if len(capabilities.Annotations) != 0 {
+		SupportedAnnotations[osmVersion] = capabilities.Annotations
+	}
+
+	SupportedIngress[osmVersion] = SupportedIngress[base]
+	if len(capabilities.Ingress) != 0 {
+		SupportedIngress[osmVersion] = capabilities.Ingress
+	}
+
+	// Unlike the other capabilities, the probes of a version may be unknown, which checks tell from probes left unchanged
+	if probes, known := SidecarProbes[base]; known {
+		SidecarProbes[osmVersion] = probes
+	}
+	if capabilities.Probes != nil {
+		SidecarProbes[osmVersion] = capabilities.Probes
+	}
+
+	return nil
+}
+
+// sortedVersions returns the versions described by a capabilities file from the oldest to the newest, so that
+// a newer version in the file can inherit from an older one in the same file.
+func sortedVersions(versions map[ControllerVersion]Capabilities) []ControllerVersion {
+	var sorted []ControllerVersion
+	for osmVersion := range versions {
+		sorted = append(sorted, osmVersion)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return compareVersions(sorted[i], sorted[j]) < 0
+	})
+	return sorted
+}
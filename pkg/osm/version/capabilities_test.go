@@ -0,0 +1,95 @@
+package version
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestLoadCapabilitiesFile(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expectedErr error
+	}{
+		{
+			name: "new versions inherit unset capabilities from the nearest known release",
+			content: `
+versions:
+  v0.12:
+    envoyAdminPort: 15001
+    trafficSplit: v1alpha4
+    ingress: [networking/v1]
+  "0.13":
+    inboundListenerName: inbound-listener-v2
+    probes: {}
+`,
+		},
+		{
+			name:        "malformed version",
+			content:     "versions:\n  latest: {}\n",
+			expectedErr: ErrCapabilitiesFileInvalid,
+		},
+		{
+			name:        "unknown capability",
+			content:     "versions:\n  v0.12:\n    adminPort: 15001\n",
+			expectedErr: ErrCapabilitiesFileInvalid,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			t.Cleanup(func() {
+				unregisterCapabilities("v0.12")
+				unregisterCapabilities("v0.13")
+			})
+
+			path := filepath.Join(t.TempDir(), "capabilities.yaml")
+			assert.Nil(ioutil.WriteFile(path, []byte(test.content), 0600))
+
+			err := LoadCapabilitiesFile(path)
+			assert.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				assert.False(IsKnown("v0.12"))
+				return
+			}
+
+			assert.Equal(uint16(15001), EnvoyAdminPort["v0.12"])
+			assert.Equal(SupportedEnvoyVersions["v0.11"], SupportedEnvoyVersions["v0.12"])
+			assert.Equal(TrafficSplitVersion(V1Alpha4), SupportedTrafficSplit["v0.12"])
+			assert.Equal([]IngressVersion{"networking/v1"}, SupportedIngress["v0.12"])
+			assert.Equal(OutboundListenerNames["v0.11"], OutboundListenerNames["v0.12"])
+			assert.Equal(SupportedTrafficTarget["v0.11"], SupportedTrafficTarget["v0.12"])
+			assert.Equal(SupportedAnnotations["v0.11"], SupportedAnnotations["v0.12"])
+
+			// v0.13 inherits from v0.12, which was loaded from the same file.
+			assert.Equal(uint16(15001), EnvoyAdminPort["v0.13"])
+			assert.Equal("inbound-listener-v2", InboundListenerNames["v0.13"])
+			_, known := SidecarProbes["v0.12"]
+			assert.False(known)
+			assert.Empty(SidecarProbes["v0.13"])
+			assert.NotNil(SidecarProbes["v0.13"])
+
+			resolved, err := ResolveControllerVersion("v0.13")
+			assert.Nil(err)
+			assert.Equal(ControllerVersion("v0.13"), resolved)
+		})
+	}
+}
+
+func unregisterCapabilities(osmVersion ControllerVersion) {
+	delete(EnvoyAdminPort, osmVersion)
+	delete(SupportedEnvoyVersions, osmVersion)
+	delete(OutboundListenerNames, osmVersion)
+	delete(InboundListenerNames, osmVersion)
+	delete(SupportedTrafficTarget, osmVersion)
+	delete(SupportedTrafficTargetRouteKinds, osmVersion)
+	delete(SupportedTrafficSplit, osmVersion)
+	delete(SupportedHTTPRouteVersion, osmVersion)
+	delete(SupportedAnnotations, osmVersion)
+	delete(SupportedIngress, osmVersion)
+	delete(SidecarProbes, osmVersion)
+}
@@ -6,4 +6,7 @@ const (
 
 	// V1Alpha3 is a string constant used to identify SMI resources of version v1alpha3
 	V1Alpha3 = "v1alpha3"
+
+	// V1Alpha4 is a string constant used to identify SMI resources of version v1alpha4
+	V1Alpha4 = "v1alpha4"
 )
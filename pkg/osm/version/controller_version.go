@@ -0,0 +1,54 @@
+package version
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*ControllerVersionCheck)(nil)
+
+// ControllerVersionCheck implements common.Runnable
+type ControllerVersionCheck struct {
+	detected ControllerVersion
+	resolved ControllerVersion
+}
+
+// NewControllerVersionCheck creates a ControllerVersionCheck, which checks whether osm-health knows the capabilities
+// of the detected OSM Controller version or falls back to those of the resolved version.
+func NewControllerVersionCheck(detected, resolved ControllerVersion) ControllerVersionCheck {
+	return ControllerVersionCheck{
+		detected: detected,
+		resolved: resolved,
+	}
+}
+
+// Description implements common.Runnable
+func (check ControllerVersionCheck) Description() string {
+	return fmt.Sprintf("Checking whether OSM version %s is recognized", check.detected)
+}
+
+// Run implements common.Runnable
+func (check ControllerVersionCheck) Run(ctx context.Context) outcomes.Outcome {
+	if check.detected == check.resolved {
+		return outcomes.Pass{}
+	}
+	return outcomes.Warning{
+		Diagnostics: fmt.Sprintf("OSM version %s is not recognized; checks assume the capabilities of OSM %s",
+			check.detected, check.resolved),
+	}
+}
+
+// Suggestion implements common.Runnable.
+func (check ControllerVersionCheck) Suggestion() string {
+	return fmt.Sprintf("Describe the capabilities of OSM %s that differ from those of OSM %s in a YAML file and pass it with --version-capabilities-file=<path>",
+		check.detected, check.resolved)
+}
+
+// FixIt implements common.Runnable.
+func (check ControllerVersionCheck) FixIt() error {
+	panic("implement me")
+}
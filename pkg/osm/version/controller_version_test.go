@@ -0,0 +1,22 @@
+package version
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+func TestControllerVersionCheck(t *testing.T) {
+	assert := tassert.New(t)
+
+	check := NewControllerVersionCheck("v0.9", "v0.9")
+	assert.IsType(outcomes.Pass{}, check.Run(context.TODO()))
+
+	check = NewControllerVersionCheck("v0.12", "v0.11")
+	assert.IsType(outcomes.Warning{}, check.Run(context.TODO()))
+	assert.Contains(check.Suggestion(), "--version-capabilities-file")
+	assert.Contains(check.Suggestion(), "OSM v0.12")
+}
@@ -9,3 +9,14 @@ var EnvoyAdminPort = map[ControllerVersion]uint16{
 	"v0.10": 15000,
 	"v0.11": 15000,
 }
+
+// SupportedEnvoyVersions is the list of Envoy minor versions the given version of the OSM Controller is tested with
+// and generates xDS configuration for.
+var SupportedEnvoyVersions = map[ControllerVersion][]EnvoyVersion{
+	"v0.6":  {"v1.17"},
+	"v0.7":  {"v1.17"},
+	"v0.8":  {"v1.18"},
+	"v0.9":  {"v1.18", "v1.19"},
+	"v0.10": {"v1.19"},
+	"v0.11": {"v1.19"},
+}
@@ -0,0 +1,14 @@
+package version
+
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
+
+var (
+	// ErrControllerVersionMalformed is returned when an OSM Controller version is not in the vMAJOR.MINOR format.
+	ErrControllerVersionMalformed = outcomes.NewError("CONTROLLER_VERSION_MALFORMED", "osm controller version is not in the vMAJOR.MINOR format")
+
+	// ErrNoKnownControllerVersions is returned when osm-health has no capability information for any OSM release.
+	ErrNoKnownControllerVersions = outcomes.NewError("NO_KNOWN_CONTROLLER_VERSIONS", "no known osm controller versions")
+
+	// ErrCapabilitiesFileInvalid is returned when a version capabilities file cannot be read or parsed.
+	ErrCapabilitiesFileInvalid = outcomes.NewError("CAPABILITIES_FILE_INVALID", "invalid version capabilities file")
+)
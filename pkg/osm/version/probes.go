@@ -0,0 +1,36 @@
+package version
+
+// ProbeType is a type of container probe, such as liveness.
+type ProbeType string
+
+const (
+	// LivenessProbe is the type of liveness probes.
+	LivenessProbe ProbeType = "liveness"
+
+	// ReadinessProbe is the type of readiness probes.
+	ReadinessProbe ProbeType = "readiness"
+
+	// StartupProbe is the type of startup probes.
+	StartupProbe ProbeType = "startup"
+)
+
+// SidecarProbe is where the OSM sidecar injector points an HTTP probe of an application container, so that the kubelet
+// probes the application through an Envoy listener instead of being rejected by mTLS.
+type SidecarProbe struct {
+	Port         int32  `json:"port"`
+	Path         string `json:"path"`
+	ListenerName string `json:"listenerName"`
+}
+
+// SidecarProbes maps the versions of the OSM Controller to how their sidecar injector rewrites the HTTP probes of
+// application containers. Versions that leave the probes unchanged map to no probes. Only the versions whose probes
+// were checked against their release are listed; the probes of other versions can be described in a capabilities file.
+var SidecarProbes = map[ControllerVersion]map[ProbeType]SidecarProbe{
+	// Source: https://github.com/openservicemesh/osm/blob/release-v0.9/pkg/injector/health_probes.go
+	// and the probe listeners of tests/sample-envoy-config-dump-bookstore.json, recorded from OSM v0.9.1
+	"v0.9": {
+		LivenessProbe:  {Port: 15901, Path: "/osm-liveness-probe", ListenerName: "liveness_listener"},
+		ReadinessProbe: {Port: 15902, Path: "/osm-readiness-probe", ListenerName: "readiness_listener"},
+		StartupProbe:   {Port: 15903, Path: "/osm-startup-probe", ListenerName: "startup_listener"},
+	},
+}
@@ -0,0 +1,100 @@
+package version
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// IsKnown returns true if osm-health has capability information for the given OSM Controller version.
+func IsKnown(osmVersion ControllerVersion) bool {
+	_, exists := EnvoyAdminPort[osmVersion]
+	return exists
+}
+
+// KnownControllerVersions returns the OSM Controller versions osm-health has capability information for,
+// sorted from the oldest to the newest release.
+func KnownControllerVersions() []ControllerVersion {
+	var known []ControllerVersion
+	for osmVersion := range EnvoyAdminPort {
+		if _, _, err := parseMajorMinor(osmVersion); err != nil {
+			continue
+		}
+		known = append(known, osmVersion)
+	}
+	sort.Slice(known, func(i, j int) bool {
+		return compareVersions(known[i], known[j]) < 0
+	})
+	return known
+}
+
+// ResolveControllerVersion maps the given OSM Controller version to the version whose capabilities osm-health should use.
+// A known version resolves to itself. An unknown version resolves to the newest known release that is older than it,
+// or to the oldest known release when it predates all of them.
+func ResolveControllerVersion(osmVersion ControllerVersion) (ControllerVersion, error) {
+	if IsKnown(osmVersion) {
+		return osmVersion, nil
+	}
+	resolved, err := nearestKnownVersion(osmVersion)
+	if err != nil {
+		return "", err
+	}
+	log.Warn().Msgf("OSM version %s is not recognized; using the capabilities of OSM %s", osmVersion, resolved)
+	return resolved, nil
+}
+
+// nearestKnownVersion returns the newest known release that is not newer than the given version,
+// or the oldest known release when the given version predates all of them.
+func nearestKnownVersion(osmVersion ControllerVersion) (ControllerVersion, error) {
+	if _, _, err := parseMajorMinor(osmVersion); err != nil {
+		return "", err
+	}
+
+	known := KnownControllerVersions()
+	if len(known) == 0 {
+		return "", errors.Wrapf(ErrNoKnownControllerVersions, "unable to resolve OSM version %s", osmVersion)
+	}
+
+	nearest := known[0]
+	for _, knownVersion := range known {
+		if compareVersions(knownVersion, osmVersion) > 0 {
+			break
+		}
+		nearest = knownVersion
+	}
+	return nearest, nil
+}
+
+// compareVersions returns -1, 0 or 1 when a is older than, the same as or newer than b.
+// Both versions must be parseable by parseMajorMinor.
+func compareVersions(a, b ControllerVersion) int {
+	aMajor, aMinor, _ := parseMajorMinor(a)
+	bMajor, bMinor, _ := parseMajorMinor(b)
+	switch {
+	case aMajor < bMajor, aMajor == bMajor && aMinor < bMinor:
+		return -1
+	case aMajor == bMajor && aMinor == bMinor:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// parseMajorMinor parses an OSM Controller version in the vMAJOR.MINOR format.
+func parseMajorMinor(osmVersion ControllerVersion) (int, int, error) {
+	chunks := strings.Split(strings.TrimPrefix(osmVersion.String(), "v"), ".")
+	if len(chunks) != 2 {
+		return 0, 0, errors.Wrapf(ErrControllerVersionMalformed, "%q", osmVersion)
+	}
+	major, err := strconv.Atoi(chunks[0])
+	if err != nil {
+		return 0, 0, errors.Wrapf(ErrControllerVersionMalformed, "%q", osmVersion)
+	}
+	minor, err := strconv.Atoi(chunks[1])
+	if err != nil {
+		return 0, 0, errors.Wrapf(ErrControllerVersionMalformed, "%q", osmVersion)
+	}
+	return major, minor, nil
+}
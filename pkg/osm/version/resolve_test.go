@@ -0,0 +1,56 @@
+package version
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestResolveControllerVersion(t *testing.T) {
+	tests := []struct {
+		name             string
+		osmVersion       ControllerVersion
+		expectedResolved ControllerVersion
+		expectedErr      error
+	}{
+		{
+			name:             "known version resolves to itself",
+			osmVersion:       "v0.9",
+			expectedResolved: "v0.9",
+		},
+		{
+			name:             "newer minor version resolves to the newest known release",
+			osmVersion:       "v0.12",
+			expectedResolved: "v0.11",
+		},
+		{
+			name:             "newer major version resolves to the newest known release",
+			osmVersion:       "v1.0",
+			expectedResolved: "v0.11",
+		},
+		{
+			name:             "older version resolves to the oldest known release",
+			osmVersion:       "v0.5",
+			expectedResolved: "v0.6",
+		},
+		{
+			name:        "malformed version",
+			osmVersion:  "latest",
+			expectedErr: ErrControllerVersionMalformed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			resolved, err := ResolveControllerVersion(test.osmVersion)
+			assert.ErrorIs(err, test.expectedErr)
+			assert.Equal(test.expectedResolved, resolved)
+		})
+	}
+}
+
+func TestKnownControllerVersions(t *testing.T) {
+	assert := tassert.New(t)
+	assert.Equal([]ControllerVersion{"v0.6", "v0.7", "v0.8", "v0.9", "v0.10", "v0.11"}, KnownControllerVersions())
+}
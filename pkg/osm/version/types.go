@@ -25,3 +25,6 @@ type HTTPRouteVersion string
 
 // Annotation is a string type alias for the osm annotation.
 type Annotation string
+
+// EnvoyVersion is a string type alias for an Envoy minor version, such as v1.19.
+type EnvoyVersion string
@@ -54,6 +54,11 @@ func TestEnvoyConfigParser(t *testing.T) {
 			assert.Truef(exists, "EnvoyAdminPort does not contain info on OSM release %s", release)
 		}
 
+		{
+			_, exists := SupportedEnvoyVersions[controllerVersion]
+			assert.Truef(exists, "SupportedEnvoyVersions does not contain info on OSM release %s", release)
+		}
+
 		{
 			_, exists := OutboundListenerNames[controllerVersion]
 			assert.Truef(exists, "OutboundListenerNames does not contain info on OSM release %s", release)
@@ -0,0 +1,279 @@
+package osm
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	mapset "github.com/deckarep/golang-set"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/utils"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+const (
+	mutatingWebhookKind   = "MutatingWebhookConfiguration"
+	validatingWebhookKind = "ValidatingWebhookConfiguration"
+
+	// osmValidatorServiceName is the name of the service of the osm-controller's resource validator webhook.
+	osmValidatorServiceName = "osm-validator"
+
+	// caBundleExpiryWarningPeriod is how long before a certificate of a CA bundle expires the check warns about it.
+	caBundleExpiryWarningPeriod = 30 * 24 * time.Hour
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*WebhookConfigurationCheck)(nil)
+
+// WebhookConfigurationCheck implements common.Runnable
+type WebhookConfigurationCheck struct {
+	client                   kubernetes.Interface
+	osmControlPlaneNamespace common.MeshNamespace
+	kind                     string
+	serviceName              string
+	// certSecretName is the name of the secret holding the webhook serving certificate, if the OSM component persists it.
+	certSecretName string
+}
+
+// webhook is the part of a mutating or validating webhook inspected by WebhookConfigurationCheck
+type webhook struct {
+	configurationName string
+	name              string
+	caBundle          []byte
+	namespaceSelector *metav1.LabelSelector
+}
+
+// NewMutatingWebhookCheck checks whether the sidecar injector's MutatingWebhookConfiguration trusts the osm-injector
+// serving certificate and selects the namespaces monitored by OSM
+func NewMutatingWebhookCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) WebhookConfigurationCheck {
+	return WebhookConfigurationCheck{
+		client:                   client,
+		osmControlPlaneNamespace: osmControlPlaneNamespace,
+		kind:                     mutatingWebhookKind,
+		serviceName:              constants.OSMInjectorName,
+		certSecretName:           constants.WebhookCertificateSecretName,
+	}
+}
+
+// NewValidatingWebhookCheck checks whether the resource validator's ValidatingWebhookConfiguration has a valid CA bundle
+// and selects the namespaces monitored by OSM
+func NewValidatingWebhookCheck(client kubernetes.Interface, osmControlPlaneNamespace common.MeshNamespace) WebhookConfigurationCheck {
+	return WebhookConfigurationCheck{
+		client:                   client,
+		osmControlPlaneNamespace: osmControlPlaneNamespace,
+		kind:                     validatingWebhookKind,
+		serviceName:              osmValidatorServiceName,
+	}
+}
+
+// Description implements common.Runnable
+func (check WebhookConfigurationCheck) Description() string {
+	return fmt.Sprintf("Checking whether the %s for service %s/%s is configured correctly", check.kind, check.osmControlPlaneNamespace, check.serviceName)
+}
+
+// Run implements common.Runnable
+func (check WebhookConfigurationCheck) Run(ctx context.Context) outcomes.Outcome {
+	webhooks, err := check.getWebhooks(ctx)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	if len(webhooks) == 0 {
+		return outcomes.Fail{Error: errors.Wrapf(ErrWebhookConfigurationNotFound, "no %s references service %s/%s", check.kind, check.osmControlPlaneNamespace, check.serviceName)}
+	}
+
+	servingCert, err := check.getServingCertificate(ctx)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+
+	monitoredNamespaces, err := utils.GetMonitoredNamespaces(ctx, check.client, check.osmControlPlaneNamespace)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	monitored := mapset.NewSet()
+	for _, ns := range monitoredNamespaces.Items {
+		monitored.Add(ns.Name)
+	}
+
+	var diagnostics, warnings []string
+	details := map[string]interface{}{}
+	for _, wh := range webhooks {
+		expiry, err := validateCABundle(wh, servingCert)
+		if err != nil {
+			return outcomes.Fail{Error: err}
+		}
+		if time.Until(expiry) < caBundleExpiryWarningPeriod {
+			warnings = append(warnings, fmt.Sprintf("webhook %s of %s %s has a certificate that expires on %s", wh.name, check.kind, wh.configurationName, expiry.Format(time.RFC3339)))
+			details[wh.name+".caBundleExpiry"] = expiry.Format(time.RFC3339)
+		}
+
+		selected, err := check.getSelectedNamespaces(ctx, wh)
+		if err != nil {
+			return outcomes.Fail{Error: err}
+		}
+		var mismatches []string
+		if notMonitored := selected.Difference(monitored); notMonitored.Cardinality() > 0 {
+			mismatches = append(mismatches, fmt.Sprintf("selects namespaces that are not monitored: %s", joinSorted(notMonitored)))
+		}
+		if notSelected := monitored.Difference(selected); notSelected.Cardinality() > 0 {
+			mismatches = append(mismatches, fmt.Sprintf("does not select monitored namespaces: %s", joinSorted(notSelected)))
+		}
+		if len(mismatches) > 0 {
+			return outcomes.Fail{Error: errors.Wrapf(ErrWebhookNamespaceSelectorMismatch, "webhook %s of %s %s %s",
+				wh.name, check.kind, wh.configurationName, strings.Join(mismatches, "; "))}
+		}
+		diagnostics = append(diagnostics, fmt.Sprintf("webhook %s of %s %s selects %d monitored namespaces", wh.name, check.kind, wh.configurationName, selected.Cardinality()))
+	}
+	if servingCert == nil && check.certSecretName != "" {
+		warnings = append(warnings, fmt.Sprintf("secret %s/%s was not found, so the CA bundle was not compared to the serving certificate", check.osmControlPlaneNamespace, check.certSecretName))
+	}
+	if len(warnings) > 0 {
+		return outcomes.Warning{Diagnostics: strings.Join(append(diagnostics, warnings...), "\n"), Details: details}
+	}
+	return outcomes.Pass{Diagnostics: strings.Join(diagnostics, "\n")}
+}
+
+// getWebhooks returns the webhooks of the check's kind whose client config references the check's service
+func (check WebhookConfigurationCheck) getWebhooks(ctx context.Context) ([]webhook, error) {
+	var webhooks []webhook
+	references := func(namespace, name string) bool {
+		return namespace == check.osmControlPlaneNamespace.String() && name == check.serviceName
+	}
+
+	switch check.kind {
+	case mutatingWebhookKind:
+		configurations, err := check.client.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list %ss", check.kind)
+		}
+		for _, configuration := range configurations.Items {
+			for _, wh := range configuration.Webhooks {
+				if wh.ClientConfig.Service != nil && references(wh.ClientConfig.Service.Namespace, wh.ClientConfig.Service.Name) {
+					webhooks = append(webhooks, webhook{configuration.Name, wh.Name, wh.ClientConfig.CABundle, wh.NamespaceSelector})
+				}
+			}
+		}
+	case validatingWebhookKind:
+		configurations, err := check.client.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list %ss", check.kind)
+		}
+		for _, configuration := range configurations.Items {
+			for _, wh := range configuration.Webhooks {
+				if wh.ClientConfig.Service != nil && references(wh.ClientConfig.Service.Namespace, wh.ClientConfig.Service.Name) {
+					webhooks = append(webhooks, webhook{configuration.Name, wh.Name, wh.ClientConfig.CABundle, wh.NamespaceSelector})
+				}
+			}
+		}
+	}
+	return webhooks, nil
+}
+
+// getServingCertificate returns the certificate chain of the webhook serving certificate, or nil when it is not persisted in a secret
+func (check WebhookConfigurationCheck) getServingCertificate(ctx context.Context) ([]byte, error) {
+	if check.certSecretName == "" {
+		return nil, nil
+	}
+	secret, err := check.client.CoreV1().Secrets(check.osmControlPlaneNamespace.String()).Get(ctx, check.certSecretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get secret %s/%s", check.osmControlPlaneNamespace, check.certSecretName)
+	}
+	return secret.Data[constants.KubernetesOpaqueSecretCAKey], nil
+}
+
+// getSelectedNamespaces returns the names of the namespaces selected by the webhook's namespace selector
+func (check WebhookConfigurationCheck) getSelectedNamespaces(ctx context.Context, wh webhook) (mapset.Set, error) {
+	selector := labels.Everything()
+	if wh.namespaceSelector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(wh.namespaceSelector); err != nil {
+			return nil, errors.Wrapf(err, "invalid namespace selector in webhook %s of %s %s", wh.name, check.kind, wh.configurationName)
+		}
+	}
+
+	namespaces, err := check.client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Errorf("unable to list namespaces in the cluster: %s", err)
+	}
+	selected := mapset.NewSet()
+	for _, ns := range namespaces.Items {
+		if selector.Matches(labels.Set(ns.Labels)) {
+			selected.Add(ns.Name)
+		}
+	}
+	return selected, nil
+}
+
+// validateCABundle checks that the webhook's CA bundle holds unexpired certificates and matches the serving certificate, when known.
+// It returns when the first certificate of the CA bundle expires.
+func validateCABundle(wh webhook, servingCert []byte) (time.Time, error) {
+	var expiry time.Time
+	if len(wh.caBundle) == 0 {
+		return expiry, errors.Wrapf(ErrWebhookCABundleInvalid, "webhook %s of %s has an empty CA bundle", wh.name, wh.configurationName)
+	}
+
+	rest := wh.caBundle
+	certificates := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return expiry, errors.Wrapf(ErrWebhookCABundleInvalid, "webhook %s of %s has a malformed certificate: %s", wh.name, wh.configurationName, err)
+		}
+		if time.Now().After(cert.NotAfter) {
+			return expiry, errors.Wrapf(ErrWebhookCABundleInvalid, "webhook %s of %s has a certificate that expired on %s", wh.name, wh.configurationName, cert.NotAfter.Format(time.RFC3339))
+		}
+		if expiry.IsZero() || cert.NotAfter.Before(expiry) {
+			expiry = cert.NotAfter
+		}
+		certificates++
+	}
+	if certificates == 0 {
+		return expiry, errors.Wrapf(ErrWebhookCABundleInvalid, "webhook %s of %s has no PEM encoded certificates", wh.name, wh.configurationName)
+	}
+
+	if servingCert != nil && !bytes.Equal(bytes.TrimSpace(wh.caBundle), bytes.TrimSpace(servingCert)) {
+		return expiry, errors.Wrapf(ErrWebhookCABundleMismatch, "webhook %s of %s", wh.name, wh.configurationName)
+	}
+	return expiry, nil
+}
+
+func joinSorted(set mapset.Set) string {
+	var names []string
+	for name := range set.Iter() {
+		names = append(names, name.(string))
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// Suggestion implements common.Runnable
+func (check WebhookConfigurationCheck) Suggestion() string {
+	return fmt.Sprintf("Restart the pods behind service %s/%s so that they reconcile the %s, and check that monitored namespaces were added with `osm namespace add`",
+		check.osmControlPlaneNamespace, check.serviceName, check.kind)
+}
+
+// FixIt implements common.Runnable
+func (check WebhookConfigurationCheck) FixIt() error {
+	panic("implement me")
+}
@@ -0,0 +1,178 @@
+package osm
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+func newTestCertificate(t *testing.T, notAfter time.Time) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "osm-injector.osm-system.svc"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestMutatingWebhookCheck(t *testing.T) {
+	cert := newTestCertificate(t, time.Now().AddDate(1, 0, 0))
+	otherCert := newTestCertificate(t, time.Now().AddDate(1, 0, 0))
+	expiringCertNotAfter := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	expiringCert := newTestCertificate(t, expiringCertNotAfter)
+	expiredCert := newTestCertificate(t, time.Now().Add(-time.Hour))
+
+	controller := newDeployment("osm-controller", 1, 1, corev1.ConditionTrue)
+	controller.Labels[constants.OSMAppInstanceLabelKey] = "osm"
+	controller.Labels[constants.OSMAppVersionLabelKey] = "v0.9.0"
+	monitored := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "bookstore",
+		Labels: map[string]string{constants.OSMKubeResourceMonitorAnnotation: "osm"},
+	}}
+	ignored := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "bookthief",
+		Labels: map[string]string{constants.OSMKubeResourceMonitorAnnotation: "osm", constants.IgnoreLabel: "true"},
+	}}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.WebhookCertificateSecretName, Namespace: "osm-system"},
+		Data:       map[string][]byte{constants.KubernetesOpaqueSecretCAKey: cert},
+	}
+
+	meshSelector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{constants.OSMKubeResourceMonitorAnnotation: "osm"},
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: constants.IgnoreLabel, Operator: metav1.LabelSelectorOpDoesNotExist},
+		},
+	}
+	newWebhookConfiguration := func(caBundle []byte, selector *metav1.LabelSelector) *admissionregv1.MutatingWebhookConfiguration {
+		return &admissionregv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "osm-webhook-osm"},
+			Webhooks: []admissionregv1.MutatingWebhook{{
+				Name: "osm-inject.k8s.io",
+				ClientConfig: admissionregv1.WebhookClientConfig{
+					Service:  &admissionregv1.ServiceReference{Namespace: "osm-system", Name: constants.OSMInjectorName},
+					CABundle: caBundle,
+				},
+				NamespaceSelector: selector,
+			}},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		objects         []runtime.Object
+		expectedOutcome outcomes.Outcome
+		expectedErr     error
+		expectedDetails map[string]interface{}
+	}{
+		{
+			name:            "webhook trusts the injector certificate and selects the monitored namespaces",
+			objects:         []runtime.Object{controller, monitored, ignored, secret, newWebhookConfiguration(cert, meshSelector)},
+			expectedOutcome: outcomes.Pass{},
+		},
+		{
+			name:            "injector certificate secret is missing",
+			objects:         []runtime.Object{controller, monitored, newWebhookConfiguration(cert, meshSelector)},
+			expectedOutcome: outcomes.Warning{},
+		},
+		{
+			name:            "webhook configuration is missing",
+			objects:         []runtime.Object{controller, monitored, secret},
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrWebhookConfigurationNotFound,
+		},
+		{
+			name:            "CA bundle does not match the injector certificate",
+			objects:         []runtime.Object{controller, monitored, secret, newWebhookConfiguration(otherCert, meshSelector)},
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrWebhookCABundleMismatch,
+		},
+		{
+			name: "CA bundle expires soon",
+			objects: []runtime.Object{controller, monitored, newWebhookConfiguration(expiringCert, meshSelector), &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: constants.WebhookCertificateSecretName, Namespace: "osm-system"},
+				Data:       map[string][]byte{constants.KubernetesOpaqueSecretCAKey: expiringCert},
+			}},
+			expectedOutcome: outcomes.Warning{},
+			expectedDetails: map[string]interface{}{"osm-inject.k8s.io.caBundleExpiry": expiringCertNotAfter.Format(time.RFC3339)},
+		},
+		{
+			name:            "CA bundle is expired",
+			objects:         []runtime.Object{controller, monitored, newWebhookConfiguration(expiredCert, meshSelector)},
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrWebhookCABundleInvalid,
+		},
+		{
+			name:            "CA bundle is empty",
+			objects:         []runtime.Object{controller, monitored, newWebhookConfiguration(nil, meshSelector)},
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrWebhookCABundleInvalid,
+		},
+		{
+			name: "namespace selector selects ignored namespaces",
+			objects: []runtime.Object{controller, monitored, ignored, secret, newWebhookConfiguration(cert, &metav1.LabelSelector{
+				MatchLabels: map[string]string{constants.OSMKubeResourceMonitorAnnotation: "osm"},
+			})},
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     ErrWebhookNamespaceSelectorMismatch,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			outcome := NewMutatingWebhookCheck(fake.NewSimpleClientset(test.objects...), "osm-system").Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.ErrorIs(outcome.GetError(), test.expectedErr)
+			if test.expectedDetails != nil {
+				assert.Equal(test.expectedDetails, outcome.GetDetails())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookCheck(t *testing.T) {
+	assert := tassert.New(t)
+	controller := newDeployment("osm-controller", 1, 1, corev1.ConditionTrue)
+	controller.Labels[constants.OSMAppInstanceLabelKey] = "osm"
+	controller.Labels[constants.OSMAppVersionLabelKey] = "v0.9.0"
+	configuration := &admissionregv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "osm-validator-mesh-osm"},
+		Webhooks: []admissionregv1.ValidatingWebhook{{
+			Name: "osm-validator.k8s.io",
+			ClientConfig: admissionregv1.WebhookClientConfig{
+				Service:  &admissionregv1.ServiceReference{Namespace: "osm-system", Name: osmValidatorServiceName},
+				CABundle: newTestCertificate(t, time.Now().AddDate(1, 0, 0)),
+			},
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{constants.OSMKubeResourceMonitorAnnotation: "osm"}},
+		}},
+	}
+
+	outcome := NewValidatingWebhookCheck(fake.NewSimpleClientset(controller, configuration), "osm-system").Run(context.TODO())
+	assert.IsType(outcomes.Pass{}, outcome)
+}
@@ -0,0 +1,106 @@
+package plugins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*Check)(nil)
+var _ runner.Described = (*Check)(nil)
+
+// Check implements common.Runnable by running a check of a plugin executable.
+type Check struct {
+	path        string
+	description CheckDescription
+	target      Target
+}
+
+// Run implements common.Runnable
+func (check Check) Run(ctx context.Context) outcomes.Outcome {
+	input, err := json.Marshal(check.target)
+	if err != nil {
+		return outcomes.Fail{Error: errors.Wrapf(ErrPluginFailed, "unable to marshal the target of check %s: %s", check.description.ID, err)}
+	}
+	output, err := execPlugin(ctx, check.path, input, runArg, check.description.ID)
+	if err != nil {
+		return outcomes.Fail{Error: errors.Wrapf(ErrPluginFailed, "%s", err)}
+	}
+
+	var result Result
+	if err := json.Unmarshal(output, &result); err != nil {
+		return outcomes.Fail{Error: errors.Wrapf(ErrPluginFailed, "plugin %s printed an invalid result for check %s: %s", check.path, check.description.ID, err)}
+	}
+	return result.outcome(check.path, check.description.ID)
+}
+
+// outcome returns the outcome of the result of a plugin check.
+func (result Result) outcome(path string, id string) outcomes.Outcome {
+	switch result.Outcome {
+	case "pass":
+		return outcomes.Pass{Diagnostics: result.Message, Details: result.Details}
+	case "fail":
+		err := ErrPluginCheckFailed
+		switch {
+		case result.Code != "" && result.Message != "":
+			err = outcomes.NewError(outcomes.ErrorCode(result.Code), result.Message)
+		case result.Code != "":
+			err = outcomes.NewError(outcomes.ErrorCode(result.Code), ErrPluginCheckFailed.Error())
+		case result.Message != "":
+			err = errors.Wrap(ErrPluginCheckFailed, result.Message)
+		}
+		fail := outcomes.Fail{Error: err, Details: result.Details}
+		if result.Severity != "" {
+			severity, ok := outcomes.ParseSeverity(result.Severity)
+			if !ok {
+				return outcomes.Fail{Error: errors.Wrapf(ErrPluginFailed, "plugin %s printed an unknown severity %q for check %s", path, result.Severity, id)}
+			}
+			fail.Severity = severity
+		}
+		return fail
+	case "warning":
+		return outcomes.Warning{Diagnostics: result.Message, Details: result.Details}
+	case "info":
+		return outcomes.Info{Diagnostics: result.Message, Details: result.Details}
+	case "skipped":
+		return outcomes.Skipped{Reason: result.Message}
+	case "unknown":
+		return outcomes.Unknown{Reason: result.Message}
+	default:
+		return outcomes.Fail{Error: errors.Wrapf(ErrPluginFailed, "plugin %s printed an unknown outcome %q for check %s", path, result.Outcome, id)}
+	}
+}
+
+// Description implements common.Runnable
+func (check Check) Description() string {
+	if check.description.Description != "" {
+		return check.description.Description
+	}
+	return fmt.Sprintf("Running check %s of plugin %s", check.description.ID, check.path)
+}
+
+// Suggestion implements common.Runnable
+func (check Check) Suggestion() string {
+	return check.description.Suggestion
+}
+
+// FixIt implements common.Runnable
+func (check Check) FixIt() error {
+	panic("implement me")
+}
+
+// CheckInfo implements runner.Described
+func (check Check) CheckInfo() runner.CheckInfo {
+	return runner.CheckInfo{
+		ID:    check.description.ID,
+		Tags:  append(append([]string{}, check.description.Tags...), TagPlugin),
+		Doc:   check.description.Doc,
+		Check: check,
+	}
+}
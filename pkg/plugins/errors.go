@@ -0,0 +1,14 @@
+package plugins
+
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
+
+var (
+	// ErrPluginInvalid denotes a plugin that cannot describe its checks.
+	ErrPluginInvalid = outcomes.NewError("PLUGIN_INVALID", "invalid plugin")
+
+	// ErrPluginFailed denotes a plugin that exited with an error or did not print a valid result for a check.
+	ErrPluginFailed = outcomes.NewError("PLUGIN_FAILED", "plugin failed")
+
+	// ErrPluginCheckFailed is the error of a failed plugin check whose result has no error code.
+	ErrPluginCheckFailed = outcomes.NewError("PLUGIN_CHECK_FAILED", "plugin check failed")
+)
@@ -0,0 +1,5 @@
+package plugins
+
+import "github.com/openservicemesh/osm-health/pkg/logger"
+
+var log = logger.New("plugins")
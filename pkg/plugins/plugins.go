@@ -0,0 +1,111 @@
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+const (
+	// TagPlugin is the tag of every plugin check.
+	TagPlugin = "plugin"
+
+	// describeTimeout is how long a plugin may take to describe its checks.
+	describeTimeout = 10 * time.Second
+
+	describeArg = "describe"
+	runArg      = "run"
+)
+
+// plugin is an executable providing checks.
+type plugin struct {
+	path   string
+	checks []CheckDescription
+}
+
+// loaded holds the plugins loaded by Load.
+var loaded []plugin
+
+// Load runs each plugin executable with the describe argument and registers the checks it provides, so that they
+// can be selected like built-in checks and Checks returns them. Built-in checks must be registered first, so that
+// a plugin check cannot replace one.
+func Load(ctx context.Context, paths ...string) error {
+	for _, path := range paths {
+		description, err := describe(ctx, path)
+		if err != nil {
+			return err
+		}
+		for _, check := range description.Checks {
+			if check.ID == "" {
+				return errors.Wrapf(ErrPluginInvalid, "plugin %s describes a check without an ID", path)
+			}
+			if isRegistered(check.ID) {
+				return errors.Wrapf(ErrPluginInvalid, "plugin %s describes check %s, which is already registered", path, check.ID)
+			}
+			runner.Register(Check{path: path, description: check}.CheckInfo())
+		}
+		loaded = append(loaded, plugin{path: path, checks: description.Checks})
+		log.Debug().Msgf("Loaded %d checks from plugin %s", len(description.Checks), path)
+	}
+	return nil
+}
+
+// Checks returns the checks of the loaded plugins, run against the given target.
+func Checks(target Target) []runner.Runnable {
+	var checks []runner.Runnable
+	for _, p := range loaded {
+		for _, description := range p.checks {
+			checks = append(checks, Check{path: p.path, description: description, target: target})
+		}
+	}
+	return checks
+}
+
+// describe runs a plugin executable to describe its checks.
+func describe(ctx context.Context, path string) (Description, error) {
+	ctx, cancel := context.WithTimeout(ctx, describeTimeout)
+	defer cancel()
+
+	var description Description
+	output, err := execPlugin(ctx, path, nil, describeArg)
+	if err != nil {
+		return description, errors.Wrapf(ErrPluginInvalid, "%s", err)
+	}
+	if err := json.Unmarshal(output, &description); err != nil {
+		return description, errors.Wrapf(ErrPluginInvalid, "plugin %s printed an invalid description: %s", path, err)
+	}
+	return description, nil
+}
+
+// execPlugin runs a plugin executable with the given standard input and arguments, and returns its standard output.
+func execPlugin(ctx context.Context, path string, stdin []byte, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, path, args...) // #nosec G204 -- the plugin is supplied by the user running osm-health
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, errors.Errorf("%s %s: %s: %s", path, strings.Join(args, " "), err, message)
+		}
+		return nil, errors.Errorf("%s %s: %s", path, strings.Join(args, " "), err)
+	}
+	return stdout.Bytes(), nil
+}
+
+// isRegistered returns whether a check with the given ID is registered.
+func isRegistered(id string) bool {
+	for _, info := range runner.Registered() {
+		if info.ID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,198 @@
+package plugins
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+// testPlugin provides a check per outcome. The labels check passes only when the target has a destination pod.
+const testPlugin = `#!/bin/sh
+case "$1" in
+describe)
+	echo '{"checks": [
+		{"id": "acme.labels", "description": "Checking the acme labels", "doc": "Pods have the acme labels", "tags": ["k8s"]},
+		{"id": "acme.warning", "doc": "Always warns"},
+		{"id": "acme.skipped", "doc": "Never applies"},
+		{"id": "acme.crash", "doc": "Always crashes"}
+	]}'
+	;;
+run)
+	input=$(cat)
+	case "$2" in
+	acme.labels)
+		case "$input" in
+		*'"role":"destination"'*) echo '{"outcome": "pass", "message": "all labels found"}' ;;
+		*) echo '{"outcome": "fail", "message": "label team missing", "code": "ACME_LABEL_MISSING", "severity": "critical", "details": {"missing": ["team"]}}' ;;
+		esac
+		;;
+	acme.warning) echo '{"outcome": "warning", "message": "careful"}' ;;
+	acme.skipped) echo '{"outcome": "skipped", "message": "not applicable"}' ;;
+	acme.crash) echo "boom" >&2; exit 2 ;;
+	esac
+	;;
+esac
+`
+
+func writePlugin(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "plugin")
+	// #nosec G306 -- the plugin must be executable
+	if err := ioutil.WriteFile(path, []byte(content), 0700); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestPlugin(t *testing.T) {
+	assert := tassert.New(t)
+	loaded = nil
+	defer func() { loaded = nil }()
+
+	assert.Nil(Load(context.TODO(), writePlugin(t, testPlugin)))
+
+	var ids []string
+	for _, info := range runner.Registered() {
+		ids = append(ids, info.ID)
+		assert.Contains(info.Tags, TagPlugin)
+	}
+	assert.Equal([]string{"acme.crash", "acme.labels", "acme.skipped", "acme.warning"}, ids)
+
+	// Loading the plugin again would replace its checks
+	assert.ErrorIs(Load(context.TODO(), writePlugin(t, testPlugin)), ErrPluginInvalid)
+
+	printables := runner.Run(context.TODO(), Checks(Target{
+		Command: "connectivity pod-to-url",
+		Pods:    []Pod{{Namespace: "bookbuyer", Name: "bookbuyer", Role: RoleSource}},
+	})...)
+	assert.Len(printables, 4)
+
+	labels := printables[0]
+	assert.Equal("acme.labels", labels.CheckID)
+	assert.Equal("Checking the acme labels", labels.CheckDescription)
+	assert.IsType(outcomes.Fail{}, labels.Outcome)
+	assert.Equal("label team missing", labels.Error.Error())
+	assert.Equal(outcomes.ErrorCode("ACME_LABEL_MISSING"), labels.ErrorCode)
+	assert.Equal(outcomes.SeverityCritical, labels.Severity)
+	assert.Equal(map[string]interface{}{"missing": []interface{}{"team"}}, labels.Details)
+
+	assert.IsType(outcomes.Warning{}, printables[1].Outcome)
+	assert.Equal("careful", printables[1].Diagnostics)
+	assert.IsType(outcomes.Skipped{}, printables[2].Outcome)
+
+	crash := printables[3]
+	assert.IsType(outcomes.Fail{}, crash.Outcome)
+	assert.ErrorIs(crash.Error, ErrPluginFailed)
+	assert.Contains(crash.Error.Error(), "boom")
+
+	printables = runner.Run(context.TODO(), Checks(Target{
+		Command: "ingress to-pod",
+		Pods:    []Pod{{Namespace: "bookstore", Name: "bookstore", Role: RoleDestination}},
+	})...)
+	assert.IsType(outcomes.Pass{}, printables[0].Outcome)
+	assert.Equal("all labels found", printables[0].Diagnostics)
+
+	// Plugin checks are selected like built-in checks
+	assert.Nil(runner.SetFilter(runner.Filter{Tags: []string{"k8s"}}))
+	defer func() { assert.Nil(runner.SetFilter(runner.Filter{})) }()
+	printables = runner.Run(context.TODO(), Checks(Target{})...)
+	assert.Len(printables, 1)
+	assert.Equal("acme.labels", printables[0].CheckID)
+}
+
+func TestResultOutcome(t *testing.T) {
+	tests := []struct {
+		name             string
+		result           Result
+		expectedOutcome  outcomes.Outcome
+		expectedCode     outcomes.ErrorCode
+		expectedSeverity outcomes.Severity
+	}{
+		{
+			name:             "fail without code",
+			result:           Result{Outcome: "fail", Message: "no good"},
+			expectedOutcome:  outcomes.Fail{},
+			expectedCode:     "PLUGIN_CHECK_FAILED",
+			expectedSeverity: outcomes.SeverityError,
+		},
+		{
+			name:             "fail with code only",
+			result:           Result{Outcome: "fail", Code: "ACME_BROKEN", Severity: "warning"},
+			expectedOutcome:  outcomes.Fail{},
+			expectedCode:     "ACME_BROKEN",
+			expectedSeverity: outcomes.SeverityWarning,
+		},
+		{
+			name:             "fail with unknown severity",
+			result:           Result{Outcome: "fail", Code: "ACME_BROKEN", Severity: "dire"},
+			expectedOutcome:  outcomes.Fail{},
+			expectedCode:     "PLUGIN_FAILED",
+			expectedSeverity: outcomes.SeverityError,
+		},
+		{
+			name:             "info",
+			result:           Result{Outcome: "info", Message: "fyi"},
+			expectedOutcome:  outcomes.Info{},
+			expectedCode:     outcomes.NoErrorCode,
+			expectedSeverity: outcomes.SeverityInfo,
+		},
+		{
+			name:             "unknown",
+			result:           Result{Outcome: "unknown", Message: "cannot tell"},
+			expectedOutcome:  outcomes.Unknown{},
+			expectedCode:     outcomes.NoErrorCode,
+			expectedSeverity: outcomes.SeverityWarning,
+		},
+		{
+			name:             "invalid outcome",
+			result:           Result{Outcome: "great"},
+			expectedOutcome:  outcomes.Fail{},
+			expectedCode:     "PLUGIN_FAILED",
+			expectedSeverity: outcomes.SeverityError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			outcome := test.result.outcome("plugin", "acme.check")
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.Equal(test.expectedCode, outcome.GetErrorCode())
+			assert.Equal(test.expectedSeverity, outcome.GetSeverity())
+		})
+	}
+}
+
+func TestLoadInvalidPlugin(t *testing.T) {
+	tests := []struct {
+		name   string
+		plugin string
+	}{
+		{
+			name:   "plugin fails to describe its checks",
+			plugin: "#!/bin/sh\nexit 1\n",
+		},
+		{
+			name:   "plugin prints an invalid description",
+			plugin: "#!/bin/sh\necho checks\n",
+		},
+		{
+			name:   "plugin describes a check without an ID",
+			plugin: "#!/bin/sh\necho '{\"checks\": [{\"doc\": \"no ID\"}]}'\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			loaded = nil
+			defer func() { loaded = nil }()
+			assert.ErrorIs(Load(context.TODO(), writePlugin(t, test.plugin)), ErrPluginInvalid)
+		})
+	}
+}
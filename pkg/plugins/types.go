@@ -0,0 +1,101 @@
+package plugins
+
+// Description is what a plugin prints when run with the describe argument: the checks it provides.
+//
+// Example:
+//
+//	{
+//	  "checks": [
+//	    {
+//	      "id": "acme.required-labels",
+//	      "description": "Checking whether the pods have the labels required by the platform team",
+//	      "doc": "Pods have the team and cost-center labels",
+//	      "tags": ["k8s", "acme"]
+//	    }
+//	  ]
+//	}
+type Description struct {
+	Checks []CheckDescription `json:"checks"`
+}
+
+// CheckDescription describes a check provided by a plugin.
+type CheckDescription struct {
+	// ID identifies the check, such as acme.required-labels. It must not be the ID of a built-in check.
+	ID string `json:"id"`
+
+	// Description is printed with the outcome of the check, like the description of built-in checks.
+	Description string `json:"description"`
+
+	// Doc describes what the check verifies, and is printed by osm-health checks list.
+	Doc string `json:"doc"`
+
+	// Tags select the check with --tags; plugin checks are also tagged plugin.
+	Tags []string `json:"tags,omitempty"`
+
+	// Suggestion tells how to fix the issue when the check fails.
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// Target is what a plugin check is run against, written as JSON to the standard input of the plugin when it is run
+// with the arguments run and the ID of the check.
+type Target struct {
+	// Command is the osm-health command running the check, such as "connectivity pod-to-pod".
+	Command string `json:"command"`
+
+	// OSMNamespace is the namespace of the OSM control plane.
+	OSMNamespace string `json:"osmNamespace"`
+
+	// MeshName is the name of the mesh, when the command knows it.
+	MeshName string `json:"meshName,omitempty"`
+
+	// Pods holds the pods the command checks, if any.
+	Pods []Pod `json:"pods,omitempty"`
+
+	// URL is the destination URL checked by the command, if any.
+	URL string `json:"url,omitempty"`
+}
+
+// Pod is a pod checked by a command.
+type Pod struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+
+	// Role is the role of the pod in the command: source or destination.
+	Role string `json:"role,omitempty"`
+}
+
+const (
+	// RoleSource is the role of the source pod of a connectivity command.
+	RoleSource = "source"
+
+	// RoleDestination is the role of the destination pod of a connectivity or ingress command.
+	RoleDestination = "destination"
+)
+
+// Result is what a plugin prints when it runs a check.
+//
+// Example:
+//
+//	{
+//	  "outcome": "fail",
+//	  "message": "pod bookstore/bookstore-v1 does not have the cost-center label",
+//	  "code": "ACME_LABEL_MISSING",
+//	  "severity": "warning",
+//	  "details": {"missingLabels": ["cost-center"]}
+//	}
+type Result struct {
+	// Outcome is the outcome of the check: pass, fail, warning, info, skipped or unknown.
+	Outcome string `json:"outcome"`
+
+	// Message is the error of a failed check, the reason of a skipped or unknown check, or the diagnostics otherwise.
+	Message string `json:"message,omitempty"`
+
+	// Code is the error code of a failed check, such as ACME_LABEL_MISSING.
+	Code string `json:"code,omitempty"`
+
+	// Severity overrides the severity of a failed check: info, warning, error or critical.
+	Severity string `json:"severity,omitempty"`
+
+	// Details holds machine-readable details of the outcome.
+	Details map[string]interface{} `json:"details,omitempty"`
+}
@@ -0,0 +1,285 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+// Change is how the outcome of a check changed between two runs.
+type Change string
+
+const (
+	// ChangeRegressed is the change of a check whose outcome became more severe, such as from pass to fail.
+	ChangeRegressed Change = "regressed"
+
+	// ChangeImproved is the change of a check whose outcome became less severe, such as from fail to pass.
+	ChangeImproved Change = "improved"
+
+	// ChangeChanged is the change of a check whose outcome or error code changed at the same severity.
+	ChangeChanged Change = "changed"
+
+	// ChangeAdded is the change of a check that only ran in the second run.
+	ChangeAdded Change = "added"
+
+	// ChangeRemoved is the change of a check that only ran in the first run.
+	ChangeRemoved Change = "removed"
+)
+
+// changeOrder is the order in which the changes of checks are printed, from the most to the least relevant.
+var changeOrder = []Change{ChangeRegressed, ChangeImproved, ChangeChanged, ChangeAdded, ChangeRemoved}
+
+// CheckChange is the change of the outcome of a check between two runs.
+type CheckChange struct {
+	Change  Change
+	Command string
+
+	// Before is the outcome of the first run, or nil when the check was added.
+	Before *CheckResult
+
+	// After is the outcome of the second run, or nil when the check was removed.
+	After *CheckResult
+}
+
+// ResultsDiff holds the checks whose outcomes changed between two runs.
+type ResultsDiff struct {
+	// Compared is the number of checks that ran in both runs.
+	Compared int
+
+	// Changes holds the changes in the order of changeOrder, then in the order the checks ran.
+	Changes []CheckChange
+}
+
+// Regressions returns the checks that regressed.
+func (diff ResultsDiff) Regressions() []CheckChange {
+	var regressions []CheckChange
+	for _, change := range diff.Changes {
+		if change.Change == ChangeRegressed {
+			regressions = append(regressions, change)
+		}
+	}
+	return regressions
+}
+
+// DiffResults compares the outcomes of the checks of two runs saved with --output json. When a file holds several runs
+// of a command, the latest one is compared. Checks are matched by command, ID or type, and description, so that the
+// same check run against different workloads is told apart. The generated names of pods in descriptions are matched
+// by the workload they belong to, so that runs from before and after a rollout can be compared.
+func DiffResults(before Results, after Results) ResultsDiff {
+	var diff ResultsDiff
+	beforeCommands, beforeOrder := latestCommands(before)
+	afterCommands, afterOrder := latestCommands(after)
+
+	for _, command := range afterOrder {
+		beforeChecks := map[string][]CheckResult{}
+		for _, check := range beforeCommands[command].Checks {
+			beforeChecks[checkKey(check)] = append(beforeChecks[checkKey(check)], check)
+		}
+
+		// Checks that ran several times with the same key are matched in the order they ran
+		matched := map[string]int{}
+		for idx := range afterCommands[command].Checks {
+			afterCheck := afterCommands[command].Checks[idx]
+			key := checkKey(afterCheck)
+			if matched[key] >= len(beforeChecks[key]) {
+				diff.Changes = append(diff.Changes, CheckChange{Change: ChangeAdded, Command: command, After: &afterCheck})
+				continue
+			}
+			beforeCheck := beforeChecks[key][matched[key]]
+			matched[key]++
+			diff.Compared++
+			if change, changed := compareChecks(beforeCheck, afterCheck); changed {
+				diff.Changes = append(diff.Changes, CheckChange{Change: change, Command: command, Before: &beforeCheck, After: &afterCheck})
+			}
+		}
+
+		seen := map[string]int{}
+		for idx := range beforeCommands[command].Checks {
+			beforeCheck := beforeCommands[command].Checks[idx]
+			key := checkKey(beforeCheck)
+			if seen[key]++; seen[key] > matched[key] {
+				diff.Changes = append(diff.Changes, CheckChange{Change: ChangeRemoved, Command: command, Before: &beforeCheck})
+			}
+		}
+	}
+	for _, command := range beforeOrder {
+		if _, ok := afterCommands[command]; ok {
+			continue
+		}
+		for idx := range beforeCommands[command].Checks {
+			beforeCheck := beforeCommands[command].Checks[idx]
+			diff.Changes = append(diff.Changes, CheckChange{Change: ChangeRemoved, Command: command, Before: &beforeCheck})
+		}
+	}
+
+	rank := map[Change]int{}
+	for idx, change := range changeOrder {
+		rank[change] = idx
+	}
+	sort.SliceStable(diff.Changes, func(i, j int) bool {
+		return rank[diff.Changes[i].Change] < rank[diff.Changes[j].Change]
+	})
+	return diff
+}
+
+// latestCommands returns the latest run of each command of results, and the commands in the order they first ran.
+func latestCommands(results Results) (map[string]CommandResult, []string) {
+	commands := map[string]CommandResult{}
+	var order []string
+	for _, command := range results.Commands {
+		if _, ok := commands[command.Command]; !ok {
+			order = append(order, command.Command)
+		}
+		commands[command.Command] = command
+	}
+	return commands, order
+}
+
+// generatedPodName matches the names Kubernetes generates for the pods of a workload: the workload name, the hash of
+// the pod template for pods of a ReplicaSet, and a random suffix, both drawn from the alphabet of k8s.io/apimachinery's
+// rand.SafeEncodeString, such as "bookstore-v1-5d8c9b7f4-xk2lp".
+var generatedPodName = regexp.MustCompile(`\b([a-z0-9][-a-z0-9]*?)(-[bcdfghjklmnpqrstvwxz2456789]{6,10})?-[bcdfghjklmnpqrstvwxz2456789]{5}\b`)
+
+// checkKey identifies a check within the outcomes of a command, regardless of the generated names of the pods it ran against.
+func checkKey(check CheckResult) string {
+	name := check.ID
+	if name == "" {
+		name = check.Type
+	}
+	return name + "\x00" + generatedPodName.ReplaceAllString(check.Description, "$1-*")
+}
+
+// compareChecks returns how the outcome of a check changed, and whether it changed at all.
+func compareChecks(before CheckResult, after CheckResult) (Change, bool) {
+	beforeSeverity, _ := outcomes.ParseSeverity(before.Severity)
+	afterSeverity, _ := outcomes.ParseSeverity(after.Severity)
+	switch {
+	case afterSeverity > beforeSeverity:
+		return ChangeRegressed, true
+	case afterSeverity < beforeSeverity:
+		return ChangeImproved, true
+	case after.Outcome != before.Outcome || after.ErrorCode != before.ErrorCode:
+		return ChangeChanged, true
+	default:
+		return "", false
+	}
+}
+
+// PrintResultsDiff prints the checks whose outcomes changed between two runs, regressions first.
+func PrintResultsDiff(diff ResultsDiff) {
+	if err := writeResultsDiff(os.Stdout, diff); err != nil {
+		log.Error().Err(err)
+	}
+}
+
+func writeResultsDiff(out io.Writer, diff ResultsDiff) error {
+	w := new(tabwriter.Writer)
+	w.Init(out, 4, 4, 0, ' ', 0)
+
+	counts := map[Change]int{}
+	for idx, change := range diff.Changes {
+		counts[change.Change]++
+		check := change.After
+		if check == nil {
+			check = change.Before
+		}
+		label := string(change.Change)
+		if change.Change == ChangeRegressed {
+			label = color.RedString(label)
+		} else if change.Change == ChangeImproved {
+			label = color.GreenString(label)
+		}
+		if _, err := fmt.Fprintf(w, "%d\t%s\t\t%s\t%s\n", idx+1, label, change.Command, check.Description); err != nil {
+			return err
+		}
+		if change.Before != nil {
+			if _, err := fmt.Fprintln(w, "---> Before:", formatCheckResult(*change.Before)); err != nil {
+				return err
+			}
+		}
+		if change.After != nil {
+			if _, err := fmt.Fprintln(w, "---> After:", formatCheckResult(*change.After)); err != nil {
+				return err
+			}
+		}
+	}
+
+	summary := fmt.Sprintf("\nCompared %d checks. %d checks regressed. %d checks improved.", diff.Compared, counts[ChangeRegressed], counts[ChangeImproved])
+	if counts[ChangeChanged] > 0 {
+		summary += fmt.Sprintf(" %d checks changed.", counts[ChangeChanged])
+	}
+	if counts[ChangeAdded] > 0 {
+		summary += fmt.Sprintf(" %d checks added.", counts[ChangeAdded])
+	}
+	if counts[ChangeRemoved] > 0 {
+		summary += fmt.Sprintf(" %d checks removed.", counts[ChangeRemoved])
+	}
+	if _, err := fmt.Fprintln(w, summary); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// formatCheckResult formats the outcome of a check, such as "fail [ENVOY_FILTER_CHAIN_MISSING]: Envoy filter chain missing".
+func formatCheckResult(check CheckResult) string {
+	formatted := check.Outcome
+	if check.ErrorCode != "" {
+		formatted += fmt.Sprintf(" [%s]", check.ErrorCode)
+	}
+	if check.Error != "" {
+		formatted += ": " + check.Error
+	} else if check.Diagnostics != "" {
+		formatted += ": " + check.Diagnostics
+	}
+	return formatted
+}
+
+// Difference holds the entries of a kind of config that only one of two sides has.
+type Difference struct {
+	Kind      string
+	OnlyLeft  []string
+	OnlyRight []string
+}
+
+// PrintDifferences prints what each of two sides, such as the Envoy configs of two pods, has that the other lacks.
+func PrintDifferences(left string, right string, differences ...Difference) {
+	if err := writeDifferences(os.Stdout, left, right, differences...); err != nil {
+		log.Error().Err(err)
+	}
+}
+
+func writeDifferences(out io.Writer, left string, right string, differences ...Difference) error {
+	w := new(tabwriter.Writer)
+	w.Init(out, 4, 4, 0, ' ', 0)
+
+	count := 0
+	for idx, difference := range differences {
+		if _, err := fmt.Fprintf(w, "%d\t%s\n", idx+1, difference.Kind); err != nil {
+			return err
+		}
+		for _, entry := range difference.OnlyLeft {
+			if _, err := fmt.Fprintf(w, "---> %s %s\n", color.RedString("only in "+left+":"), entry); err != nil {
+				return err
+			}
+		}
+		for _, entry := range difference.OnlyRight {
+			if _, err := fmt.Fprintf(w, "---> %s %s\n", color.GreenString("only in "+right+":"), entry); err != nil {
+				return err
+			}
+		}
+		count += len(difference.OnlyLeft) + len(difference.OnlyRight)
+	}
+
+	summary := fmt.Sprintf("\nFound %d differences between %s and %s.", count, left, right)
+	if _, err := fmt.Fprintln(w, summary); err != nil {
+		return err
+	}
+	return w.Flush()
+}
@@ -0,0 +1,129 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fatih/color"
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestDiffResults(t *testing.T) {
+	assert := tassert.New(t)
+
+	listener := CheckResult{ID: "envoy.listener", Description: "Checking the listener of bookbuyer", Outcome: "pass", Severity: "none"}
+	failedListener := CheckResult{ID: "envoy.listener", Description: "Checking the listener of bookbuyer", Outcome: "fail", Severity: "error", ErrorCode: "ENVOY_LISTENER_MISSING"}
+	cluster := CheckResult{ID: "envoy.cluster", Description: "Checking the clusters of bookbuyer", Outcome: "fail", Severity: "error", ErrorCode: "ENVOY_CLUSTER_MISSING"}
+	fixedCluster := CheckResult{ID: "envoy.cluster", Description: "Checking the clusters of bookbuyer", Outcome: "pass", Severity: "none"}
+	version := CheckResult{Type: "ControllerVersionCheck", Description: "Checking the OSM version", Outcome: "info", Severity: "info"}
+	unknownVersion := CheckResult{Type: "ControllerVersionCheck", Description: "Checking the OSM version", Outcome: "info", Severity: "info", ErrorCode: "UNKNOWN_VERSION"}
+	secret := CheckResult{ID: "envoy.secret", Description: "Checking the secrets of bookbuyer", Outcome: "pass", Severity: "none"}
+	trafficTarget := CheckResult{ID: "smi.traffictarget", Description: "Checking whether there is a TrafficTarget", Outcome: "pass", Severity: "none"}
+	status := CheckResult{ID: "control-plane.pods", Description: "Checking the control plane pods", Outcome: "pass", Severity: "none"}
+
+	before := Results{Commands: []CommandResult{
+		{Command: "connectivity pod-to-pod", Checks: []CheckResult{failedListener}},
+		{Command: "control-plane status", Checks: []CheckResult{status}},
+		// The latest run of a command is compared
+		{Command: "connectivity pod-to-pod", Checks: []CheckResult{listener, cluster, version, secret, secret}},
+	}}
+	after := Results{Commands: []CommandResult{
+		{Command: "connectivity pod-to-pod", Checks: []CheckResult{failedListener, fixedCluster, unknownVersion, secret, trafficTarget}},
+	}}
+
+	diff := DiffResults(before, after)
+	assert.Equal(4, diff.Compared)
+	var changes []Change
+	for _, change := range diff.Changes {
+		changes = append(changes, change.Change)
+	}
+	assert.Equal([]Change{ChangeRegressed, ChangeImproved, ChangeChanged, ChangeAdded, ChangeRemoved, ChangeRemoved}, changes)
+
+	regressions := diff.Regressions()
+	assert.Len(regressions, 1)
+	assert.Equal("connectivity pod-to-pod", regressions[0].Command)
+	assert.Equal(listener, *regressions[0].Before)
+	assert.Equal(failedListener, *regressions[0].After)
+
+	added := diff.Changes[3]
+	assert.Nil(added.Before)
+	assert.Equal(trafficTarget, *added.After)
+
+	removedSecret := diff.Changes[4]
+	assert.Equal(secret, *removedSecret.Before)
+	assert.Nil(removedSecret.After)
+
+	removedCommand := diff.Changes[5]
+	assert.Equal("control-plane status", removedCommand.Command)
+
+	assert.Empty(DiffResults(after, after).Changes)
+}
+
+func TestDiffResultsAcrossRollout(t *testing.T) {
+	assert := tassert.New(t)
+
+	before := Results{Commands: []CommandResult{{Command: "connectivity pod-to-pod", Checks: []CheckResult{
+		{ID: "envoy.listener", Description: "Checking the listener of bookbuyer/bookbuyer-7d9c5f8b6-xk2lp", Outcome: "pass", Severity: "none"},
+		{ID: "envoy.cluster", Description: "Checking the clusters of bookstore/bookstore-v1-5d8c9b7f4-q9w2z", Outcome: "pass", Severity: "none"},
+		{ID: "pod.ready", Description: "Checking whether pod bookwarehouse/bookwarehouse-0 is ready", Outcome: "pass", Severity: "none"},
+	}}}}
+	after := Results{Commands: []CommandResult{{Command: "connectivity pod-to-pod", Checks: []CheckResult{
+		{ID: "envoy.listener", Description: "Checking the listener of bookbuyer/bookbuyer-6b4f7c9d8-m5n7t", Outcome: "pass", Severity: "none"},
+		{ID: "envoy.cluster", Description: "Checking the clusters of bookstore/bookstore-v1-74c6d9f5b-p8r4s", Outcome: "fail", Severity: "error", ErrorCode: "ENVOY_CLUSTER_MISSING"},
+		{ID: "pod.ready", Description: "Checking whether pod bookwarehouse/bookwarehouse-1 is ready", Outcome: "pass", Severity: "none"},
+	}}}}
+
+	diff := DiffResults(before, after)
+	assert.Equal(2, diff.Compared)
+	var changes []Change
+	for _, change := range diff.Changes {
+		changes = append(changes, change.Change)
+	}
+	// StatefulSet pods have stable names, so another ordinal is another pod
+	assert.Equal([]Change{ChangeRegressed, ChangeAdded, ChangeRemoved}, changes)
+	assert.Equal("envoy.cluster", diff.Changes[0].After.ID)
+}
+
+func TestWriteResultsDiff(t *testing.T) {
+	assert := tassert.New(t)
+	color.NoColor = true
+
+	diff := ResultsDiff{
+		Compared: 2,
+		Changes: []CheckChange{
+			{
+				Change:  ChangeRegressed,
+				Command: "connectivity pod-to-pod",
+				Before:  &CheckResult{Description: "Checking the listener of bookbuyer", Outcome: "pass"},
+				After:   &CheckResult{Description: "Checking the listener of bookbuyer", Outcome: "fail", ErrorCode: "ENVOY_LISTENER_MISSING", Error: "envoy listener missing"},
+			},
+			{
+				Change:  ChangeAdded,
+				Command: "connectivity pod-to-pod",
+				After:   &CheckResult{Description: "Checking whether there is a TrafficTarget", Outcome: "skipped", Diagnostics: "OSM is in permissive traffic policy mode"},
+			},
+		},
+	}
+
+	var out bytes.Buffer
+	assert.Nil(writeResultsDiff(&out, diff))
+	assert.Contains(out.String(), "regressed")
+	assert.Contains(out.String(), "---> Before: pass\n")
+	assert.Contains(out.String(), "---> After: fail [ENVOY_LISTENER_MISSING]: envoy listener missing\n")
+	assert.Contains(out.String(), "---> After: skipped: OSM is in permissive traffic policy mode\n")
+	assert.Contains(out.String(), "Compared 2 checks. 1 checks regressed. 0 checks improved. 1 checks added.")
+}
+
+func TestWriteDifferences(t *testing.T) {
+	assert := tassert.New(t)
+	color.NoColor = true
+
+	var out bytes.Buffer
+	assert.Nil(writeDifferences(&out, "bookstore/bookstore-v1-a", "bookstore/bookstore-v1-b",
+		Difference{Kind: "clusters", OnlyLeft: []string{"bookwarehouse/bookwarehouse"}},
+		Difference{Kind: "endpoints", OnlyLeft: []string{"10.244.2.5:14001"}, OnlyRight: []string{"10.244.2.6:14001"}},
+	))
+	assert.Contains(out.String(), "---> only in bookstore/bookstore-v1-a: bookwarehouse/bookwarehouse\n")
+	assert.Contains(out.String(), "---> only in bookstore/bookstore-v1-b: 10.244.2.6:14001\n")
+	assert.Contains(out.String(), "Found 3 differences between bookstore/bookstore-v1-a and bookstore/bookstore-v1-b.")
+}
@@ -0,0 +1,11 @@
+package printer
+
+import "errors"
+
+var (
+	// ErrUnknownReportFormat is returned when a report format is not one osm-health can write.
+	ErrUnknownReportFormat = errors.New("unknown report format")
+
+	// ErrUnknownOutputFormat is returned when an output format is not one osm-health can write.
+	ErrUnknownOutputFormat = errors.New("unknown output format")
+)
@@ -0,0 +1,217 @@
+package printer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+const (
+	// junitSuitePrefix prefixes the name of the testsuite of a command.
+	junitSuitePrefix = "osm-health "
+
+	// junitClassPrefix prefixes the class name of the testcase of a check.
+	junitClassPrefix = "osm-health."
+)
+
+// junitTestSuites is the root element of a JUnit XML file.
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite holds the outcomes of the checks of a command.
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Time       string          `xml:"time,attr"`
+	Timestamp  string          `xml:"timestamp,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	TestCases  []junitTestCase `xml:"testcase"`
+	SystemOut  string          `xml:"system-out,omitempty"`
+}
+
+// junitProperty describes the mesh the checks of a command ran against.
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// junitTestCase holds the outcome of a check.
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+// junitProblem is the failure of a check, or the error of a check that did not complete.
+type junitProblem struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// junitSkipped is the reason a check was skipped.
+type junitSkipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+// appendJUnit appends the testsuite of a report to the JUnit results held by a file, or creates the file.
+func appendJUnit(path string, report Report) error {
+	var suites junitTestSuites
+	content, err := ioutil.ReadFile(path) // #nosec G304 -- the path is supplied by the user running osm-health
+	switch {
+	case err == nil:
+		if err := xml.Unmarshal(content, &suites); err != nil {
+			return errors.Wrapf(err, "unable to append to %s, which does not hold JUnit results", path)
+		}
+	case !os.IsNotExist(err):
+		return errors.Wrapf(err, "unable to read %s", path)
+	}
+	suites.TestSuites = append(suites.TestSuites, newJUnitTestSuite(report, time.Now()))
+
+	f, err := os.Create(path) // #nosec G304 -- the path is supplied by the user running osm-health
+	if err != nil {
+		return errors.Wrapf(err, "unable to create %s", path)
+	}
+	if err := writeJUnit(f, suites); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// WriteJUnit writes the outcomes of a command as JUnit XML, with the command as a testsuite and each check as a
+// testcase.
+func WriteJUnit(w io.Writer, report Report) error {
+	return writeJUnit(w, junitTestSuites{TestSuites: []junitTestSuite{newJUnitTestSuite(report, time.Now())}})
+}
+
+func writeJUnit(w io.Writer, suites junitTestSuites) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return errors.Wrap(err, "unable to encode the JUnit results")
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// newJUnitTestSuite returns the testsuite of the outcomes of a command. Failed checks are failures and checks that
+// timed out are errors. Skipped checks are skipped, and the diagnostics of the other checks are their system-out.
+func newJUnitTestSuite(report Report, timestamp time.Time) junitTestSuite {
+	suite := junitTestSuite{
+		Name:      junitSuitePrefix + report.Command,
+		Tests:     len(report.Printables),
+		Timestamp: timestamp.UTC().Format("2006-01-02T15:04:05"),
+	}
+	if mesh := report.Mesh; mesh != nil && mesh.Namespace != "" {
+		suite.Properties = []junitProperty{
+			{Name: "mesh", Value: mesh.Name.String()},
+			{Name: "osmNamespace", Value: mesh.Namespace.String()},
+			{Name: "osmVersion", Value: string(mesh.DetectedOSMVersion)},
+		}
+	}
+
+	var total time.Duration
+	for _, printable := range report.Printables {
+		total += printable.Duration
+		testCase := newJUnitTestCase(printable)
+		switch {
+		case testCase.Failure != nil:
+			suite.Failures++
+		case testCase.Error != nil:
+			suite.Errors++
+		case testCase.Skipped != nil:
+			suite.Skipped++
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+	suite.Time = formatSeconds(total)
+
+	var diagnoses []string
+	for _, d := range report.Diagnoses {
+		diagnosis := fmt.Sprintf("Diagnosis: %s (confidence %.0f%%)", d.Title, d.Confidence*100)
+		for _, cause := range d.Causes {
+			diagnosis += fmt.Sprintf("\n  Likely cause (%.0f%%): %s", cause.Confidence*100, cause.Cause.Cause)
+			if cause.Suggestion != "" {
+				diagnosis += fmt.Sprintf("\n    Suggestion: %s", cause.Suggestion)
+			}
+		}
+		diagnoses = append(diagnoses, diagnosis)
+	}
+	suite.SystemOut = strings.Join(diagnoses, "\n")
+	return suite
+}
+
+// newJUnitTestCase returns the testcase of the outcome of a check.
+func newJUnitTestCase(printable common.Printable) junitTestCase {
+	className := junitClassPrefix + printable.CheckType
+	if printable.CheckID != "" {
+		className = junitClassPrefix + printable.CheckID
+	}
+	testCase := junitTestCase{
+		Name:      printable.CheckDescription,
+		ClassName: className,
+		Time:      formatSeconds(printable.Duration),
+	}
+
+	switch printable.Outcome.(type) {
+	case outcomes.Fail:
+		testCase.Failure = newJUnitProblem(printable)
+	case outcomes.Timeout:
+		testCase.Error = newJUnitProblem(printable)
+	case outcomes.Skipped:
+		testCase.Skipped = &junitSkipped{Message: printable.Diagnostics}
+	default:
+		if printable.Diagnostics != "" {
+			testCase.SystemOut = fmt.Sprintf("%s: %s", outcomeName(printable.Outcome), printable.Diagnostics)
+		}
+	}
+	return testCase
+}
+
+// newJUnitProblem returns the failure or error of a check, with its suggestion and details.
+func newJUnitProblem(printable common.Printable) *junitProblem {
+	problem := &junitProblem{Message: outcomeName(printable.Outcome)}
+	if printable.Error != nil {
+		problem.Message = printable.Error.Error()
+	}
+	if printable.ErrorCode != outcomes.NoErrorCode && printable.ErrorCode != outcomes.ErrorCodeUnknown {
+		problem.Type = string(printable.ErrorCode)
+	}
+
+	lines := []string{problem.Message}
+	if printable.Suggestion != "" {
+		lines = append(lines, "Suggestion: "+printable.Suggestion)
+	}
+	if len(printable.Details) > 0 {
+		lines = append(lines, "Details: "+formatDetails(printable.Details))
+	}
+	problem.Text = strings.Join(lines, "\n")
+	return problem
+}
+
+// formatSeconds formats a duration as the seconds of JUnit times.
+func formatSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
@@ -0,0 +1,113 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+func TestWriteJUnit(t *testing.T) {
+	assert := tassert.New(t)
+
+	report := testReport
+	report.Printables = append(report.Printables,
+		common.Printable{
+			CheckType:        "MeshConfigCheck",
+			CheckDescription: "Checking whether the MeshConfig exists",
+			Error:            outcomes.NewError("CHECK_TIMED_OUT", "check timed out"),
+			ErrorCode:        "CHECK_TIMED_OUT",
+			Duration:         30 * time.Second,
+			Outcome:          outcomes.Timeout{},
+		},
+		common.Printable{
+			CheckID:          "osm.version",
+			CheckDescription: "Checking whether the OSM version is known",
+			Diagnostics:      "OSM v0.9 is known",
+			Duration:         1500 * time.Millisecond,
+			Outcome:          outcomes.Info{},
+		},
+	)
+
+	var out bytes.Buffer
+	assert.Nil(WriteJUnit(&out, report))
+	assert.Contains(out.String(), xml.Header)
+
+	var suites junitTestSuites
+	assert.Nil(xml.Unmarshal(out.Bytes(), &suites))
+	assert.Len(suites.TestSuites, 1)
+
+	suite := suites.TestSuites[0]
+	assert.Equal("osm-health connectivity pod-to-pod", suite.Name)
+	assert.Equal(6, suite.Tests)
+	assert.Equal(2, suite.Failures)
+	assert.Equal(1, suite.Errors)
+	assert.Equal(1, suite.Skipped)
+	assert.Equal("31.500", suite.Time)
+	assert.Contains(suite.Properties, junitProperty{Name: "osmNamespace", Value: "osm-system"})
+	assert.Contains(suite.SystemOut, "Diagnosis: The source is not allowed to reach the destination (confidence 50%)")
+	assert.Len(suite.TestCases, 6)
+
+	failed := suite.TestCases[0]
+	assert.Equal("osm-health.envoy.filter-chain", failed.ClassName)
+	assert.Equal("Envoy filter chain missing", failed.Failure.Message)
+	assert.Equal("ENVOY_FILTER_CHAIN_MISSING", failed.Failure.Type)
+	assert.Equal("Envoy filter chain missing\nSuggestion: Check the TrafficTargets <from> bookbuyer", failed.Failure.Text)
+
+	passed := suite.TestCases[1]
+	assert.Nil(passed.Failure)
+	assert.Nil(passed.Error)
+	assert.Nil(passed.Skipped)
+
+	skipped := suite.TestCases[2]
+	assert.Equal("OSM is in permissive traffic policy modes", skipped.Skipped.Message)
+
+	unregistered := suite.TestCases[3]
+	assert.Equal("plugin failed", unregistered.Failure.Message)
+	assert.Empty(unregistered.Failure.Type)
+
+	timedOut := suite.TestCases[4]
+	assert.Equal("osm-health.MeshConfigCheck", timedOut.ClassName)
+	assert.Equal("CHECK_TIMED_OUT", timedOut.Error.Type)
+	assert.Equal("30.000", timedOut.Time)
+
+	info := suite.TestCases[5]
+	assert.Equal("Info: OSM v0.9 is known", info.SystemOut)
+}
+
+func TestSaveOutputAppends(t *testing.T) {
+	assert := tassert.New(t)
+	defer func() { recorded = nil }()
+	path := filepath.Join(t.TempDir(), "junit.xml")
+
+	recorded = nil
+	saved, err := SaveOutput(OutputFormatJUnit, path)
+	assert.Nil(err)
+	assert.False(saved)
+
+	Record(testReport)
+	saved, err = SaveOutput(OutputFormatJUnit, path)
+	assert.Nil(err)
+	assert.True(saved)
+
+	Record(Report{Command: "control-plane status"})
+	saved, err = SaveOutput(OutputFormatJUnit, path)
+	assert.Nil(err)
+	assert.True(saved)
+
+	var suites junitTestSuites
+	content, err := ioutil.ReadFile(path)
+	assert.Nil(err)
+	assert.Nil(xml.Unmarshal(content, &suites))
+	assert.Len(suites.TestSuites, 2)
+	assert.Equal("osm-health connectivity pod-to-pod", suites.TestSuites[0].Name)
+	assert.Len(suites.TestSuites[0].TestCases, 4)
+	assert.Equal("osm-health control-plane status", suites.TestSuites[1].Name)
+}
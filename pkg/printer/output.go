@@ -0,0 +1,53 @@
+package printer
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// OutputFormat is a machine-readable format of the outcomes of a command.
+type OutputFormat string
+
+const (
+	// OutputFormatJUnit is the JUnit XML format understood by CI systems.
+	OutputFormatJUnit OutputFormat = "junit"
+
+	// OutputFormatJSON is the JSON format of Results, which 'osm-health diff' compares.
+	OutputFormatJSON OutputFormat = "json"
+)
+
+// ParseOutputFormat returns the output format with the given name.
+func ParseOutputFormat(name string) (OutputFormat, error) {
+	switch format := OutputFormat(strings.ToLower(name)); format {
+	case OutputFormatJUnit, OutputFormatJSON:
+		return format, nil
+	default:
+		return "", errors.Wrapf(ErrUnknownOutputFormat, "%q, expected %s or %s", name, OutputFormatJUnit, OutputFormatJSON)
+	}
+}
+
+// DefaultOutputPath returns the path the output in the given format is saved to when none is given.
+func DefaultOutputPath(format OutputFormat) string {
+	if format == OutputFormatJSON {
+		return "osm-health-results.json"
+	}
+	return "osm-health-junit.xml"
+}
+
+// SaveOutput writes the outcomes recorded by the command that ran to a file in the given format, and returns whether
+// there were outcomes to write. When the file already holds results in that format, the outcomes of the command are
+// appended to them, so that several commands run in a CI pipeline report to the same file.
+func SaveOutput(format OutputFormat, path string) (bool, error) {
+	if recorded == nil {
+		return false, nil
+	}
+	switch format {
+	case OutputFormatJUnit:
+		return true, appendJUnit(path, *recorded)
+	case OutputFormatJSON:
+		return true, appendResults(path, *recorded)
+	default:
+		return false, errors.Wrapf(ErrUnknownOutputFormat, "%q", format)
+	}
+}
@@ -0,0 +1,113 @@
+package printer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+// replicaColumn is a column of the table printed by PrintReplicas, holding the most severe outcome of the checks with
+// the given IDs.
+type replicaColumn struct {
+	title    string
+	checkIDs []string
+}
+
+// replicaColumns are the columns of the table printed by PrintReplicas.
+var replicaColumns = []replicaColumn{
+	{title: "SOURCE EDS", checkIDs: []string{"envoy.endpoints"}},
+	{title: "INBOUND LISTENER", checkIDs: []string{"envoy.listener"}},
+	{title: "CERTIFICATES", checkIDs: []string{"envoy.certificate", "envoy.certificate-age"}},
+}
+
+// Replica holds the outcomes of the checks run against a replica of the destination of a command.
+type Replica struct {
+	// Pod is the namespaced name of the replica.
+	Pod string
+
+	// IP is the IP address of the replica.
+	IP string
+
+	Printables []common.Printable
+}
+
+// PrintReplicas prints a table of the replicas of a destination, with whether each is an endpoint of the source Envoy,
+// has an inbound listener and has current certificates, followed by the replicas that failed.
+func PrintReplicas(replicas ...Replica) {
+	if err := writeReplicas(os.Stdout, replicas...); err != nil {
+		log.Error().Err(err)
+	}
+}
+
+func writeReplicas(out io.Writer, replicas ...Replica) error {
+	w := new(tabwriter.Writer)
+	w.Init(out, 4, 4, 2, ' ', 0)
+
+	titles := []string{"REPLICA", "IP"}
+	for _, column := range replicaColumns {
+		titles = append(titles, column.title)
+	}
+	if _, err := fmt.Fprintf(w, "\nReplicas:\n%s\n", strings.Join(titles, "\t")); err != nil {
+		return err
+	}
+
+	var failed []string
+	for _, replica := range replicas {
+		cells := []string{replica.Pod, replica.IP}
+		for _, column := range replicaColumns {
+			cells = append(cells, formatReplicaCell(column, replica.Printables))
+		}
+		if _, err := fmt.Fprintln(w, strings.Join(cells, "\t")); err != nil {
+			return err
+		}
+		for _, printable := range replica.Printables {
+			if _, ok := printable.Outcome.(outcomes.Fail); ok {
+				failed = append(failed, replica.Pod)
+				break
+			}
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	summary := fmt.Sprintf("\nChecked %d replicas. %d replicas failed checks.", len(replicas), len(failed))
+	if len(failed) > 0 {
+		summary += fmt.Sprintf(" Failed replicas: %s", strings.Join(failed, ", "))
+	}
+	_, err := fmt.Fprintln(out, summary)
+	return err
+}
+
+// formatReplicaCell formats the most severe outcome of the checks of a column, with its error code, or "-" when none
+// of the checks ran.
+func formatReplicaCell(column replicaColumn, printables []common.Printable) string {
+	var worst *common.Printable
+	for idx := range printables {
+		printable := &printables[idx]
+		for _, checkID := range column.checkIDs {
+			if printable.CheckID == checkID && (worst == nil || printable.Severity > worst.Severity) {
+				worst = printable
+			}
+		}
+	}
+	if worst == nil {
+		return "-"
+	}
+
+	cell := outcomeName(worst.Outcome)
+	if worst.ErrorCode != outcomes.NoErrorCode && worst.ErrorCode != outcomes.ErrorCodeUnknown {
+		cell += fmt.Sprintf(" [%s]", worst.ErrorCode)
+	}
+	if _, ok := worst.Outcome.(outcomes.Fail); ok {
+		return color.RedString(cell)
+	}
+	return cell
+}
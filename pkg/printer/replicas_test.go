@@ -0,0 +1,47 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fatih/color"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+func TestWriteReplicas(t *testing.T) {
+	assert := tassert.New(t)
+	color.NoColor = true
+
+	replicas := []Replica{
+		{
+			Pod: "bookstore/bookstore-v1-a",
+			IP:  "10.244.2.5",
+			Printables: []common.Printable{
+				{CheckID: "envoy.endpoints", Outcome: outcomes.Pass{}},
+				{CheckID: "envoy.listener", Outcome: outcomes.Pass{}},
+				{CheckID: "envoy.certificate", Outcome: outcomes.Pass{}},
+				{CheckID: "envoy.certificate", Outcome: outcomes.Pass{}},
+				{CheckID: "envoy.certificate-age", Outcome: outcomes.Pass{}},
+			},
+		},
+		{
+			Pod: "bookstore/bookstore-v1-b",
+			IP:  "10.244.2.6",
+			Printables: []common.Printable{
+				{CheckID: "envoy.endpoints", Severity: outcomes.SeverityError, ErrorCode: "ENDPOINT_NOT_FOUND", Outcome: outcomes.Fail{}},
+				{CheckID: "envoy.certificate", Outcome: outcomes.Pass{}},
+				{CheckID: "envoy.certificate-age", Severity: outcomes.SeverityError, ErrorCode: "ENVOY_SERVICE_CERTIFICATE_STALE", Outcome: outcomes.Fail{}},
+			},
+		},
+	}
+
+	var out bytes.Buffer
+	assert.Nil(writeReplicas(&out, replicas...))
+	assert.Contains(out.String(), "REPLICA                   IP          SOURCE EDS                 INBOUND LISTENER  CERTIFICATES\n")
+	assert.Contains(out.String(), "bookstore/bookstore-v1-a  10.244.2.5  Pass                       Pass              Pass\n")
+	assert.Contains(out.String(), "bookstore/bookstore-v1-b  10.244.2.6  Fail [ENDPOINT_NOT_FOUND]  -                 Fail [ENVOY_SERVICE_CERTIFICATE_STALE]\n")
+	assert.Contains(out.String(), "Checked 2 replicas. 1 replicas failed checks. Failed replicas: bookstore/bookstore-v1-b")
+}
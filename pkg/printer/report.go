@@ -0,0 +1,255 @@
+package printer
+
+import (
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"os"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/diagnosis"
+	"github.com/openservicemesh/osm-health/pkg/osm/utils"
+)
+
+// ReportFormat is the format of a report.
+type ReportFormat string
+
+const (
+	// ReportFormatHTML is a self-contained HTML page.
+	ReportFormatHTML ReportFormat = "html"
+
+	// ReportFormatMarkdown is a Markdown document, with expandable sections as HTML supported by GitHub.
+	ReportFormatMarkdown ReportFormat = "markdown"
+
+	// uncategorized is the category of the checks that are not registered.
+	uncategorized = "other"
+)
+
+//go:embed templates/report.*.tmpl
+var reportTemplates embed.FS
+
+// reportFuncs are the functions available to the report templates.
+var reportFuncs = map[string]interface{}{
+	"percent": func(confidence float64) string {
+		return fmt.Sprintf("%.0f%%", confidence*100)
+	},
+}
+
+// Report is the outcome of a command, written by WriteReport for support engineers to attach to incidents and by
+// WriteJUnit for CI pipelines.
+type Report struct {
+	// Command is the command that ran the checks, such as "connectivity pod-to-pod".
+	Command string
+
+	// Mesh describes the mesh the checks ran against, or is nil when it is unknown.
+	Mesh *utils.MeshInfo
+
+	// Printables holds the outcomes of the checks, as printed by Print.
+	Printables []common.Printable
+
+	// Diagnoses holds the diagnoses of the outcomes, as printed by PrintDiagnoses.
+	Diagnoses []diagnosis.Diagnosis
+}
+
+// recorded holds the report of the command that ran, if any.
+var recorded *Report
+
+// Record keeps the report of the command that ran, so that it can be saved with SaveReport or SaveOutput once the
+// command completes.
+func Record(report Report) {
+	recorded = &report
+}
+
+// ParseReportFormat returns the report format with the given name.
+func ParseReportFormat(name string) (ReportFormat, error) {
+	switch format := ReportFormat(strings.ToLower(name)); format {
+	case ReportFormatHTML, ReportFormatMarkdown:
+		return format, nil
+	default:
+		return "", errors.Wrapf(ErrUnknownReportFormat, "%q, expected %s or %s", name, ReportFormatHTML, ReportFormatMarkdown)
+	}
+}
+
+// DefaultReportPath returns the path a report in the given format is saved to when none is given.
+func DefaultReportPath(format ReportFormat) string {
+	if format == ReportFormatMarkdown {
+		return "osm-health-report.md"
+	}
+	return "osm-health-report.html"
+}
+
+// SaveReport writes the report recorded by the command that ran to a file, and returns whether there was one to write.
+// Commands that do not run checks do not record a report.
+func SaveReport(format ReportFormat, path string) (bool, error) {
+	if recorded == nil {
+		return false, nil
+	}
+	f, err := os.Create(path) // #nosec G304 -- the path is supplied by the user running osm-health
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to create report %s", path)
+	}
+	if err := WriteReport(f, format, *recorded); err != nil {
+		_ = f.Close()
+		return false, err
+	}
+	return true, f.Close()
+}
+
+// WriteReport writes a report in the given format. The report is generated from the same outcomes as the table
+// printed by Print, grouped by the category of their checks, with the Envoy config relevant to each failure.
+func WriteReport(w io.Writer, format ReportFormat, report Report) error {
+	view := newReportView(report, time.Now())
+	switch format {
+	case ReportFormatHTML:
+		tmpl, err := htmltemplate.New("report.html.tmpl").Funcs(reportFuncs).ParseFS(reportTemplates, "templates/report.html.tmpl")
+		if err != nil {
+			return errors.Wrap(err, "unable to parse the HTML report template")
+		}
+		return tmpl.Execute(w, view)
+	case ReportFormatMarkdown:
+		tmpl, err := texttemplate.New("report.md.tmpl").Funcs(reportFuncs).ParseFS(reportTemplates, "templates/report.md.tmpl")
+		if err != nil {
+			return errors.Wrap(err, "unable to parse the Markdown report template")
+		}
+		return tmpl.Execute(w, view)
+	default:
+		return errors.Wrapf(ErrUnknownReportFormat, "%q", format)
+	}
+}
+
+// reportView is what the report templates render.
+type reportView struct {
+	Command     string
+	GeneratedAt string
+	Mesh        *utils.MeshInfo
+	Checks      int
+	Failed      int
+	Warnings    int
+	TimedOut    int
+	Skipped     int
+	Categories  []reportCategory
+	Diagnoses   []diagnosis.Diagnosis
+}
+
+// reportCategory groups the outcomes of the checks whose IDs share a prefix, such as envoy or smi.
+type reportCategory struct {
+	Name   string
+	Failed int
+	Checks []reportCheck
+}
+
+// reportCheck is the outcome of a check in a report.
+type reportCheck struct {
+	Index       int
+	ID          string
+	Description string
+	Outcome     string
+	Severity    string
+	Error       string
+	ErrorCode   string
+	Diagnostics string
+	Details     string
+	Suggestion  string
+	Snippets    []outcomes.Snippet
+}
+
+// Expandable returns whether the check has more to show than its outcome.
+func (check reportCheck) Expandable() bool {
+	return check.Diagnostics != "" || check.Details != "" || check.Suggestion != "" || len(check.Snippets) > 0
+}
+
+func newReportView(report Report, generatedAt time.Time) reportView {
+	view := reportView{
+		Command:     report.Command,
+		GeneratedAt: generatedAt.UTC().Format(time.RFC1123),
+		Mesh:        report.Mesh,
+		Checks:      len(report.Printables),
+		Diagnoses:   report.Diagnoses,
+	}
+	// Commands that can run without a mesh leave its MeshInfo empty when they do not find it
+	if view.Mesh != nil && view.Mesh.Namespace == "" {
+		view.Mesh = nil
+	}
+
+	categories := map[string]int{}
+	for idx, printable := range report.Printables {
+		check := reportCheck{
+			Index:       idx + 1,
+			ID:          printable.CheckID,
+			Description: printable.CheckDescription,
+			Outcome:     outcomeName(printable.Outcome),
+			Severity:    printable.Severity.String(),
+			Diagnostics: printable.Diagnostics,
+			Details:     formatDetails(printable.Details),
+			Suggestion:  printable.Suggestion,
+			Snippets:    printable.Snippets,
+		}
+		if printable.Error != nil {
+			check.Error = printable.Error.Error()
+		}
+		if printable.ErrorCode != outcomes.ErrorCodeUnknown {
+			check.ErrorCode = string(printable.ErrorCode)
+		}
+
+		failed := false
+		switch printable.Outcome.(type) {
+		case outcomes.Fail:
+			view.Failed++
+			failed = true
+		case outcomes.Warning:
+			view.Warnings++
+		case outcomes.Timeout:
+			view.TimedOut++
+		case outcomes.Skipped:
+			view.Skipped++
+		}
+
+		name := category(printable.CheckID)
+		pos, ok := categories[name]
+		if !ok {
+			pos = len(view.Categories)
+			categories[name] = pos
+			view.Categories = append(view.Categories, reportCategory{Name: name})
+		}
+		view.Categories[pos].Checks = append(view.Categories[pos].Checks, check)
+		if failed {
+			view.Categories[pos].Failed++
+		}
+	}
+	return view
+}
+
+// category returns the category of a check from its ID, such as envoy for envoy.cluster.
+func category(checkID string) string {
+	if checkID == "" {
+		return uncategorized
+	}
+	return strings.SplitN(checkID, ".", 2)[0]
+}
+
+// outcomeName returns the name of the type of an outcome without the colors of the table.
+func outcomeName(outcome outcomes.Outcome) string {
+	switch outcome.(type) {
+	case outcomes.Pass:
+		return "Pass"
+	case outcomes.Fail:
+		return "Fail"
+	case outcomes.Warning:
+		return "Warning"
+	case outcomes.Info:
+		return "Info"
+	case outcomes.Skipped:
+		return "Skipped"
+	case outcomes.Timeout:
+		return "Timeout"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,132 @@
+package printer
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/diagnosis"
+	"github.com/openservicemesh/osm-health/pkg/osm/utils"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+)
+
+var testReport = Report{
+	Command: "connectivity pod-to-pod",
+	Mesh: &utils.MeshInfo{
+		Name:               "osm",
+		Namespace:          "osm-system",
+		OSMVersion:         version.ControllerVersion("v0.9"),
+		DetectedOSMVersion: version.ControllerVersion("v0.9"),
+	},
+	Printables: []common.Printable{
+		{
+			CheckID:          "envoy.filter-chain",
+			CheckDescription: "Checking whether bookbuyer/bookbuyer and bookstore/bookstore are configured with the correct Envoy filter chains",
+			Severity:         outcomes.SeverityError,
+			Error:            outcomes.NewError("ENVOY_FILTER_CHAIN_MISSING", "Envoy filter chain missing"),
+			ErrorCode:        "ENVOY_FILTER_CHAIN_MISSING",
+			Suggestion:       "Check the TrafficTargets <from> bookbuyer",
+			Snippets:         []outcomes.Snippet{{Title: "Listener outbound-listener of bookbuyer/bookbuyer", Content: `{"name": "outbound-listener"}`}},
+			Outcome:          outcomes.Fail{},
+		},
+		{
+			CheckID:          "envoy.listener",
+			CheckDescription: "Checking whether bookbuyer/bookbuyer is configured with correct outbound Envoy listener",
+			Outcome:          outcomes.Pass{},
+		},
+		{
+			CheckID:          "smi.traffictarget",
+			CheckDescription: "Checking whether there is a TrafficTarget",
+			Diagnostics:      "OSM is in permissive traffic policy modes",
+			Outcome:          outcomes.Skipped{},
+		},
+		{
+			CheckDescription: "Running check acme.labels of plugin acme",
+			Error:            errors.New("plugin failed"),
+			ErrorCode:        outcomes.ErrorCodeUnknown,
+			Outcome:          outcomes.Fail{},
+		},
+	},
+	Diagnoses: []diagnosis.Diagnosis{
+		{
+			Title:      "The source is not allowed to reach the destination",
+			Confidence: 0.5,
+			Evidence:   []string{"Checking whether there is a TrafficTarget"},
+			Causes: []diagnosis.RankedCause{
+				{Cause: diagnosis.Cause{Cause: "No TrafficTarget", Suggestion: "Add a TrafficTarget"}, Confidence: 0.4},
+			},
+		},
+	},
+}
+
+func TestWriteReport(t *testing.T) {
+	tests := []struct {
+		format   ReportFormat
+		expected []string
+	}{
+		{
+			format: ReportFormatHTML,
+			expected: []string{
+				"<title>osm-health report: connectivity pod-to-pod</title>",
+				"<tr><td>Mesh</td><td>osm</td></tr>",
+				"<tr><td>Failed</td><td class=\"Fail\">2</td></tr>",
+				"<h2>envoy (2 checks, 1 failed)</h2>",
+				"<h2>smi (1 checks)</h2>",
+				"<h2>other (1 checks, 1 failed)</h2>",
+				"<details open>",
+				"Error [ENVOY_FILTER_CHAIN_MISSING]: Envoy filter chain missing",
+				"Check the TrafficTargets &lt;from&gt; bookbuyer",
+				"<pre>{&#34;name&#34;: &#34;outbound-listener&#34;}</pre>",
+				"(confidence 50%)",
+				"Likely cause (40%): No TrafficTarget",
+				"Error: plugin failed",
+			},
+		},
+		{
+			format: ReportFormatMarkdown,
+			expected: []string{
+				"# osm-health report: connectivity pod-to-pod",
+				"- **Mesh:** osm",
+				"- **Failed:** 2",
+				"## envoy (2 checks, 1 failed)",
+				"**Error [ENVOY_FILTER_CHAIN_MISSING]: Envoy filter chain missing**",
+				"**Suggestion:** Check the TrafficTargets <from> bookbuyer",
+				"```json\n{\"name\": \"outbound-listener\"}\n```",
+				"- **Pass** 2. Checking whether bookbuyer/bookbuyer is configured with correct outbound Envoy listener `envoy.listener`",
+				"### The source is not allowed to reach the destination (confidence 50%)",
+				"  - Suggestion: Add a TrafficTarget",
+				"- **Fail** 4. Running check acme.labels of plugin acme\n  - Error: plugin failed",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.format), func(t *testing.T) {
+			assert := tassert.New(t)
+			var out bytes.Buffer
+			assert.Nil(WriteReport(&out, test.format, testReport))
+			for _, expected := range test.expected {
+				assert.Contains(out.String(), expected)
+			}
+		})
+	}
+}
+
+func TestParseReportFormat(t *testing.T) {
+	assert := tassert.New(t)
+
+	format, err := ParseReportFormat("HTML")
+	assert.Nil(err)
+	assert.Equal(ReportFormatHTML, format)
+
+	format, err = ParseReportFormat("markdown")
+	assert.Nil(err)
+	assert.Equal(ReportFormatMarkdown, format)
+
+	_, err = ParseReportFormat("pdf")
+	assert.ErrorIs(err, ErrUnknownReportFormat)
+}
@@ -0,0 +1,174 @@
+package printer
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+// Results holds the outcomes of the commands written with --output json, in the order they ran.
+type Results struct {
+	Commands []CommandResult `json:"commands"`
+}
+
+// CommandResult holds the outcomes of the checks of a command.
+type CommandResult struct {
+	// Command is the command that ran the checks, such as "connectivity pod-to-pod".
+	Command string `json:"command"`
+
+	// Timestamp is when the outcomes were written.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Mesh describes the mesh the checks ran against, or is nil when it is unknown.
+	Mesh *MeshResult `json:"mesh,omitempty"`
+
+	Checks    []CheckResult     `json:"checks"`
+	Diagnoses []DiagnosisResult `json:"diagnoses,omitempty"`
+}
+
+// MeshResult describes the mesh the checks of a command ran against.
+type MeshResult struct {
+	Name               string `json:"name"`
+	Namespace          string `json:"namespace"`
+	OSMVersion         string `json:"osmVersion"`
+	DetectedOSMVersion string `json:"detectedOSMVersion"`
+}
+
+// CheckResult is the outcome of a check.
+type CheckResult struct {
+	// ID is the ID of the check in the catalog, or empty when the check is not registered.
+	ID string `json:"id,omitempty"`
+
+	// Type is the type of the check, for checks that are not registered.
+	Type string `json:"type,omitempty"`
+
+	Description string `json:"description"`
+
+	// Outcome is the type of the outcome, such as pass, fail or skipped.
+	Outcome string `json:"outcome"`
+
+	// Severity is the name of the severity of the outcome, such as error or critical.
+	Severity string `json:"severity"`
+
+	Error       string                 `json:"error,omitempty"`
+	ErrorCode   string                 `json:"errorCode,omitempty"`
+	Diagnostics string                 `json:"diagnostics,omitempty"`
+	Suggestion  string                 `json:"suggestion,omitempty"`
+	Details     map[string]interface{} `json:"details,omitempty"`
+
+	// Duration is how long the check ran, in seconds.
+	Duration float64 `json:"duration"`
+}
+
+// DiagnosisResult is a diagnosis of the outcomes of a command.
+type DiagnosisResult struct {
+	Title      string   `json:"title"`
+	Confidence float64  `json:"confidence"`
+	Causes     []string `json:"causes,omitempty"`
+}
+
+// LoadResults reads the results written to a file with --output json.
+func LoadResults(path string) (Results, error) {
+	var results Results
+	content, err := ioutil.ReadFile(path) // #nosec G304 -- the path is supplied by the user running osm-health
+	if err != nil {
+		return results, errors.Wrapf(err, "unable to read %s", path)
+	}
+	if err := json.Unmarshal(content, &results); err != nil {
+		return results, errors.Wrapf(err, "%s does not hold results written with --output json", path)
+	}
+	return results, nil
+}
+
+// WriteResults writes results as indented JSON.
+func WriteResults(w io.Writer, results Results) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(results); err != nil {
+		return errors.Wrap(err, "unable to encode the results")
+	}
+	return nil
+}
+
+// appendResults appends the outcomes of a report to the results held by a file, or creates the file.
+func appendResults(path string, report Report) error {
+	var results Results
+	if _, err := os.Stat(path); err == nil {
+		if results, err = LoadResults(path); err != nil {
+			return errors.Wrapf(err, "unable to append to %s", path)
+		}
+	} else if !os.IsNotExist(err) {
+		return errors.Wrapf(err, "unable to read %s", path)
+	}
+	results.Commands = append(results.Commands, newCommandResult(report, time.Now()))
+
+	f, err := os.Create(path) // #nosec G304 -- the path is supplied by the user running osm-health
+	if err != nil {
+		return errors.Wrapf(err, "unable to create %s", path)
+	}
+	if err := WriteResults(f, results); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// newCommandResult returns the outcomes of a command as written with --output json.
+func newCommandResult(report Report, timestamp time.Time) CommandResult {
+	result := CommandResult{
+		Command:   report.Command,
+		Timestamp: timestamp.UTC(),
+		Checks:    []CheckResult{},
+	}
+	if mesh := report.Mesh; mesh != nil && mesh.Namespace != "" {
+		result.Mesh = &MeshResult{
+			Name:               mesh.Name.String(),
+			Namespace:          mesh.Namespace.String(),
+			OSMVersion:         string(mesh.OSMVersion),
+			DetectedOSMVersion: string(mesh.DetectedOSMVersion),
+		}
+	}
+	for _, printable := range report.Printables {
+		result.Checks = append(result.Checks, newCheckResult(printable))
+	}
+	for _, d := range report.Diagnoses {
+		diagnosis := DiagnosisResult{Title: d.Title, Confidence: d.Confidence}
+		for _, cause := range d.Causes {
+			diagnosis.Causes = append(diagnosis.Causes, cause.Cause.Cause)
+		}
+		result.Diagnoses = append(result.Diagnoses, diagnosis)
+	}
+	return result
+}
+
+// newCheckResult returns the outcome of a check as written with --output json.
+func newCheckResult(printable common.Printable) CheckResult {
+	check := CheckResult{
+		ID:          printable.CheckID,
+		Description: printable.CheckDescription,
+		Outcome:     strings.ToLower(outcomeName(printable.Outcome)),
+		Severity:    printable.Severity.String(),
+		Diagnostics: printable.Diagnostics,
+		Suggestion:  printable.Suggestion,
+		Details:     printable.Details,
+		Duration:    printable.Duration.Seconds(),
+	}
+	if check.ID == "" {
+		check.Type = printable.CheckType
+	}
+	if printable.Error != nil {
+		check.Error = printable.Error.Error()
+	}
+	if printable.ErrorCode != outcomes.NoErrorCode && printable.ErrorCode != outcomes.ErrorCodeUnknown {
+		check.ErrorCode = string(printable.ErrorCode)
+	}
+	return check
+}
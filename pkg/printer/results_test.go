@@ -0,0 +1,79 @@
+package printer
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestNewCommandResult(t *testing.T) {
+	assert := tassert.New(t)
+
+	timestamp := time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC)
+	result := newCommandResult(testReport, timestamp)
+	assert.Equal("connectivity pod-to-pod", result.Command)
+	assert.Equal(timestamp, result.Timestamp)
+	assert.Equal(&MeshResult{Name: "osm", Namespace: "osm-system", OSMVersion: "v0.9", DetectedOSMVersion: "v0.9"}, result.Mesh)
+	assert.Len(result.Checks, 4)
+	assert.Equal(CheckResult{
+		ID:          "envoy.filter-chain",
+		Description: "Checking whether bookbuyer/bookbuyer and bookstore/bookstore are configured with the correct Envoy filter chains",
+		Outcome:     "fail",
+		Severity:    "error",
+		Error:       "Envoy filter chain missing",
+		ErrorCode:   "ENVOY_FILTER_CHAIN_MISSING",
+		Suggestion:  "Check the TrafficTargets <from> bookbuyer",
+	}, result.Checks[0])
+	assert.Equal("skipped", result.Checks[2].Outcome)
+	assert.Empty(result.Checks[3].ErrorCode)
+	assert.Equal([]DiagnosisResult{{Title: "The source is not allowed to reach the destination", Confidence: 0.5, Causes: []string{"No TrafficTarget"}}}, result.Diagnoses)
+
+	result = newCommandResult(Report{Command: "envoy trace"}, timestamp)
+	assert.Nil(result.Mesh)
+	assert.Empty(result.Checks)
+}
+
+func TestSaveOutputJSONAppends(t *testing.T) {
+	assert := tassert.New(t)
+	defer func() { recorded = nil }()
+	path := filepath.Join(t.TempDir(), "results.json")
+
+	Record(testReport)
+	saved, err := SaveOutput(OutputFormatJSON, path)
+	assert.Nil(err)
+	assert.True(saved)
+
+	Record(Report{Command: "control-plane status"})
+	saved, err = SaveOutput(OutputFormatJSON, path)
+	assert.Nil(err)
+	assert.True(saved)
+
+	results, err := LoadResults(path)
+	assert.Nil(err)
+	assert.Len(results.Commands, 2)
+	assert.Equal("connectivity pod-to-pod", results.Commands[0].Command)
+	assert.Len(results.Commands[0].Checks, 4)
+	assert.Equal("control-plane status", results.Commands[1].Command)
+
+	_, err = SaveOutput(OutputFormatJUnit, path)
+	assert.NotNil(err)
+}
+
+func TestParseOutputFormat(t *testing.T) {
+	assert := tassert.New(t)
+
+	format, err := ParseOutputFormat("JUnit")
+	assert.Nil(err)
+	assert.Equal(OutputFormatJUnit, format)
+	assert.Equal("osm-health-junit.xml", DefaultOutputPath(format))
+
+	format, err = ParseOutputFormat("json")
+	assert.Nil(err)
+	assert.Equal(OutputFormatJSON, format)
+	assert.Equal("osm-health-results.json", DefaultOutputPath(format))
+
+	_, err = ParseOutputFormat("yaml")
+	assert.ErrorIs(err, ErrUnknownOutputFormat)
+}
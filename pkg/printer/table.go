@@ -3,16 +3,23 @@ package printer
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/fatih/color"
 
 	"github.com/openservicemesh/osm-health/pkg/common"
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/diagnosis"
+	"github.com/openservicemesh/osm-health/pkg/runner"
 )
 
 // Print prints the printable outcomes of the evaluation of a list of Runnables.
 func Print(printables ...common.Printable) {
 	errorsCount := 0
+	timeoutsCount := 0
+	skippedCount := 0
 	w := new(tabwriter.Writer)
 	w.Init(os.Stdout, 4, 4, 0, ' ', 0)
 
@@ -22,13 +29,24 @@ func Print(printables ...common.Printable) {
 		if err != nil {
 			return
 		}
+		if _, ok := printableOutcome.Outcome.(outcomes.Skipped); ok {
+			skippedCount = skippedCount + 1
+		}
 		if printableOutcome.Error != nil {
-			_, err := fmt.Fprintln(w, color.RedString("---> Error: "+printableOutcome.Error.Error()))
+			label := "---> Error: "
+			if printableOutcome.ErrorCode != outcomes.NoErrorCode && printableOutcome.ErrorCode != outcomes.ErrorCodeUnknown {
+				label = fmt.Sprintf("---> Error [%s]: ", printableOutcome.ErrorCode)
+			}
+			_, err := fmt.Fprintln(w, color.RedString(label+printableOutcome.Error.Error()))
 			if err != nil {
 				log.Error().Err(err)
 				return
 			}
-			errorsCount = errorsCount + 1
+			if _, ok := printableOutcome.Outcome.(outcomes.Timeout); ok {
+				timeoutsCount = timeoutsCount + 1
+			} else {
+				errorsCount = errorsCount + 1
+			}
 		}
 		if printableOutcome.Diagnostics != "" {
 			_, err := fmt.Fprintln(w, "---> Diagnostic info:", printableOutcome.Diagnostics)
@@ -37,11 +55,97 @@ func Print(printables ...common.Printable) {
 				return
 			}
 		}
+		if len(printableOutcome.Details) > 0 {
+			_, err := fmt.Fprintln(w, "---> Details:", formatDetails(printableOutcome.Details))
+			if err != nil {
+				log.Error().Err(err)
+				return
+			}
+		}
 	}
 
-	_, err := fmt.Fprintf(w, "\nRan %d checks. %d checks failed.\n", len(printables), errorsCount)
+	summary := fmt.Sprintf("\nRan %d checks. %d checks failed.", len(printables), errorsCount)
+	if timeoutsCount > 0 {
+		summary += fmt.Sprintf(" %d checks timed out.", timeoutsCount)
+	}
+	if skippedCount > 0 {
+		summary += fmt.Sprintf(" %d checks skipped.", skippedCount)
+	}
+	_, err := fmt.Fprintln(w, summary)
 	if err != nil {
 		log.Error().Err(err)
 		return
 	}
 }
+
+// formatDetails formats the details of an outcome as key=value pairs sorted by key.
+func formatDetails(details map[string]interface{}) string {
+	keys := make([]string, 0, len(details))
+	for key := range details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", key, details[key]))
+	}
+	return strings.Join(pairs, " ")
+}
+
+// PrintDiagnoses prints the diagnoses of the rules that matched the outcomes of a run, with their likely root causes.
+func PrintDiagnoses(diagnoses ...diagnosis.Diagnosis) {
+	if len(diagnoses) == 0 {
+		return
+	}
+
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 4, 4, 0, ' ', 0)
+	defer func() { _ = w.Flush() }()
+
+	if _, err := fmt.Fprintf(w, "\nDiagnosis:\n"); err != nil {
+		log.Error().Err(err)
+		return
+	}
+	for idx, d := range diagnoses {
+		if _, err := fmt.Fprintf(w, "%d\t%s\t(confidence %.0f%%)\n", idx+1, color.YellowString(d.Title), d.Confidence*100); err != nil {
+			log.Error().Err(err)
+			return
+		}
+		for _, evidence := range d.Evidence {
+			if _, err := fmt.Fprintln(w, "---> Evidence:", evidence); err != nil {
+				log.Error().Err(err)
+				return
+			}
+		}
+		for _, cause := range d.Causes {
+			if _, err := fmt.Fprintf(w, "---> Likely cause (%.0f%%): %s\n", cause.Confidence*100, cause.Cause.Cause); err != nil {
+				log.Error().Err(err)
+				return
+			}
+			if cause.Suggestion != "" {
+				if _, err := fmt.Fprintln(w, "     Suggestion:", cause.Suggestion); err != nil {
+					log.Error().Err(err)
+					return
+				}
+			}
+		}
+	}
+}
+
+// PrintChecks prints the catalog of the given types of checks.
+func PrintChecks(infos ...runner.CheckInfo) {
+	w := new(tabwriter.Writer)
+	w.Init(os.Stdout, 4, 4, 2, ' ', 0)
+	defer func() { _ = w.Flush() }()
+
+	if _, err := fmt.Fprintln(w, "ID\tTAGS\tDESCRIPTION"); err != nil {
+		log.Error().Err(err)
+		return
+	}
+	for _, info := range infos {
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\n", info.ID, strings.Join(info.Tags, ","), info.Doc); err != nil {
+			log.Error().Err(err)
+			return
+		}
+	}
+}
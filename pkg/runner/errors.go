@@ -0,0 +1,17 @@
+package runner
+
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
+
+var (
+	// ErrCheckTimedOut denotes that a check did not complete within its timeout or the timeout of the run.
+	ErrCheckTimedOut = outcomes.NewError("CHECK_TIMED_OUT", "check timed out")
+
+	// ErrCheckInterrupted denotes that a check was interrupted, e.g. with Ctrl-C, before it completed.
+	ErrCheckInterrupted = outcomes.NewError("CHECK_INTERRUPTED", "check interrupted")
+
+	// ErrUnknownCheck denotes that a check ID or pattern given to select checks matches no registered check.
+	ErrUnknownCheck = outcomes.NewError("UNKNOWN_CHECK", "unknown check")
+
+	// ErrUnknownTag denotes that a tag given to select checks is not a tag of any registered check.
+	ErrUnknownTag = outcomes.NewError("UNKNOWN_TAG", "unknown check tag")
+)
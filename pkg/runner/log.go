@@ -0,0 +1,5 @@
+package runner
+
+import "github.com/openservicemesh/osm-health/pkg/logger"
+
+var log = logger.New("runner")
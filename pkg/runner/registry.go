@@ -0,0 +1,177 @@
+package runner
+
+import (
+	"path"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// CheckInfo describes a type of check in the registry.
+type CheckInfo struct {
+	// ID identifies the type of check across releases, such as envoy.cluster.
+	ID string
+
+	// Tags group related types of checks, such as envoy, smi or slow.
+	Tags []string
+
+	// Doc describes what the check verifies.
+	Doc string
+
+	// Check is a value of the type of check, such as envoy.ClusterCheck{}.
+	Check Runnable
+}
+
+// HasTag returns whether the type of check has the given tag.
+func (info CheckInfo) HasTag(tag string) bool {
+	for _, t := range info.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Described is implemented by checks that describe themselves, such as plugin checks, because checks with
+// different IDs share their Go type.
+type Described interface {
+	// CheckInfo returns the description of the check, with the ID it is registered with.
+	CheckInfo() CheckInfo
+}
+
+// registry holds the registered types of checks, keyed by ID.
+var registry = map[string]CheckInfo{}
+
+// Register adds types of checks to the registry, replacing a type of check registered earlier with the same ID.
+func Register(infos ...CheckInfo) {
+	for _, info := range infos {
+		registry[info.ID] = info
+	}
+}
+
+// Registered returns the registered types of checks sorted by ID.
+func Registered() []CheckInfo {
+	infos := make([]CheckInfo, 0, len(registry))
+	for _, info := range registry {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+// lookup returns the registered type of the given check, by its ID when it is Described and by its Go type otherwise.
+func lookup(check Runnable) (CheckInfo, bool) {
+	if described, ok := check.(Described); ok {
+		info, registered := registry[described.CheckInfo().ID]
+		return info, registered
+	}
+	t := checkReflectType(check)
+	for _, info := range registry {
+		if info.Check != nil && checkReflectType(info.Check) == t {
+			return info, true
+		}
+	}
+	return CheckInfo{}, false
+}
+
+// Filter selects the checks to run by their ID and tags. The zero Filter selects every check.
+type Filter struct {
+	// Checks holds the IDs of the checks to run, or patterns such as envoy.*; empty selects every check.
+	Checks []string
+
+	// SkipChecks holds the IDs of the checks not to run, or patterns such as smi.*.
+	SkipChecks []string
+
+	// Tags selects the checks with at least one of the tags; empty selects every check.
+	Tags []string
+}
+
+// filter selects the checks Run runs.
+var filter Filter
+
+// SetFilter sets the checks Run runs. It fails when an ID, pattern or tag matches no registered check, so that
+// a typo does not silently select no checks.
+func SetFilter(f Filter) error {
+	if err := f.validate(); err != nil {
+		return err
+	}
+	filter = f
+	return nil
+}
+
+// Selected returns the registered types of checks selected by the filter set with SetFilter, sorted by ID.
+func Selected() []CheckInfo {
+	var infos []CheckInfo
+	for _, info := range Registered() {
+		if filter.selects(info, true) {
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
+// validate checks that every ID, pattern and tag of the filter matches a registered check.
+func (f Filter) validate() error {
+	for _, pattern := range append(append([]string{}, f.Checks...), f.SkipChecks...) {
+		found := false
+		for _, info := range registry {
+			matched, err := path.Match(pattern, info.ID)
+			if err != nil {
+				return errors.Wrapf(err, "invalid check pattern %q", pattern)
+			}
+			if matched {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Wrapf(ErrUnknownCheck, "%q matches no check; run 'osm-health checks list' to list the checks", pattern)
+		}
+	}
+	for _, tag := range f.Tags {
+		found := false
+		for _, info := range registry {
+			if info.HasTag(tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Wrapf(ErrUnknownTag, "%q; run 'osm-health checks list' to list the checks and their tags", tag)
+		}
+	}
+	return nil
+}
+
+// selects returns whether the filter selects a check. Checks whose type is not registered only run when the filter
+// does not select checks by ID or tag.
+func (f Filter) selects(info CheckInfo, registered bool) bool {
+	if !registered {
+		return len(f.Checks) == 0 && len(f.Tags) == 0
+	}
+	if len(f.Checks) > 0 && !matchesAny(f.Checks, info.ID) {
+		return false
+	}
+	if matchesAny(f.SkipChecks, info.ID) {
+		return false
+	}
+	if len(f.Tags) == 0 {
+		return true
+	}
+	for _, tag := range f.Tags {
+		if info.HasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAny returns whether the ID matches any of the patterns; patterns were validated by Filter.validate.
+func matchesAny(patterns []string, id string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, id); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+type otherFakeCheck struct {
+	fakeCheck
+}
+
+// unregisteredFakeCheck is a check whose type is not in the registry.
+type unregisteredFakeCheck struct {
+	fakeCheck
+}
+
+func withRegistry(t *testing.T, infos ...CheckInfo) {
+	registry = map[string]CheckInfo{}
+	Register(infos...)
+	t.Cleanup(func() {
+		registry = map[string]CheckInfo{}
+		filter = Filter{}
+	})
+}
+
+func TestFilter(t *testing.T) {
+	withRegistry(t,
+		CheckInfo{ID: "envoy.cluster", Tags: []string{"envoy", "slow"}, Check: fakeCheck{}},
+		CheckInfo{ID: "smi.traffictarget", Tags: []string{"smi"}, Check: otherFakeCheck{}},
+	)
+
+	tests := []struct {
+		name        string
+		filter      Filter
+		expectedIDs []string
+		expectedErr error
+	}{
+		{
+			name:        "empty filter selects every check",
+			expectedIDs: []string{"envoy.cluster", "smi.traffictarget"},
+		},
+		{
+			name:        "checks by ID",
+			filter:      Filter{Checks: []string{"smi.traffictarget"}},
+			expectedIDs: []string{"smi.traffictarget"},
+		},
+		{
+			name:        "checks by pattern",
+			filter:      Filter{Checks: []string{"envoy.*"}},
+			expectedIDs: []string{"envoy.cluster"},
+		},
+		{
+			name:        "skipped checks",
+			filter:      Filter{SkipChecks: []string{"envoy.*"}},
+			expectedIDs: []string{"smi.traffictarget"},
+		},
+		{
+			name:        "checks with any of the tags",
+			filter:      Filter{Tags: []string{"slow", "smi"}},
+			expectedIDs: []string{"envoy.cluster", "smi.traffictarget"},
+		},
+		{
+			name:   "skipped checks take precedence",
+			filter: Filter{Tags: []string{"envoy"}, SkipChecks: []string{"envoy.cluster"}},
+		},
+		{
+			name:        "unknown check",
+			filter:      Filter{Checks: []string{"envoy.listener"}},
+			expectedErr: ErrUnknownCheck,
+		},
+		{
+			name:        "unknown skipped check",
+			filter:      Filter{SkipChecks: []string{"k8s.*"}},
+			expectedErr: ErrUnknownCheck,
+		},
+		{
+			name:        "unknown tag",
+			filter:      Filter{Tags: []string{"certs"}},
+			expectedErr: ErrUnknownTag,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			filter = Filter{}
+			err := SetFilter(test.filter)
+			assert.ErrorIs(err, test.expectedErr)
+			if err != nil {
+				return
+			}
+			var ids []string
+			for _, info := range Selected() {
+				ids = append(ids, info.ID)
+			}
+			assert.Equal(test.expectedIDs, ids)
+		})
+	}
+}
+
+func TestRunFiltered(t *testing.T) {
+	withRegistry(t,
+		CheckInfo{ID: "envoy.cluster", Tags: []string{"envoy"}, Check: fakeCheck{}},
+		CheckInfo{ID: "smi.traffictarget", Tags: []string{"smi"}, Check: otherFakeCheck{}},
+	)
+	checks := []Runnable{
+		fakeCheck{outcome: outcomes.Pass{}},
+		otherFakeCheck{fakeCheck{outcome: outcomes.Pass{}}},
+		unregisteredFakeCheck{fakeCheck{outcome: outcomes.Pass{}}},
+	}
+
+	assert := tassert.New(t)
+	printables := Run(context.TODO(), checks...)
+	assert.Len(printables, 3)
+	assert.Equal("envoy.cluster", printables[0].CheckID)
+	assert.Equal("smi.traffictarget", printables[1].CheckID)
+	assert.Equal("", printables[2].CheckID)
+
+	// Checks that are not registered do not run when checks are selected by ID or tag
+	assert.Nil(SetFilter(Filter{Tags: []string{"smi"}}))
+	printables = Run(context.TODO(), checks...)
+	assert.Len(printables, 1)
+	assert.Equal("otherFakeCheck", printables[0].CheckType)
+
+	assert.Nil(SetFilter(Filter{SkipChecks: []string{"smi.traffictarget"}}))
+	printables = Run(context.TODO(), checks...)
+	assert.Len(printables, 2)
+	assert.Equal("unregisteredFakeCheck", printables[1].CheckType)
+}
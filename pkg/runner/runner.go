@@ -1,25 +1,152 @@
 package runner
 
 import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+
 	"github.com/openservicemesh/osm-health/pkg/common"
 	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
 )
 
-// Run evaluates all the Runnables and returns the outcomes.
-func Run(checks ...Runnable) []common.Printable {
-	printableOutcomes := make([]common.Printable, len(checks))
+// DefaultCheckTimeout is how long a check may run before its outcome is a Timeout.
+const DefaultCheckTimeout = 30 * time.Second
+
+// checkTimeout is how long each check may run; 0 or less disables the timeout of checks.
+var checkTimeout = DefaultCheckTimeout
+
+// SetCheckTimeout sets how long each check may run; 0 or less disables the timeout of checks.
+func SetCheckTimeout(timeout time.Duration) {
+	checkTimeout = timeout
+}
+
+// Run evaluates the Runnables selected by the filter set with SetFilter in order and returns the outcomes.
+// A check that does not complete within the check timeout gets a Timeout outcome. Once ctx is done, e.g. when the
+// run times out or is interrupted with Ctrl-C, the remaining checks are not run and the outcomes of the checks that
+// did run are returned.
+func Run(ctx context.Context, checks ...Runnable) []common.Printable {
+	var printableOutcomes []common.Printable
 	for idx, check := range checks {
-		outcome := check.Run()
-		if outcome == nil {
-			outcome = outcomes.Unknown{}
+		if ctx.Err() != nil {
+			log.Warn().Msgf("Stopped after %d of %d checks: %s", idx, len(checks), interruption(ctx))
+			break
+		}
+		info, registered := lookup(check)
+		if !filter.selects(info, registered) {
+			continue
+		}
+		start := time.Now()
+		outcome := runCheck(ctx, check)
+		duration := time.Since(start)
+		var snippets []outcomes.Snippet
+		if fail, ok := outcome.(outcomes.Fail); ok {
+			snippets = fail.Snippets
 		}
-		printableOutcomes[idx] = common.Printable{
-			// TODO add check.Suggestion() and check.FixIt() in the future.
+		printableOutcomes = append(printableOutcomes, common.Printable{
+			// TODO add check.FixIt() in the future.
+			CheckID:          info.ID,
+			CheckType:        checkType(check),
 			CheckDescription: check.Description(),
 			Type:             outcome.GetOutcomeType(),
+			Severity:         outcome.GetSeverity(),
 			Diagnostics:      outcome.GetDiagnostics(),
 			Error:            outcome.GetError(),
-		}
+			ErrorCode:        outcome.GetErrorCode(),
+			Details:          outcome.GetDetails(),
+			Suggestion:       suggestion(check),
+			Snippets:         snippets,
+			Duration:         duration,
+			Outcome:          outcome,
+		})
 	}
 	return printableOutcomes
 }
+
+// runCheck runs a check with the check timeout, and stops waiting for it once its context is done.
+func runCheck(ctx context.Context, check Runnable) outcomes.Outcome {
+	checkCtx, cancel := context.WithCancel(ctx)
+	if checkTimeout > 0 {
+		checkCtx, cancel = context.WithTimeout(ctx, checkTimeout)
+	}
+	defer cancel()
+
+	// The channel is buffered so that a check that ignores its context, such as one stuck in a port-forward, does
+	// not leak blocked on sending its outcome once nobody waits for it.
+	result := make(chan outcomes.Outcome, 1)
+	go func() {
+		// A panicking check must not take down the run and the outcomes of the other checks with it.
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error().Msgf("Check %s panicked: %v", checkType(check), r)
+				result <- outcomes.Unknown{Reason: fmt.Sprintf("the check panicked: %v", r)}
+			}
+		}()
+		result <- check.Run(checkCtx)
+	}()
+
+	var outcome outcomes.Outcome
+	select {
+	case outcome = <-result:
+	case <-checkCtx.Done():
+		select {
+		case outcome = <-result:
+		default:
+			return outcomes.Timeout{Error: interruption(ctx)}
+		}
+	}
+
+	if outcome == nil {
+		return outcomes.Unknown{Reason: "the check returned no outcome"}
+	}
+	// A check that gives up when its context is done usually fails with the error of the interrupted request.
+	if fail, ok := outcome.(outcomes.Fail); ok && checkCtx.Err() != nil {
+		return outcomes.Timeout{Error: fmt.Errorf("%w: %v", interruption(ctx), fail.Error)}
+	}
+	return outcome
+}
+
+// interruption returns why a check was stopped before it completed; ctx is the context of the run.
+func interruption(ctx context.Context) error {
+	switch {
+	case errors.Is(ctx.Err(), context.Canceled):
+		return ErrCheckInterrupted
+	case ctx.Err() != nil:
+		return errors.Wrap(ErrCheckTimedOut, "the run timed out")
+	default:
+		return errors.Wrapf(ErrCheckTimedOut, "no outcome after %s", checkTimeout)
+	}
+}
+
+// notImplemented is the panic value of the methods of checks that are not implemented yet.
+const notImplemented = "implement me"
+
+// suggestion returns the suggestion of a check, or an empty string when the check does not implement Suggestion yet.
+// Other panics of Suggestion are logged, so that bugs in suggestions are not mistaken for checks without one.
+func suggestion(check Runnable) (s string) {
+	defer func() {
+		if r := recover(); r != nil {
+			if r != notImplemented {
+				log.Warn().Msgf("Suggestion of check %s panicked: %v", checkType(check), r)
+			}
+			s = ""
+		}
+	}()
+	return check.Suggestion()
+}
+
+// checkType returns the name of the type of the check, dereferencing pointers.
+func checkType(check Runnable) string {
+	return checkReflectType(check).Name()
+}
+
+// checkReflectType returns the type of the check, dereferencing pointers.
+func checkReflectType(check Runnable) reflect.Type {
+	t := reflect.TypeOf(check)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
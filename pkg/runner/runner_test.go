@@ -0,0 +1,166 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+)
+
+type fakeCheck struct {
+	outcome outcomes.Outcome
+}
+
+func (check fakeCheck) Run(ctx context.Context) outcomes.Outcome {
+	return check.outcome
+}
+
+func (check fakeCheck) Description() string {
+	return "fake check"
+}
+
+func (check fakeCheck) Suggestion() string {
+	return ""
+}
+
+func (check fakeCheck) FixIt() error {
+	return nil
+}
+
+// blockingCheck runs until its context is done, or forever when it ignores its context.
+type blockingCheck struct {
+	fakeCheck
+	ignoreContext bool
+	cancelRun     context.CancelFunc
+}
+
+func (check blockingCheck) Run(ctx context.Context) outcomes.Outcome {
+	if check.cancelRun != nil {
+		check.cancelRun()
+	}
+	if check.ignoreContext {
+		select {}
+	}
+	<-ctx.Done()
+	return outcomes.Fail{Error: ctx.Err()}
+}
+
+func TestRun(t *testing.T) {
+	assert := tassert.New(t)
+	printables := Run(context.TODO(), fakeCheck{outcome: outcomes.Pass{}}, &fakeCheck{})
+
+	assert.Len(printables, 2)
+	assert.Equal("fakeCheck", printables[0].CheckType)
+	assert.Equal(outcomes.Pass{}, printables[0].Outcome)
+	assert.Equal("fakeCheck", printables[1].CheckType)
+	assert.IsType(outcomes.Unknown{}, printables[1].Outcome)
+	assert.Equal("the check returned no outcome", printables[1].Diagnostics)
+	assert.Equal("fake check", printables[1].CheckDescription)
+}
+
+// unfinishedCheck does not implement Suggestion yet.
+type unfinishedCheck struct {
+	fakeCheck
+}
+
+func (check unfinishedCheck) Suggestion() string {
+	panic("implement me")
+}
+
+// suggestingCheck fails with a snippet of what it inspected and suggests a fix.
+type suggestingCheck struct {
+	fakeCheck
+}
+
+func (check suggestingCheck) Suggestion() string {
+	return "restart the pod"
+}
+
+func TestRunSuggestionAndSnippets(t *testing.T) {
+	assert := tassert.New(t)
+	snippets := []outcomes.Snippet{{Title: "listener", Content: "{}"}}
+	printables := Run(context.TODO(),
+		suggestingCheck{fakeCheck{outcome: outcomes.Fail{Error: errors.New("broken"), Snippets: snippets}}},
+		unfinishedCheck{fakeCheck{outcome: outcomes.Pass{}}},
+	)
+
+	assert.Len(printables, 2)
+	assert.Equal("restart the pod", printables[0].Suggestion)
+	assert.Equal(snippets, printables[0].Snippets)
+	assert.Empty(printables[1].Suggestion)
+	assert.Empty(printables[1].Snippets)
+}
+
+// panickingCheck panics while it runs.
+type panickingCheck struct {
+	fakeCheck
+}
+
+func (check panickingCheck) Run(ctx context.Context) outcomes.Outcome {
+	var pod *struct{ Name string }
+	return outcomes.Info{Diagnostics: pod.Name}
+}
+
+func TestRunPanickingCheck(t *testing.T) {
+	assert := tassert.New(t)
+	printables := Run(context.TODO(), panickingCheck{}, fakeCheck{outcome: outcomes.Pass{}})
+
+	assert.Len(printables, 2)
+	assert.IsType(outcomes.Unknown{}, printables[0].Outcome)
+	assert.Contains(printables[0].Diagnostics, "the check panicked")
+	assert.Equal(outcomes.Pass{}, printables[1].Outcome)
+}
+
+func TestRunCheckTimeout(t *testing.T) {
+	defer SetCheckTimeout(DefaultCheckTimeout)
+	SetCheckTimeout(10 * time.Millisecond)
+
+	testCases := []struct {
+		name  string
+		check Runnable
+	}{
+		{
+			name:  "check returns when its context is done",
+			check: blockingCheck{},
+		},
+		{
+			name:  "check ignores its context",
+			check: blockingCheck{ignoreContext: true},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			printables := Run(context.TODO(), test.check, fakeCheck{outcome: outcomes.Pass{}})
+
+			assert.Len(printables, 2)
+			assert.IsType(outcomes.Timeout{}, printables[0].Outcome)
+			assert.True(errors.Is(printables[0].Error, ErrCheckTimedOut))
+			assert.Equal(outcomes.ErrorCode("CHECK_TIMED_OUT"), printables[0].ErrorCode)
+			assert.Equal(outcomes.Pass{}, printables[1].Outcome)
+		})
+	}
+}
+
+func TestRunInterrupted(t *testing.T) {
+	assert := tassert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	printables := Run(ctx,
+		fakeCheck{outcome: outcomes.Pass{}},
+		blockingCheck{cancelRun: cancel},
+		fakeCheck{outcome: outcomes.Pass{}},
+	)
+
+	// The outcomes of the checks that ran are returned, and the remaining checks are not run.
+	assert.Len(printables, 2)
+	assert.Equal(outcomes.Pass{}, printables[0].Outcome)
+	assert.IsType(outcomes.Timeout{}, printables[1].Outcome)
+	assert.True(errors.Is(printables[1].Error, ErrCheckInterrupted))
+}
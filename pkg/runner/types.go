@@ -1,13 +1,16 @@
 package runner
 
 import (
+	"context"
+
 	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
 )
 
 // Runnable is a type of generic function that can be executed; it returns pass/fail on a given check.
 type Runnable interface {
 	// Run executes a check and returns an outcome (outcomes.Outcome).
-	Run() outcomes.Outcome
+	// The check should stop and return as soon as ctx is done.
+	Run(ctx context.Context) outcomes.Outcome
 
 	// Description returns human-readable information on what check is being executed.
 	Description() string
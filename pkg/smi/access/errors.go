@@ -1,11 +1,14 @@
 package access
 
-import "errors"
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
 
 var (
 	// ErrorUnknownSupportForRouteKindUnknownOsmVersion is the error for unknown osm versions when checking for supported traffic target route kinds.
-	ErrorUnknownSupportForRouteKindUnknownOsmVersion = errors.New("unknown osm version: no info on supported traffic target route kinds for specified osm version")
+	ErrorUnknownSupportForRouteKindUnknownOsmVersion = outcomes.NewError("UNKNOWN_SUPPORT_FOR_ROUTE_KIND_UNKNOWN_OSM_VERSION", "unknown osm version: no info on supported traffic target route kinds for specified osm version")
 
 	// ErrorUnsupportedRouteKind is the error if the osm version does not support the TrafficTarget route kind.
-	ErrorUnsupportedRouteKind = errors.New("unsupported traffic target route kind")
+	ErrorUnsupportedRouteKind = outcomes.NewError("UNSUPPORTED_ROUTE_KIND", "unsupported traffic target route kind")
+
+	// ErrorUnsupportedTrafficTargetVersion is the error if the osm version cannot be mapped to a TrafficTarget version.
+	ErrorUnsupportedTrafficTargetVersion = outcomes.NewError("UNSUPPORTED_TRAFFIC_TARGET_VERSION", "OSM Controller version could not be mapped to a TrafficTarget version")
 )
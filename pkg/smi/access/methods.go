@@ -0,0 +1,101 @@
+package access
+
+import (
+	"context"
+
+	mapset "github.com/deckarep/golang-set"
+	smiAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned"
+	smiSpecClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/smi"
+	"github.com/openservicemesh/osm-health/pkg/smi/access/v1alpha2"
+	"github.com/openservicemesh/osm-health/pkg/smi/access/v1alpha3"
+	"github.com/openservicemesh/osm-health/pkg/smi/specs"
+)
+
+// GetTrafficTargets returns the TrafficTargets in the given namespace, read with the TrafficTarget version supported by osmVersion
+func GetTrafficTargets(ctx context.Context, osmVersion version.ControllerVersion, accessClient smiAccessClient.Interface, namespace string) ([]smi.TrafficTarget, error) {
+	switch version.SupportedTrafficTarget[osmVersion] {
+	case version.V1Alpha2:
+		return v1alpha2.GetTrafficTargets(ctx, accessClient, namespace)
+	case version.V1Alpha3:
+		return v1alpha3.GetTrafficTargets(ctx, accessClient, namespace)
+	default:
+		return nil, ErrorUnsupportedTrafficTargetVersion
+	}
+}
+
+// GetMatchingTrafficTargets returns the TrafficTargets which allow srcPod to communicate with dstPod
+func GetMatchingTrafficTargets(ctx context.Context, osmVersion version.ControllerVersion, accessClient smiAccessClient.Interface, srcPod *corev1.Pod, dstPod *corev1.Pod) ([]smi.TrafficTarget, error) {
+	trafficTargets, err := GetTrafficTargets(ctx, osmVersion, accessClient, dstPod.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	var matchingTargets []smi.TrafficTarget
+	for _, trafficTarget := range trafficTargets {
+		if DoesTargetMatchPods(trafficTarget, srcPod, dstPod) {
+			matchingTargets = append(matchingTargets, trafficTarget)
+		}
+	}
+	return matchingTargets, nil
+}
+
+// DoesTargetMatchPods checks whether a given TrafficTarget has dstPod as its destination and srcPod as an allowed source to this destination
+func DoesTargetMatchPods(trafficTarget smi.TrafficTarget, srcPod *corev1.Pod, dstPod *corev1.Pod) bool {
+	return doesTargetRefDstPod(trafficTarget, dstPod) && doesTargetRefSrcPod(trafficTarget, srcPod)
+}
+
+// doesTargetRefDstPod checks whether the TrafficTarget refers to the destination pod's service account
+func doesTargetRefDstPod(trafficTarget smi.TrafficTarget, dstPod *corev1.Pod) bool {
+	return isPodIdentity(trafficTarget.Destination, dstPod)
+}
+
+// doesTargetRefSrcPod checks whether the TrafficTarget refers to the source pod's service account
+func doesTargetRefSrcPod(trafficTarget smi.TrafficTarget, srcPod *corev1.Pod) bool {
+	for _, source := range trafficTarget.Sources {
+		if isPodIdentity(source, srcPod) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPodIdentity(subject smi.IdentityBindingSubject, pod *corev1.Pod) bool {
+	return subject.Kind == smi.ServiceAccountKind && subject.Name == pod.Spec.ServiceAccountName && subject.Namespace == pod.Namespace
+}
+
+// GetHTTPRouteMatchesForPods returns the HTTPRouteGroup matches referenced by the rules of TrafficTargets which allow srcPod to communicate with dstPod
+func GetHTTPRouteMatchesForPods(ctx context.Context, osmVersion version.ControllerVersion, accessClient smiAccessClient.Interface, specClient smiSpecClient.Interface, srcPod *corev1.Pod, dstPod *corev1.Pod) ([]smi.HTTPRouteMatch, error) {
+	trafficTargets, err := GetMatchingTrafficTargets(ctx, osmVersion, accessClient, srcPod, dstPod)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []smi.HTTPRouteMatch
+	seen := mapset.NewSet()
+	for _, trafficTarget := range trafficTargets {
+		for _, rule := range trafficTarget.Rules {
+			if rule.Kind != smi.HTTPRouteGroupKind {
+				continue
+			}
+			httpRouteGroup, err := specs.GetHTTPRouteGroup(ctx, osmVersion, specClient, dstPod.Namespace, rule.Name)
+			if err != nil {
+				return nil, err
+			}
+			allowedMatches := mapset.NewSet()
+			for _, name := range rule.Matches {
+				allowedMatches.Add(name)
+			}
+			for _, match := range httpRouteGroup.Matches {
+				if !allowedMatches.Contains(match.Name) || seen.Contains(match.String()) {
+					continue
+				}
+				seen.Add(match.String())
+				matches = append(matches, match)
+			}
+		}
+	}
+	return matches, nil
+}
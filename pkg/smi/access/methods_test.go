@@ -0,0 +1,116 @@
+package access
+
+import (
+	"context"
+	"testing"
+
+	accessV1alpha2 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha2"
+	accessV1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
+	fakeAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned/fake"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/smi"
+)
+
+var (
+	srcPod = &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "bookbuyer", Namespace: "bookbuyer"},
+		Spec:       corev1.PodSpec{ServiceAccountName: "bookbuyer"},
+	}
+	dstPod = &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "bookstore"},
+		Spec:       corev1.PodSpec{ServiceAccountName: "bookstore"},
+	}
+)
+
+func TestGetMatchingTrafficTargets(t *testing.T) {
+	v1alpha2Target := &accessV1alpha2.TrafficTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "bookstore-v1alpha2", Namespace: "bookstore"},
+		Spec: accessV1alpha2.TrafficTargetSpec{
+			Destination: accessV1alpha2.IdentityBindingSubject{Kind: smi.ServiceAccountKind, Name: "bookstore", Namespace: "bookstore"},
+			Sources:     []accessV1alpha2.IdentityBindingSubject{{Kind: smi.ServiceAccountKind, Name: "bookbuyer", Namespace: "bookbuyer"}},
+			Rules:       []accessV1alpha2.TrafficTargetRule{{Kind: smi.HTTPRouteGroupKind, Name: "bookstore-routes", Matches: []string{"buy-a-book"}}},
+		},
+	}
+	v1alpha3Target := &accessV1alpha3.TrafficTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "bookstore-v1alpha3", Namespace: "bookstore"},
+		Spec: accessV1alpha3.TrafficTargetSpec{
+			Destination: accessV1alpha3.IdentityBindingSubject{Kind: smi.ServiceAccountKind, Name: "bookstore", Namespace: "bookstore"},
+			Sources:     []accessV1alpha3.IdentityBindingSubject{{Kind: smi.ServiceAccountKind, Name: "bookbuyer", Namespace: "bookbuyer"}},
+			Rules:       []accessV1alpha3.TrafficTargetRule{{Kind: smi.TCPRouteKind, Name: "bookstore-tcp"}},
+		},
+	}
+	unrelatedTarget := &accessV1alpha3.TrafficTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "bookstore-bookthief", Namespace: "bookstore"},
+		Spec: accessV1alpha3.TrafficTargetSpec{
+			Destination: accessV1alpha3.IdentityBindingSubject{Kind: smi.ServiceAccountKind, Name: "bookstore", Namespace: "bookstore"},
+			Sources:     []accessV1alpha3.IdentityBindingSubject{{Kind: smi.ServiceAccountKind, Name: "bookthief", Namespace: "bookthief"}},
+		},
+	}
+	accessClient := fakeAccessClient.NewSimpleClientset(v1alpha2Target, v1alpha3Target, unrelatedTarget)
+
+	tests := []struct {
+		name            string
+		osmVersion      version.ControllerVersion
+		expectedTargets []smi.TrafficTarget
+		expectedErr     error
+	}{
+		{
+			name:       "v1alpha2 TrafficTargets",
+			osmVersion: "v0.6",
+			expectedTargets: []smi.TrafficTarget{
+				{
+					Name:        "bookstore-v1alpha2",
+					Namespace:   "bookstore",
+					Destination: smi.IdentityBindingSubject{Kind: smi.ServiceAccountKind, Name: "bookstore", Namespace: "bookstore"},
+					Sources:     []smi.IdentityBindingSubject{{Kind: smi.ServiceAccountKind, Name: "bookbuyer", Namespace: "bookbuyer"}},
+					Rules:       []smi.TrafficTargetRule{{Kind: smi.HTTPRouteGroupKind, Name: "bookstore-routes", Matches: []string{"buy-a-book"}}},
+				},
+			},
+		},
+		{
+			name:       "v1alpha3 TrafficTargets",
+			osmVersion: "v0.9",
+			expectedTargets: []smi.TrafficTarget{
+				{
+					Name:        "bookstore-v1alpha3",
+					Namespace:   "bookstore",
+					Destination: smi.IdentityBindingSubject{Kind: smi.ServiceAccountKind, Name: "bookstore", Namespace: "bookstore"},
+					Sources:     []smi.IdentityBindingSubject{{Kind: smi.ServiceAccountKind, Name: "bookbuyer", Namespace: "bookbuyer"}},
+					Rules:       []smi.TrafficTargetRule{{Kind: smi.TCPRouteKind, Name: "bookstore-tcp"}},
+				},
+			},
+		},
+		{
+			name:        "unknown OSM version",
+			osmVersion:  "v-unknown",
+			expectedErr: ErrorUnsupportedTrafficTargetVersion,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			targets, err := GetMatchingTrafficTargets(context.TODO(), test.osmVersion, accessClient, srcPod, dstPod)
+			assert.Equal(test.expectedErr, err)
+			assert.Equal(test.expectedTargets, targets)
+		})
+	}
+}
+
+func TestDoesTargetMatchPods(t *testing.T) {
+	target := smi.TrafficTarget{
+		Destination: smi.IdentityBindingSubject{Kind: smi.ServiceAccountKind, Name: "bookstore", Namespace: "bookstore"},
+		Sources:     []smi.IdentityBindingSubject{{Kind: smi.ServiceAccountKind, Name: "bookbuyer", Namespace: "bookbuyer"}},
+	}
+
+	assert := tassert.New(t)
+	assert.True(DoesTargetMatchPods(target, srcPod, dstPod))
+	assert.False(DoesTargetMatchPods(target, dstPod, srcPod))
+
+	target.Sources[0].Kind = "Group"
+	assert.False(DoesTargetMatchPods(target, srcPod, dstPod))
+}
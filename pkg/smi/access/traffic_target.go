@@ -3,18 +3,18 @@ package access
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
+	mapset "github.com/deckarep/golang-set"
 	smiAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
 	"github.com/openservicemesh/osm-health/pkg/osm/version"
 	"github.com/openservicemesh/osm-health/pkg/runner"
-	"github.com/openservicemesh/osm-health/pkg/smi/access/v1alpha2"
-	"github.com/openservicemesh/osm-health/pkg/smi/access/v1alpha3"
-	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm-health/pkg/smi"
 )
 
 // Verify interface compliance
@@ -22,21 +22,21 @@ var _ runner.Runnable = (*TrafficTargetCheck)(nil)
 
 // TrafficTargetCheck implements common.Runnable
 type TrafficTargetCheck struct {
-	osmVersion   version.ControllerVersion
-	cfg          configurator.Configurator
-	srcPod       *corev1.Pod
-	dstPod       *corev1.Pod
-	accessClient smiAccessClient.Interface
+	osmVersion       version.ControllerVersion
+	meshConfigGetter config.MeshConfigGetter
+	srcPod           *corev1.Pod
+	dstPod           *corev1.Pod
+	accessClient     smiAccessClient.Interface
 }
 
 // NewTrafficTargetCheck creates a check of type TrafficTargetCheck which checks whether the src and dest pods are referenced as src and dest in a TrafficTarget (in that order)
-func NewTrafficTargetCheck(osmVersion version.ControllerVersion, osmConfigurator configurator.Configurator, srcPod *corev1.Pod, dstPod *corev1.Pod, smiAccessClient smiAccessClient.Interface) TrafficTargetCheck {
+func NewTrafficTargetCheck(osmVersion version.ControllerVersion, meshConfigGetter config.MeshConfigGetter, srcPod *corev1.Pod, dstPod *corev1.Pod, smiAccessClient smiAccessClient.Interface) TrafficTargetCheck {
 	return TrafficTargetCheck{
-		osmVersion:   osmVersion,
-		cfg:          osmConfigurator,
-		srcPod:       srcPod,
-		dstPod:       dstPod,
-		accessClient: smiAccessClient,
+		osmVersion:       osmVersion,
+		meshConfigGetter: meshConfigGetter,
+		srcPod:           srcPod,
+		dstPod:           dstPod,
+		accessClient:     smiAccessClient,
 	}
 }
 
@@ -50,33 +50,22 @@ func (check TrafficTargetCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (check TrafficTargetCheck) Run() outcomes.Outcome {
-	// Check if permissive mode is enabled, in which case every meshed pod is allowed to communicate with each other
-	if check.cfg.IsPermissiveTrafficPolicyMode() {
-		return outcomes.Info{Diagnostics: "OSM is in permissive traffic policy modes -- all meshed pods can communicate and SMI access policies are not applicable"}
+func (check TrafficTargetCheck) Run(ctx context.Context) outcomes.Outcome {
+	meshConfig, err := check.meshConfigGetter.GetMeshConfig()
+	if err != nil {
+		return outcomes.Fail{Error: err}
 	}
-	switch version.SupportedTrafficTarget[check.osmVersion] {
-	case version.V1Alpha2:
-		return check.runForTrafficTargetV1alpha2()
-	case version.V1Alpha3:
-		return check.runForTrafficTargetV1alpha3()
-	default:
-		return outcomes.Fail{Error: fmt.Errorf(
-			"OSM Controller version could not be mapped to a TrafficTarget version. Supported versions are v0.5 through v0.9")}
+	// Check if permissive mode is enabled, in which case every meshed pod is allowed to communicate with each other
+	if meshConfig.Spec.Traffic.EnablePermissiveTrafficPolicyMode {
+		return outcomes.Skipped{Reason: "OSM is in permissive traffic policy modes -- all meshed pods can communicate and SMI access policies are not applicable"}
 	}
-}
-
-func (check TrafficTargetCheck) runForTrafficTargetV1alpha2() outcomes.Outcome {
-	trafficTargets, err := check.accessClient.AccessV1alpha2().TrafficTargets(check.dstPod.Namespace).List(context.TODO(), metav1.ListOptions{})
+	trafficTargets, err := GetMatchingTrafficTargets(ctx, check.osmVersion, check.accessClient, check.srcPod, check.dstPod)
 	if err != nil {
-		log.Err(err).Msgf("Error getting TrafficTargets for namespace %s", check.dstPod.Namespace)
 		return outcomes.Fail{Error: err}
 	}
 	var matchingTargetNames []string
-	for _, trafficTarget := range trafficTargets.Items {
-		if v1alpha2.DoesTargetMatchPods(trafficTarget.Spec, check.srcPod, check.dstPod) {
-			matchingTargetNames = append(matchingTargetNames, trafficTarget.Name)
-		}
+	for _, trafficTarget := range trafficTargets {
+		matchingTargetNames = append(matchingTargetNames, trafficTarget.Name)
 	}
 	if len(matchingTargetNames) > 0 {
 		return outcomes.Info{Diagnostics: fmt.Sprintf(
@@ -88,41 +77,49 @@ func (check TrafficTargetCheck) runForTrafficTargetV1alpha2() outcomes.Outcome {
 			strings.Join(matchingTargetNames, ", ")),
 		}
 	}
-	return outcomes.Info{Diagnostics: fmt.Sprintf(
+	diagnostics := fmt.Sprintf(
 		"Pod '%s/%s' is not allowed to communicate to pod '%s/%s' via any SMI TrafficTarget policy\n",
 		check.srcPod.Namespace,
 		check.srcPod.Name,
 		check.dstPod.Namespace,
-		check.dstPod.Name)}
-}
+		check.dstPod.Name)
 
-func (check TrafficTargetCheck) runForTrafficTargetV1alpha3() outcomes.Outcome {
-	trafficTargets, err := check.accessClient.AccessV1alpha3().TrafficTargets(check.dstPod.Namespace).List(context.TODO(), metav1.ListOptions{})
+	// TrafficTargets in the destination namespace that do not match usually reference the wrong service accounts.
+	namespaceTargets, err := GetTrafficTargets(ctx, check.osmVersion, check.accessClient, check.dstPod.Namespace)
 	if err != nil {
-		log.Err(err).Msgf("Error getting TrafficTargets for namespace %s", check.dstPod.Namespace)
 		return outcomes.Fail{Error: err}
 	}
-	var matchingTargetNames []string
-	for _, trafficTarget := range trafficTargets.Items {
-		if v1alpha3.DoesTargetMatchPods(trafficTarget.Spec, check.srcPod, check.dstPod) {
-			matchingTargetNames = append(matchingTargetNames, trafficTarget.Name)
-		}
-	}
-	if len(matchingTargetNames) > 0 {
-		return outcomes.Info{Diagnostics: fmt.Sprintf(
-			"Pod '%s/%s' is allowed to communicate to pod '%s/%s' via SMI TrafficTarget policy/policies %s\n",
+	if len(namespaceTargets) > 0 {
+		diagnostics += fmt.Sprintf(
+			"TrafficTarget(s) in namespace '%s' reference service accounts %s, but pod '%s/%s' uses service account '%s' and pod '%s/%s' uses service account '%s'\n",
+			check.dstPod.Namespace,
+			strings.Join(getReferencedServiceAccounts(namespaceTargets), ", "),
 			check.srcPod.Namespace,
 			check.srcPod.Name,
+			check.srcPod.Spec.ServiceAccountName,
 			check.dstPod.Namespace,
 			check.dstPod.Name,
-			strings.Join(matchingTargetNames, ", "))}
+			check.dstPod.Spec.ServiceAccountName)
 	}
-	return outcomes.Info{Diagnostics: fmt.Sprintf(
-		"Pod '%s/%s' is not allowed to communicate to pod '%s/%s' via any SMI TrafficTarget policy\n",
-		check.srcPod.Namespace,
-		check.srcPod.Name,
-		check.dstPod.Namespace,
-		check.dstPod.Name)}
+	return outcomes.Info{Diagnostics: diagnostics}
+}
+
+// getReferencedServiceAccounts returns the sorted namespace/name of the service accounts referenced by the TrafficTargets
+func getReferencedServiceAccounts(trafficTargets []smi.TrafficTarget) []string {
+	serviceAccounts := mapset.NewSet()
+	for _, trafficTarget := range trafficTargets {
+		for _, subject := range append([]smi.IdentityBindingSubject{trafficTarget.Destination}, trafficTarget.Sources...) {
+			if subject.Kind == smi.ServiceAccountKind {
+				serviceAccounts.Add(fmt.Sprintf("'%s/%s'", subject.Namespace, subject.Name))
+			}
+		}
+	}
+	var names []string
+	for serviceAccount := range serviceAccounts.Iter() {
+		names = append(names, serviceAccount.(string))
+	}
+	sort.Strings(names)
+	return names
 }
 
 // Suggestion implements common.Runnable
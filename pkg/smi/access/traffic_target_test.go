@@ -0,0 +1,94 @@
+package access
+
+import (
+	"context"
+	"testing"
+
+	accessV1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha3"
+	fakeAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned/fake"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/config"
+	"github.com/openservicemesh/osm-health/pkg/smi"
+	configv1alpha1 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha1"
+)
+
+func TestTrafficTargetCheck(t *testing.T) {
+	trafficTarget := &accessV1alpha3.TrafficTarget{
+		ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "bookstore"},
+		Spec: accessV1alpha3.TrafficTargetSpec{
+			Destination: accessV1alpha3.IdentityBindingSubject{Kind: smi.ServiceAccountKind, Name: "bookstore", Namespace: "bookstore"},
+			Sources:     []accessV1alpha3.IdentityBindingSubject{{Kind: smi.ServiceAccountKind, Name: "bookbuyer", Namespace: "bookbuyer"}},
+		},
+	}
+	newMeshConfig := func(permissive bool) *configv1alpha1.MeshConfig {
+		return &configv1alpha1.MeshConfig{Spec: configv1alpha1.MeshConfigSpec{
+			Traffic: configv1alpha1.TrafficSpec{EnablePermissiveTrafficPolicyMode: permissive},
+		}}
+	}
+
+	tests := []struct {
+		name            string
+		objects         []runtime.Object
+		meshConfig      *configv1alpha1.MeshConfig
+		expectedOutcome outcomes.Outcome
+		expectedErr     error
+	}{
+		{
+			name:            "permissive traffic policy mode",
+			meshConfig:      newMeshConfig(true),
+			expectedOutcome: outcomes.Skipped{},
+		},
+		{
+			name:            "TrafficTarget allows the source pod",
+			objects:         []runtime.Object{trafficTarget},
+			meshConfig:      newMeshConfig(false),
+			expectedOutcome: outcomes.Info{},
+		},
+		{
+			name:            "MeshConfig is unavailable",
+			objects:         []runtime.Object{trafficTarget},
+			expectedOutcome: outcomes.Fail{},
+			expectedErr:     config.ErrMeshConfigUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			check := NewTrafficTargetCheck("v0.10", config.NewSnapshot(test.meshConfig), srcPod, dstPod, fakeAccessClient.NewSimpleClientset(test.objects...))
+			outcome := check.Run(context.TODO())
+			assert.IsType(test.expectedOutcome, outcome)
+			assert.ErrorIs(outcome.GetError(), test.expectedErr)
+		})
+	}
+}
+
+func TestGetReferencedServiceAccounts(t *testing.T) {
+	assert := tassert.New(t)
+	trafficTargets := []smi.TrafficTarget{
+		{
+			Name:        "bookstore",
+			Namespace:   "bookstore",
+			Destination: smi.IdentityBindingSubject{Kind: smi.ServiceAccountKind, Name: "bookstore", Namespace: "bookstore"},
+			Sources: []smi.IdentityBindingSubject{
+				{Kind: smi.ServiceAccountKind, Name: "bookbuyer", Namespace: "bookbuyer"},
+				{Kind: "Group", Name: "admins", Namespace: "bookbuyer"},
+			},
+		},
+		{
+			Name:        "bookstore-thief",
+			Namespace:   "bookstore",
+			Destination: smi.IdentityBindingSubject{Kind: smi.ServiceAccountKind, Name: "bookstore", Namespace: "bookstore"},
+			Sources: []smi.IdentityBindingSubject{
+				{Kind: smi.ServiceAccountKind, Name: "bookthief", Namespace: "bookthief"},
+			},
+		},
+	}
+
+	assert.Equal([]string{"'bookbuyer/bookbuyer'", "'bookstore/bookstore'", "'bookthief/bookthief'"}, getReferencedServiceAccounts(trafficTargets))
+	assert.Empty(getReferencedServiceAccounts(nil))
+}
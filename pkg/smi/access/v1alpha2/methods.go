@@ -3,59 +3,50 @@ package v1alpha2
 import (
 	"context"
 
-	mapset "github.com/deckarep/golang-set"
-	accessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha2"
-	smiSpecClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned"
-	corev1 "k8s.io/api/core/v1"
+	access "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha2"
+	smiAccessClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/access/clientset/versioned"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-)
 
-const (
-	serviceAccountKind = "ServiceAccount"
+	"github.com/openservicemesh/osm-health/pkg/smi"
 )
 
-// DoesTargetMatchPods checks whether a given TrafficTarget has dstPod as its destination as dstPod and srcPod as an allowed source to this destination
-func DoesTargetMatchPods(spec accessClient.TrafficTargetSpec, srcPod *corev1.Pod, dstPod *corev1.Pod) bool {
-	return doesTargetRefDstPod(spec, dstPod) && doesTargetRefSrcPod(spec, srcPod)
-}
-
-// doesTargetRefDstPod checks whether the TrafficTarget spec refers to the destination pod's service account
-func doesTargetRefDstPod(spec accessClient.TrafficTargetSpec, dstPod *corev1.Pod) bool {
-	if spec.Destination.Kind != serviceAccountKind {
-		return false
+// GetTrafficTargets returns the TrafficTargets in the given namespace
+func GetTrafficTargets(ctx context.Context, accessClient smiAccessClient.Interface, namespace string) ([]smi.TrafficTarget, error) {
+	trafficTargets, err := accessClient.AccessV1alpha2().TrafficTargets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Err(err).Msgf("Error getting TrafficTargets for namespace %s", namespace)
+		return nil, err
 	}
-	return spec.Destination.Name == dstPod.Spec.ServiceAccountName && spec.Destination.Namespace == dstPod.Namespace
-}
-
-// doesTargetRefSrcPod checks whether the TrafficTarget spec refers to the source pod's service account
-func doesTargetRefSrcPod(spec accessClient.TrafficTargetSpec, srcPod *corev1.Pod) bool {
-	for _, source := range spec.Sources {
-		if source.Kind != serviceAccountKind {
-			continue
-		}
-		if source.Name == srcPod.Spec.ServiceAccountName && source.Namespace == srcPod.Namespace {
-			return true
-		}
+	var targets []smi.TrafficTarget
+	for _, trafficTarget := range trafficTargets.Items {
+		targets = append(targets, toTrafficTarget(trafficTarget))
 	}
-	return false
+	return targets, nil
 }
 
-// GetExistingRouteNames returns the names of HTTPRouteGroups and TCPRoutes that exist in the cluster
-func GetExistingRouteNames(specClient smiSpecClient.Interface, namespace string) (mapset.Set, error) {
-	routes := mapset.NewSet()
-	httpRouteGroups, err := specClient.SpecsV1alpha3().HTTPRouteGroups(namespace).List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
-		log.Err(err).Msgf("Error getting HTTPRouteGroups for namespace %s", namespace)
+func toTrafficTarget(trafficTarget access.TrafficTarget) smi.TrafficTarget {
+	target := smi.TrafficTarget{
+		Name:        trafficTarget.Name,
+		Namespace:   trafficTarget.Namespace,
+		Destination: toIdentityBindingSubject(trafficTarget.Spec.Destination),
 	}
-	for _, httpRouteGroup := range httpRouteGroups.Items {
-		routes.Add(httpRouteGroup.Name)
+	for _, source := range trafficTarget.Spec.Sources {
+		target.Sources = append(target.Sources, toIdentityBindingSubject(source))
 	}
-	tcpRoutes, err := specClient.SpecsV1alpha3().TCPRoutes(namespace).List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
-		log.Err(err).Msgf("Error getting HTTPRouteGroups for namespace %s", namespace)
+	for _, rule := range trafficTarget.Spec.Rules {
+		target.Rules = append(target.Rules, smi.TrafficTargetRule{
+			Kind:    rule.Kind,
+			Name:    rule.Name,
+			Matches: rule.Matches,
+		})
 	}
-	for _, tcpRoute := range tcpRoutes.Items {
-		routes.Add(tcpRoute.Name)
+	return target
+}
+
+func toIdentityBindingSubject(subject access.IdentityBindingSubject) smi.IdentityBindingSubject {
+	return smi.IdentityBindingSubject{
+		Kind:      subject.Kind,
+		Name:      subject.Name,
+		Namespace: subject.Namespace,
 	}
-	return routes, err
 }
@@ -6,4 +6,7 @@ const (
 
 	// TCPRouteKind is the Kind for TCPRoute
 	TCPRouteKind = "TCPRoute"
+
+	// ServiceAccountKind is the Kind for the ServiceAccount identities referenced by TrafficTargets
+	ServiceAccountKind = "ServiceAccount"
 )
@@ -1,8 +1,8 @@
 package smi
 
-import "errors"
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
 
 var (
 	// ErrInvalidRuleKind is an error returned when the TrafficTarget has an unexpected rule kind.
-	ErrInvalidRuleKind = errors.New("unsupported rule kind in TrafficTarget")
+	ErrInvalidRuleKind = outcomes.NewError("INVALID_RULE_KIND", "unsupported rule kind in TrafficTarget")
 )
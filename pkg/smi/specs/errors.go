@@ -0,0 +1,8 @@
+package specs
+
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
+
+var (
+	// ErrHTTPRouteVersionUnsupported is returned when the OSM Controller version cannot be mapped to an HTTPRouteGroup version.
+	ErrHTTPRouteVersionUnsupported = outcomes.NewError("HTTP_ROUTE_VERSION_UNSUPPORTED", "OSM Controller version could not be mapped to a supported HTTPRouteGroup version")
+)
@@ -0,0 +1,85 @@
+package specs
+
+import (
+	"context"
+
+	mapset "github.com/deckarep/golang-set"
+	smiSpecClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned"
+
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/smi"
+	"github.com/openservicemesh/osm-health/pkg/smi/specs/v1alpha2"
+	"github.com/openservicemesh/osm-health/pkg/smi/specs/v1alpha3"
+	"github.com/openservicemesh/osm-health/pkg/smi/specs/v1alpha4"
+)
+
+// getHTTPRouteVersion returns the preferred HTTPRouteGroup version supported by osmVersion
+func getHTTPRouteVersion(osmVersion version.ControllerVersion) version.HTTPRouteVersion {
+	supportedVersions := version.SupportedHTTPRouteVersion[osmVersion]
+	if len(supportedVersions) == 0 {
+		return ""
+	}
+	return supportedVersions[0]
+}
+
+// GetHTTPRouteGroups returns the HTTPRouteGroups in the given namespace, read with the HTTPRouteGroup version supported by osmVersion
+func GetHTTPRouteGroups(ctx context.Context, osmVersion version.ControllerVersion, specClient smiSpecClient.Interface, namespace string) ([]smi.HTTPRouteGroup, error) {
+	switch getHTTPRouteVersion(osmVersion) {
+	case version.V1Alpha2:
+		return v1alpha2.GetHTTPRouteGroups(ctx, specClient, namespace)
+	case version.V1Alpha3:
+		return v1alpha3.GetHTTPRouteGroups(ctx, specClient, namespace)
+	case version.V1Alpha4:
+		return v1alpha4.GetHTTPRouteGroups(ctx, specClient, namespace)
+	default:
+		return nil, ErrHTTPRouteVersionUnsupported
+	}
+}
+
+// GetHTTPRouteGroup returns the HTTPRouteGroup with the given namespace and name, read with the HTTPRouteGroup version supported by osmVersion
+func GetHTTPRouteGroup(ctx context.Context, osmVersion version.ControllerVersion, specClient smiSpecClient.Interface, namespace string, name string) (smi.HTTPRouteGroup, error) {
+	switch getHTTPRouteVersion(osmVersion) {
+	case version.V1Alpha2:
+		return v1alpha2.GetHTTPRouteGroup(ctx, specClient, namespace, name)
+	case version.V1Alpha3:
+		return v1alpha3.GetHTTPRouteGroup(ctx, specClient, namespace, name)
+	case version.V1Alpha4:
+		return v1alpha4.GetHTTPRouteGroup(ctx, specClient, namespace, name)
+	default:
+		return smi.HTTPRouteGroup{}, ErrHTTPRouteVersionUnsupported
+	}
+}
+
+// GetTCPRouteNames returns the names of the TCPRoutes in the given namespace, read with the spec version supported by osmVersion
+func GetTCPRouteNames(ctx context.Context, osmVersion version.ControllerVersion, specClient smiSpecClient.Interface, namespace string) ([]string, error) {
+	switch getHTTPRouteVersion(osmVersion) {
+	case version.V1Alpha2:
+		return v1alpha2.GetTCPRouteNames(ctx, specClient, namespace)
+	case version.V1Alpha3:
+		return v1alpha3.GetTCPRouteNames(ctx, specClient, namespace)
+	case version.V1Alpha4:
+		return v1alpha4.GetTCPRouteNames(ctx, specClient, namespace)
+	default:
+		return nil, ErrHTTPRouteVersionUnsupported
+	}
+}
+
+// GetExistingRouteNames returns the names of HTTPRouteGroups and TCPRoutes that exist in the given namespace
+func GetExistingRouteNames(ctx context.Context, osmVersion version.ControllerVersion, specClient smiSpecClient.Interface, namespace string) (mapset.Set, error) {
+	routes := mapset.NewSet()
+	httpRouteGroups, err := GetHTTPRouteGroups(ctx, osmVersion, specClient, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, httpRouteGroup := range httpRouteGroups {
+		routes.Add(httpRouteGroup.Name)
+	}
+	tcpRouteNames, err := GetTCPRouteNames(ctx, osmVersion, specClient, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, tcpRouteName := range tcpRouteNames {
+		routes.Add(tcpRouteName)
+	}
+	return routes, nil
+}
@@ -0,0 +1,73 @@
+package specs
+
+import (
+	"context"
+	"testing"
+
+	specsV1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha3"
+	specsV1alpha4 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+	fakeSpecClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned/fake"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+)
+
+func TestGetExistingRouteNames(t *testing.T) {
+	specClient := fakeSpecClient.NewSimpleClientset(
+		&specsV1alpha3.HTTPRouteGroup{ObjectMeta: metav1.ObjectMeta{Name: "http-v1alpha3", Namespace: "bookstore"}},
+		&specsV1alpha3.TCPRoute{ObjectMeta: metav1.ObjectMeta{Name: "tcp-v1alpha3", Namespace: "bookstore"}},
+		&specsV1alpha4.HTTPRouteGroup{ObjectMeta: metav1.ObjectMeta{Name: "http-v1alpha4", Namespace: "bookstore"}},
+		&specsV1alpha4.TCPRoute{ObjectMeta: metav1.ObjectMeta{Name: "tcp-v1alpha4", Namespace: "bookstore"}},
+	)
+
+	tests := []struct {
+		name           string
+		osmVersion     version.ControllerVersion
+		expectedRoutes []interface{}
+		expectedErr    error
+	}{
+		{
+			name:           "v1alpha3 routes",
+			osmVersion:     "v0.6",
+			expectedRoutes: []interface{}{"http-v1alpha3", "tcp-v1alpha3"},
+		},
+		{
+			name:           "v1alpha4 routes",
+			osmVersion:     "v0.9",
+			expectedRoutes: []interface{}{"http-v1alpha4", "tcp-v1alpha4"},
+		},
+		{
+			name:        "unknown OSM version",
+			osmVersion:  "v-unknown",
+			expectedErr: ErrHTTPRouteVersionUnsupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			routes, err := GetExistingRouteNames(context.TODO(), test.osmVersion, specClient, "bookstore")
+			assert.Equal(test.expectedErr, err)
+			if test.expectedErr == nil {
+				assert.ElementsMatch(test.expectedRoutes, routes.ToSlice())
+			}
+		})
+	}
+}
+
+func TestGetHTTPRouteGroup(t *testing.T) {
+	assert := tassert.New(t)
+	specClient := fakeSpecClient.NewSimpleClientset(&specsV1alpha4.HTTPRouteGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "bookstore-routes", Namespace: "bookstore"},
+		Spec: specsV1alpha4.HTTPRouteGroupSpec{
+			Matches: []specsV1alpha4.HTTPMatch{{Name: "buy-a-book", PathRegex: ".*a-book.*new", Methods: []string{"GET"}}},
+		},
+	})
+
+	group, err := GetHTTPRouteGroup(context.TODO(), "v0.9", specClient, "bookstore", "bookstore-routes")
+	assert.NoError(err)
+	assert.Len(group.Matches, 1)
+	assert.Equal("bookstore-routes/buy-a-book", group.Matches[0].String())
+	assert.Equal(".*a-book.*new", group.Matches[0].PathRegex)
+}
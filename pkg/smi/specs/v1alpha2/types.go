@@ -0,0 +1,5 @@
+package v1alpha2
+
+import "github.com/openservicemesh/osm-health/pkg/logger"
+
+var log = logger.New("specs/v1alpha2")
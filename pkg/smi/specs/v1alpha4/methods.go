@@ -0,0 +1,66 @@
+package v1alpha4
+
+import (
+	"context"
+
+	specs "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha4"
+	smiSpecClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/smi"
+)
+
+// GetHTTPRouteGroups returns the HTTPRouteGroups in the given namespace
+func GetHTTPRouteGroups(ctx context.Context, specClient smiSpecClient.Interface, namespace string) ([]smi.HTTPRouteGroup, error) {
+	httpRouteGroups, err := specClient.SpecsV1alpha4().HTTPRouteGroups(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Err(err).Msgf("Error getting HTTPRouteGroups for namespace %s", namespace)
+		return nil, err
+	}
+	var groups []smi.HTTPRouteGroup
+	for _, httpRouteGroup := range httpRouteGroups.Items {
+		groups = append(groups, toHTTPRouteGroup(httpRouteGroup))
+	}
+	return groups, nil
+}
+
+// GetHTTPRouteGroup returns the HTTPRouteGroup with the given namespace and name
+func GetHTTPRouteGroup(ctx context.Context, specClient smiSpecClient.Interface, namespace string, name string) (smi.HTTPRouteGroup, error) {
+	httpRouteGroup, err := specClient.SpecsV1alpha4().HTTPRouteGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Err(err).Msgf("Error getting HTTPRouteGroup %s/%s", namespace, name)
+		return smi.HTTPRouteGroup{}, err
+	}
+	return toHTTPRouteGroup(*httpRouteGroup), nil
+}
+
+// GetTCPRouteNames returns the names of the TCPRoutes in the given namespace
+func GetTCPRouteNames(ctx context.Context, specClient smiSpecClient.Interface, namespace string) ([]string, error) {
+	tcpRoutes, err := specClient.SpecsV1alpha4().TCPRoutes(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Err(err).Msgf("Error getting TCPRoutes for namespace %s", namespace)
+		return nil, err
+	}
+	var names []string
+	for _, tcpRoute := range tcpRoutes.Items {
+		names = append(names, tcpRoute.Name)
+	}
+	return names, nil
+}
+
+func toHTTPRouteGroup(httpRouteGroup specs.HTTPRouteGroup) smi.HTTPRouteGroup {
+	group := smi.HTTPRouteGroup{
+		Name:      httpRouteGroup.Name,
+		Namespace: httpRouteGroup.Namespace,
+	}
+	for _, match := range httpRouteGroup.Spec.Matches {
+		group.Matches = append(group.Matches, smi.HTTPRouteMatch{
+			RouteGroup: httpRouteGroup.Name,
+			Name:       match.Name,
+			PathRegex:  match.PathRegex,
+			Methods:    match.Methods,
+			Headers:    match.Headers,
+		})
+	}
+	return group
+}
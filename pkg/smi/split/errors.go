@@ -0,0 +1,32 @@
+package split
+
+import "github.com/openservicemesh/osm-health/pkg/common/outcomes"
+
+var (
+	// ErrTrafficSplitVersionUnsupported is returned when the OSM Controller version cannot be mapped to a TrafficSplit version.
+	ErrTrafficSplitVersionUnsupported = outcomes.NewError("TRAFFIC_SPLIT_VERSION_UNSUPPORTED", "OSM Controller version could not be mapped to a TrafficSplit version")
+
+	// ErrRootServiceNotFound is returned when the root service of a TrafficSplit does not exist.
+	ErrRootServiceNotFound = outcomes.NewError("ROOT_SERVICE_NOT_FOUND", "TrafficSplit root service not found")
+
+	// ErrRootServiceConflictingEndpoints is returned when the root service of a TrafficSplit selects pods that are not backends of the TrafficSplit.
+	ErrRootServiceConflictingEndpoints = outcomes.NewError("ROOT_SERVICE_CONFLICTING_ENDPOINTS", "TrafficSplit root service has endpoints that do not belong to any backend")
+
+	// ErrBackendServiceNotFound is returned when a backend service of a TrafficSplit does not exist.
+	ErrBackendServiceNotFound = outcomes.NewError("BACKEND_SERVICE_NOT_FOUND", "TrafficSplit backend service not found")
+
+	// ErrBackendNoReadyEndpoints is returned when a backend service of a TrafficSplit does not have ready endpoints.
+	ErrBackendNoReadyEndpoints = outcomes.NewError("BACKEND_NO_READY_ENDPOINTS", "TrafficSplit backend service has no ready endpoints")
+
+	// ErrNegativeBackendWeight is returned when a backend of a TrafficSplit has a negative weight.
+	ErrNegativeBackendWeight = outcomes.NewError("NEGATIVE_BACKEND_WEIGHT", "TrafficSplit backend has a negative weight")
+
+	// ErrAllBackendWeightsZero is returned when all backends of a TrafficSplit have a zero weight.
+	ErrAllBackendWeightsZero = outcomes.NewError("ALL_BACKEND_WEIGHTS_ZERO", "all TrafficSplit backend weights are zero")
+
+	// ErrUnsupportedMatchKind is returned when a TrafficSplit match references a route that is not an HTTPRouteGroup.
+	ErrUnsupportedMatchKind = outcomes.NewError("UNSUPPORTED_MATCH_KIND", "TrafficSplit match references an unsupported route kind")
+
+	// ErrMatchNotFound is returned when a TrafficSplit match references an HTTPRouteGroup that does not exist.
+	ErrMatchNotFound = outcomes.NewError("MATCH_NOT_FOUND", "HTTPRouteGroup referenced by TrafficSplit match not found")
+)
@@ -0,0 +1,67 @@
+package split
+
+import (
+	"context"
+
+	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/kubernetes/pod"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/smi"
+	"github.com/openservicemesh/osm-health/pkg/smi/split/v1alpha2"
+	"github.com/openservicemesh/osm-health/pkg/smi/split/v1alpha3"
+	"github.com/openservicemesh/osm-health/pkg/smi/split/v1alpha4"
+)
+
+// GetTrafficSplits returns the TrafficSplits in the given namespace, read with the TrafficSplit version supported by osmVersion
+func GetTrafficSplits(ctx context.Context, osmVersion version.ControllerVersion, splitClient smiSplitClient.Interface, namespace string) ([]smi.TrafficSplit, error) {
+	switch version.SupportedTrafficSplit[osmVersion] {
+	case version.V1Alpha2:
+		return v1alpha2.GetTrafficSplits(ctx, splitClient, namespace)
+	case version.V1Alpha3:
+		return v1alpha3.GetTrafficSplits(ctx, splitClient, namespace)
+	case version.V1Alpha4:
+		return v1alpha4.GetTrafficSplits(ctx, splitClient, namespace)
+	default:
+		return nil, ErrTrafficSplitVersionUnsupported
+	}
+}
+
+// GetTrafficSplitsForPod returns the TrafficSplits in which one of the pod's services is the root service or a backend
+func GetTrafficSplitsForPod(ctx context.Context, osmVersion version.ControllerVersion, client kubernetes.Interface, splitClient smiSplitClient.Interface, p *corev1.Pod) ([]smi.TrafficSplit, error) {
+	services, err := pod.GetMatchingServices(ctx, client, p.ObjectMeta.GetLabels(), p.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	serviceNames := make(map[string]struct{}, len(services))
+	for _, svc := range services {
+		serviceNames[svc.Name] = struct{}{}
+	}
+
+	trafficSplits, err := GetTrafficSplits(ctx, osmVersion, splitClient, p.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var podSplits []smi.TrafficSplit
+	for _, trafficSplit := range trafficSplits {
+		if isServiceInTrafficSplit(trafficSplit, serviceNames) {
+			podSplits = append(podSplits, trafficSplit)
+		}
+	}
+	return podSplits, nil
+}
+
+func isServiceInTrafficSplit(trafficSplit smi.TrafficSplit, serviceNames map[string]struct{}) bool {
+	if _, ok := serviceNames[trafficSplit.Service]; ok {
+		return true
+	}
+	for _, backend := range trafficSplit.Backends {
+		if _, ok := serviceNames[backend.Service]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package split
+
+import (
+	"context"
+	"testing"
+
+	splitV1alpha2 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
+	splitV1alpha4 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
+	fakeSmiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/fake"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/smi"
+)
+
+func TestGetTrafficSplits(t *testing.T) {
+	// No OSM release maps to TrafficSplit v1alpha4 yet, so register a fake release for this test.
+	const v1alpha4Release version.ControllerVersion = "v-test-split-v1alpha4"
+	version.SupportedTrafficSplit[v1alpha4Release] = version.V1Alpha4
+	defer delete(version.SupportedTrafficSplit, v1alpha4Release)
+
+	splitClient := fakeSmiSplitClient.NewSimpleClientset(
+		&splitV1alpha2.TrafficSplit{
+			ObjectMeta: metav1.ObjectMeta{Name: "bookstore-split", Namespace: "bookstore"},
+			Spec: splitV1alpha2.TrafficSplitSpec{
+				Service:  "bookstore",
+				Backends: []splitV1alpha2.TrafficSplitBackend{{Service: "bookstore-v1", Weight: 100}},
+			},
+		},
+		&splitV1alpha4.TrafficSplit{
+			ObjectMeta: metav1.ObjectMeta{Name: "bookstore-canary", Namespace: "bookstore"},
+			Spec: splitV1alpha4.TrafficSplitSpec{
+				Service:  "bookstore",
+				Backends: []splitV1alpha4.TrafficSplitBackend{{Service: "bookstore-v2", Weight: 10}},
+				Matches:  []corev1.TypedLocalObjectReference{{Kind: smi.HTTPRouteGroupKind, Name: "canary-routes"}},
+			},
+		},
+	)
+
+	tests := []struct {
+		name           string
+		osmVersion     version.ControllerVersion
+		expectedSplits []smi.TrafficSplit
+		expectedErr    error
+	}{
+		{
+			name:       "v1alpha2 TrafficSplits",
+			osmVersion: "v0.9",
+			expectedSplits: []smi.TrafficSplit{{
+				Name:      "bookstore-split",
+				Namespace: "bookstore",
+				Service:   "bookstore",
+				Backends:  []smi.TrafficSplitBackend{{Service: "bookstore-v1", Weight: 100}},
+			}},
+		},
+		{
+			name:       "v1alpha4 TrafficSplits with matches",
+			osmVersion: v1alpha4Release,
+			expectedSplits: []smi.TrafficSplit{{
+				Name:      "bookstore-canary",
+				Namespace: "bookstore",
+				Service:   "bookstore",
+				Backends:  []smi.TrafficSplitBackend{{Service: "bookstore-v2", Weight: 10}},
+				Matches:   []smi.TrafficSplitMatch{{Kind: smi.HTTPRouteGroupKind, Name: "canary-routes"}},
+			}},
+		},
+		{
+			name:        "unknown OSM version",
+			osmVersion:  "v-unknown",
+			expectedErr: ErrTrafficSplitVersionUnsupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			splits, err := GetTrafficSplits(context.TODO(), test.osmVersion, splitClient, "bookstore")
+			assert.Equal(test.expectedErr, err)
+			assert.Equal(test.expectedSplits, splits)
+		})
+	}
+}
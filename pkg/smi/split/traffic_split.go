@@ -6,7 +6,6 @@ import (
 
 	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
@@ -42,19 +41,9 @@ func (check TrafficSplitCheck) Description() string {
 }
 
 // Run implements common.Runnable
-func (check TrafficSplitCheck) Run() outcomes.Outcome {
-	switch version.SupportedTrafficSplit[check.osmVersion] {
-	case version.V1Alpha2:
-		return check.runForTrafficSplitV1alpha2()
-	default:
-		return outcomes.Fail{Error: fmt.Errorf(
-			"OSM Controller version could not be mapped to a TrafficSplit version. Supported versions are v0.5 through v0.9")}
-	}
-}
-
-func (check TrafficSplitCheck) runForTrafficSplitV1alpha2() outcomes.Outcome {
+func (check TrafficSplitCheck) Run(ctx context.Context) outcomes.Outcome {
 	ns := check.pod.Namespace
-	services, err := pod.GetMatchingServices(check.client, check.pod.ObjectMeta.GetLabels(), ns)
+	services, err := pod.GetMatchingServices(ctx, check.client, check.pod.ObjectMeta.GetLabels(), ns)
 	if err != nil {
 		return outcomes.Fail{Error: err}
 	}
@@ -62,17 +51,16 @@ func (check TrafficSplitCheck) runForTrafficSplitV1alpha2() outcomes.Outcome {
 		return outcomes.Info{Diagnostics: fmt.Sprintf("pod '%s/%s' does not have a corresponding service", ns, check.pod.Name)}
 	}
 
-	trafficSplits, err := check.splitClient.SplitV1alpha2().TrafficSplits(ns).List(context.TODO(), metav1.ListOptions{})
+	trafficSplits, err := GetTrafficSplits(ctx, check.osmVersion, check.splitClient, ns)
 	if err != nil {
 		return outcomes.Fail{Error: err}
 	}
-	for _, trafficSplit := range trafficSplits.Items {
-		spec := trafficSplit.Spec
-		for _, backend := range spec.Backends {
+	for _, trafficSplit := range trafficSplits {
+		for _, backend := range trafficSplit.Backends {
 			for _, svc := range services {
 				if backend.Service == svc.Name {
 					return outcomes.Info{
-						Diagnostics: fmt.Sprintf("pod '%s/%s' participates in traffic split for service '%s/%s'", ns, check.pod.Name, ns, spec.Service),
+						Diagnostics: fmt.Sprintf("pod '%s/%s' participates in traffic split for service '%s/%s'", ns, check.pod.Name, ns, trafficSplit.Service),
 					}
 				}
 			}
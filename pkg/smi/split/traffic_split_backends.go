@@ -0,0 +1,88 @@
+package split
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*TrafficSplitBackendsCheck)(nil)
+
+// TrafficSplitBackendsCheck implements common.Runnable
+type TrafficSplitBackendsCheck struct {
+	osmVersion  version.ControllerVersion
+	client      kubernetes.Interface
+	pod         *corev1.Pod
+	splitClient smiSplitClient.Interface
+}
+
+// NewTrafficSplitBackendsCheck creates a TrafficSplitBackendsCheck which checks whether every backend service of
+// every TrafficSplit the pod participates in exists and has ready endpoints
+func NewTrafficSplitBackendsCheck(osmVersion version.ControllerVersion, client kubernetes.Interface, pod *corev1.Pod, splitClient smiSplitClient.Interface) TrafficSplitBackendsCheck {
+	return TrafficSplitBackendsCheck{
+		osmVersion:  osmVersion,
+		client:      client,
+		pod:         pod,
+		splitClient: splitClient,
+	}
+}
+
+// Description implements common.Runnable
+func (check TrafficSplitBackendsCheck) Description() string {
+	return fmt.Sprintf("Checking whether the backends of traffic splits for pod %s/%s have ready endpoints", check.pod.Namespace, check.pod.Name)
+}
+
+// Run implements common.Runnable
+func (check TrafficSplitBackendsCheck) Run(ctx context.Context) outcomes.Outcome {
+	trafficSplits, err := GetTrafficSplitsForPod(ctx, check.osmVersion, check.client, check.splitClient, check.pod)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	if len(trafficSplits) == 0 {
+		return outcomes.Info{Diagnostics: fmt.Sprintf("pod '%s/%s' does not participate in any traffic split", check.pod.Namespace, check.pod.Name)}
+	}
+
+	for _, trafficSplit := range trafficSplits {
+		ns := trafficSplit.Namespace
+		for _, backend := range trafficSplit.Backends {
+			_, err := check.client.CoreV1().Services(ns).Get(ctx, backend.Service, metav1.GetOptions{})
+			if k8sErrors.IsNotFound(err) {
+				return outcomes.Fail{Error: errors.Wrapf(ErrBackendServiceNotFound, "backend service '%s/%s' of traffic split %s", ns, backend.Service, trafficSplit)}
+			}
+			if err != nil {
+				return outcomes.Fail{Error: err}
+			}
+
+			addresses, err := getEndpointAddresses(ctx, check.client, ns, backend.Service, true)
+			if err != nil {
+				return outcomes.Fail{Error: err}
+			}
+			if len(addresses) == 0 {
+				return outcomes.Fail{Error: errors.Wrapf(ErrBackendNoReadyEndpoints, "backend service '%s/%s' of traffic split %s", ns, backend.Service, trafficSplit)}
+			}
+		}
+	}
+
+	return outcomes.Pass{}
+}
+
+// Suggestion implements common.Runnable
+func (check TrafficSplitBackendsCheck) Suggestion() string {
+	return fmt.Sprintf("Check that each TrafficSplit backend service exists and selects ready pods. To get the endpoints in the namespace, use: \"kubectl get endpoints -n %s\"", check.pod.Namespace)
+}
+
+// FixIt implements common.Runnable
+func (check TrafficSplitBackendsCheck) FixIt() error {
+	panic("implement me")
+}
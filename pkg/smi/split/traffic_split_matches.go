@@ -0,0 +1,90 @@
+package split
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	smiSpecClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/specs/clientset/versioned"
+	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm-health/pkg/smi"
+	"github.com/openservicemesh/osm-health/pkg/smi/specs"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*TrafficSplitMatchesCheck)(nil)
+
+// TrafficSplitMatchesCheck implements common.Runnable
+type TrafficSplitMatchesCheck struct {
+	osmVersion  version.ControllerVersion
+	client      kubernetes.Interface
+	pod         *corev1.Pod
+	splitClient smiSplitClient.Interface
+	specClient  smiSpecClient.Interface
+}
+
+// NewTrafficSplitMatchesCheck creates a TrafficSplitMatchesCheck which checks whether the matches of every TrafficSplit
+// the pod participates in reference existing HTTPRouteGroups
+func NewTrafficSplitMatchesCheck(osmVersion version.ControllerVersion, client kubernetes.Interface, pod *corev1.Pod, splitClient smiSplitClient.Interface, specClient smiSpecClient.Interface) TrafficSplitMatchesCheck {
+	return TrafficSplitMatchesCheck{
+		osmVersion:  osmVersion,
+		client:      client,
+		pod:         pod,
+		splitClient: splitClient,
+		specClient:  specClient,
+	}
+}
+
+// Description implements common.Runnable
+func (check TrafficSplitMatchesCheck) Description() string {
+	return fmt.Sprintf("Checking whether the matches of traffic splits for pod %s/%s reference existing HTTPRouteGroups", check.pod.Namespace, check.pod.Name)
+}
+
+// Run implements common.Runnable
+func (check TrafficSplitMatchesCheck) Run(ctx context.Context) outcomes.Outcome {
+	trafficSplits, err := GetTrafficSplitsForPod(ctx, check.osmVersion, check.client, check.splitClient, check.pod)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	if len(trafficSplits) == 0 {
+		return outcomes.Info{Diagnostics: fmt.Sprintf("pod '%s/%s' does not participate in any traffic split", check.pod.Namespace, check.pod.Name)}
+	}
+
+	foundMatches := false
+	for _, trafficSplit := range trafficSplits {
+		for _, match := range trafficSplit.Matches {
+			foundMatches = true
+			if match.Kind != smi.HTTPRouteGroupKind {
+				return outcomes.Fail{Error: errors.Wrapf(ErrUnsupportedMatchKind, "traffic split %s references %s '%s'", trafficSplit, match.Kind, match.Name)}
+			}
+			_, err := specs.GetHTTPRouteGroup(ctx, check.osmVersion, check.specClient, trafficSplit.Namespace, match.Name)
+			if k8sErrors.IsNotFound(err) {
+				return outcomes.Fail{Error: errors.Wrapf(ErrMatchNotFound, "traffic split %s references HTTPRouteGroup '%s/%s'", trafficSplit, trafficSplit.Namespace, match.Name)}
+			}
+			if err != nil {
+				return outcomes.Fail{Error: err}
+			}
+		}
+	}
+	if !foundMatches {
+		return outcomes.Info{Diagnostics: fmt.Sprintf("traffic splits for pod '%s/%s' apply to all traffic and do not reference HTTPRouteGroups", check.pod.Namespace, check.pod.Name)}
+	}
+	return outcomes.Pass{}
+}
+
+// Suggestion implements common.Runnable
+func (check TrafficSplitMatchesCheck) Suggestion() string {
+	return fmt.Sprintf("Check that the HTTPRouteGroups referenced by the TrafficSplit matches exist. Use: \"kubectl get trafficsplit -n %s -o yaml\" and \"kubectl get httproutegroups -n %s\"", check.pod.Namespace, check.pod.Namespace)
+}
+
+// FixIt implements common.Runnable
+func (check TrafficSplitMatchesCheck) FixIt() error {
+	panic("implement me")
+}
@@ -0,0 +1,124 @@
+package split
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*TrafficSplitRootServiceCheck)(nil)
+
+// TrafficSplitRootServiceCheck implements common.Runnable
+type TrafficSplitRootServiceCheck struct {
+	osmVersion  version.ControllerVersion
+	client      kubernetes.Interface
+	pod         *corev1.Pod
+	splitClient smiSplitClient.Interface
+}
+
+// NewTrafficSplitRootServiceCheck creates a TrafficSplitRootServiceCheck which checks whether the root service of every
+// TrafficSplit the pod participates in exists and does not have endpoints that bypass the backends of the TrafficSplit
+func NewTrafficSplitRootServiceCheck(osmVersion version.ControllerVersion, client kubernetes.Interface, pod *corev1.Pod, splitClient smiSplitClient.Interface) TrafficSplitRootServiceCheck {
+	return TrafficSplitRootServiceCheck{
+		osmVersion:  osmVersion,
+		client:      client,
+		pod:         pod,
+		splitClient: splitClient,
+	}
+}
+
+// Description implements common.Runnable
+func (check TrafficSplitRootServiceCheck) Description() string {
+	return fmt.Sprintf("Checking whether the root services of traffic splits for pod %s/%s are valid", check.pod.Namespace, check.pod.Name)
+}
+
+// Run implements common.Runnable
+func (check TrafficSplitRootServiceCheck) Run(ctx context.Context) outcomes.Outcome {
+	trafficSplits, err := GetTrafficSplitsForPod(ctx, check.osmVersion, check.client, check.splitClient, check.pod)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	if len(trafficSplits) == 0 {
+		return outcomes.Info{Diagnostics: fmt.Sprintf("pod '%s/%s' does not participate in any traffic split", check.pod.Namespace, check.pod.Name)}
+	}
+
+	for _, trafficSplit := range trafficSplits {
+		ns := trafficSplit.Namespace
+		_, err := check.client.CoreV1().Services(ns).Get(ctx, trafficSplit.Service, metav1.GetOptions{})
+		if k8sErrors.IsNotFound(err) {
+			return outcomes.Fail{Error: errors.Wrapf(ErrRootServiceNotFound, "root service '%s/%s' of traffic split %s", ns, trafficSplit.Service, trafficSplit)}
+		}
+		if err != nil {
+			return outcomes.Fail{Error: err}
+		}
+
+		rootAddresses, err := getEndpointAddresses(ctx, check.client, ns, trafficSplit.Service, true)
+		if err != nil {
+			return outcomes.Fail{Error: err}
+		}
+		backendAddresses := make(map[string]struct{})
+		for _, backend := range trafficSplit.Backends {
+			addresses, err := getEndpointAddresses(ctx, check.client, ns, backend.Service, false)
+			if err != nil {
+				return outcomes.Fail{Error: err}
+			}
+			for address := range addresses {
+				backendAddresses[address] = struct{}{}
+			}
+		}
+		for address := range rootAddresses {
+			if _, ok := backendAddresses[address]; !ok {
+				return outcomes.Fail{Error: errors.Wrapf(ErrRootServiceConflictingEndpoints,
+					"root service '%s/%s' of traffic split %s selects endpoint %s", ns, trafficSplit.Service, trafficSplit, address)}
+			}
+		}
+	}
+
+	return outcomes.Pass{}
+}
+
+// getEndpointAddresses returns the endpoint IP addresses of the service. Not-ready addresses are only included when readyOnly is false.
+// A service without an Endpoints resource, such as a service without a selector, has no endpoint addresses.
+func getEndpointAddresses(ctx context.Context, client kubernetes.Interface, namespace string, serviceName string, readyOnly bool) (map[string]struct{}, error) {
+	addresses := make(map[string]struct{})
+	endpoints, err := client.CoreV1().Endpoints(namespace).Get(ctx, serviceName, metav1.GetOptions{})
+	if k8sErrors.IsNotFound(err) {
+		return addresses, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, subset := range endpoints.Subsets {
+		for _, address := range subset.Addresses {
+			addresses[address.IP] = struct{}{}
+		}
+		if readyOnly {
+			continue
+		}
+		for _, address := range subset.NotReadyAddresses {
+			addresses[address.IP] = struct{}{}
+		}
+	}
+	return addresses, nil
+}
+
+// Suggestion implements common.Runnable
+func (check TrafficSplitRootServiceCheck) Suggestion() string {
+	return fmt.Sprintf("Check that the root service of each TrafficSplit exists and only selects pods that back one of its backend services. To get TrafficSplits in the namespace, use: \"kubectl get trafficsplit -n %s -o yaml\"", check.pod.Namespace)
+}
+
+// FixIt implements common.Runnable
+func (check TrafficSplitRootServiceCheck) FixIt() error {
+	panic("implement me")
+}
@@ -1,6 +1,7 @@
 package split
 
 import (
+	"context"
 	"testing"
 
 	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
@@ -174,12 +175,12 @@ func TestIsInTrafficSplit(t *testing.T) {
 
 			trafficSplitChecker := NewTrafficSplitCheck(osmVersion, client, &testCase.pod, smiSplitClient)
 			if testCase.isErrorExpected {
-				assert.Error(trafficSplitChecker.Run().GetError())
+				assert.Error(trafficSplitChecker.Run(context.TODO()).GetError())
 			} else {
-				assert.NoError(trafficSplitChecker.Run().GetError())
+				assert.NoError(trafficSplitChecker.Run(context.TODO()).GetError())
 			}
 			if testCase.isDiagnosticInfoExpected {
-				assert.NotEmpty(trafficSplitChecker.Run().GetDiagnostics())
+				assert.NotEmpty(trafficSplitChecker.Run(context.TODO()).GetDiagnostics())
 			}
 		})
 	}
@@ -0,0 +1,211 @@
+package split
+
+import (
+	"context"
+	"testing"
+
+	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
+	fakeSmiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned/fake"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm-health/pkg/smi"
+)
+
+func newService(name string, selector map[string]string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "bookstore"},
+		Spec:       corev1.ServiceSpec{Selector: selector},
+	}
+}
+
+func newEndpoints(name string, readyIPs []string, notReadyIPs []string) *corev1.Endpoints {
+	subset := corev1.EndpointSubset{}
+	for _, ip := range readyIPs {
+		subset.Addresses = append(subset.Addresses, corev1.EndpointAddress{IP: ip})
+	}
+	for _, ip := range notReadyIPs {
+		subset.NotReadyAddresses = append(subset.NotReadyAddresses, corev1.EndpointAddress{IP: ip})
+	}
+	return &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "bookstore"},
+		Subsets:    []corev1.EndpointSubset{subset},
+	}
+}
+
+func newTrafficSplit(service string, weights map[string]int) *split.TrafficSplit {
+	trafficSplit := &split.TrafficSplit{
+		ObjectMeta: metav1.ObjectMeta{Name: "bookstore-split", Namespace: "bookstore"},
+		Spec:       split.TrafficSplitSpec{Service: service},
+	}
+	for _, backend := range []string{"bookstore-v1", "bookstore-v2"} {
+		if weight, ok := weights[backend]; ok {
+			trafficSplit.Spec.Backends = append(trafficSplit.Spec.Backends, split.TrafficSplitBackend{Service: backend, Weight: weight})
+		}
+	}
+	return trafficSplit
+}
+
+func TestTrafficSplitValidityChecks(t *testing.T) {
+	bookstoreV1Pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bookstore-v1-pod",
+			Namespace: "bookstore",
+			Labels:    map[string]string{"app": "bookstore", "version": "v1"},
+		},
+	}
+	healthyObjects := []runtime.Object{
+		newService("bookstore", map[string]string{"app": "bookstore"}),
+		newService("bookstore-v1", map[string]string{"app": "bookstore", "version": "v1"}),
+		newService("bookstore-v2", map[string]string{"app": "bookstore", "version": "v2"}),
+		newEndpoints("bookstore", []string{"10.0.0.1", "10.0.0.2"}, nil),
+		newEndpoints("bookstore-v1", []string{"10.0.0.1"}, nil),
+		newEndpoints("bookstore-v2", []string{"10.0.0.2"}, nil),
+	}
+
+	type newCheckFunc func(version.ControllerVersion, *fake.Clientset, *corev1.Pod, *fakeSmiSplitClient.Clientset) runner.Runnable
+	rootServiceCheck := func(v version.ControllerVersion, c *fake.Clientset, p *corev1.Pod, s *fakeSmiSplitClient.Clientset) runner.Runnable {
+		return NewTrafficSplitRootServiceCheck(v, c, p, s)
+	}
+	backendsCheck := func(v version.ControllerVersion, c *fake.Clientset, p *corev1.Pod, s *fakeSmiSplitClient.Clientset) runner.Runnable {
+		return NewTrafficSplitBackendsCheck(v, c, p, s)
+	}
+	weightsCheck := func(v version.ControllerVersion, c *fake.Clientset, p *corev1.Pod, s *fakeSmiSplitClient.Clientset) runner.Runnable {
+		return NewTrafficSplitWeightsCheck(v, c, p, s)
+	}
+
+	testCases := []struct {
+		name          string
+		newCheck      newCheckFunc
+		pod           *corev1.Pod
+		k8sObjects    []runtime.Object
+		trafficSplit  *split.TrafficSplit
+		expectedError error
+		expectedInfo  bool
+	}{
+		{
+			name:     "pod not in any traffic split",
+			newCheck: rootServiceCheck,
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bookbuyer-pod",
+					Namespace: "bookstore",
+					Labels:    map[string]string{"app": "bookbuyer"},
+				},
+			},
+			k8sObjects:   healthyObjects,
+			trafficSplit: newTrafficSplit("bookstore", map[string]int{"bookstore-v2": 100}),
+			expectedInfo: true,
+		},
+		{
+			name:         "valid root service",
+			newCheck:     rootServiceCheck,
+			k8sObjects:   healthyObjects,
+			trafficSplit: newTrafficSplit("bookstore", map[string]int{"bookstore-v1": 50, "bookstore-v2": 50}),
+		},
+		{
+			name:     "missing root service",
+			newCheck: rootServiceCheck,
+			k8sObjects: []runtime.Object{
+				newService("bookstore-v1", map[string]string{"app": "bookstore", "version": "v1"}),
+			},
+			trafficSplit:  newTrafficSplit("bookstore", map[string]int{"bookstore-v1": 100}),
+			expectedError: ErrRootServiceNotFound,
+		},
+		{
+			name:     "root service selects pods outside of the backends",
+			newCheck: rootServiceCheck,
+			k8sObjects: []runtime.Object{
+				newService("bookstore", map[string]string{"app": "bookstore"}),
+				newService("bookstore-v1", map[string]string{"app": "bookstore", "version": "v1"}),
+				newEndpoints("bookstore", []string{"10.0.0.1", "10.0.0.3"}, nil),
+				newEndpoints("bookstore-v1", []string{"10.0.0.1"}, nil),
+			},
+			trafficSplit:  newTrafficSplit("bookstore", map[string]int{"bookstore-v1": 100}),
+			expectedError: ErrRootServiceConflictingEndpoints,
+		},
+		{
+			name:         "backends exist with ready endpoints",
+			newCheck:     backendsCheck,
+			k8sObjects:   healthyObjects,
+			trafficSplit: newTrafficSplit("bookstore", map[string]int{"bookstore-v1": 50, "bookstore-v2": 50}),
+		},
+		{
+			name:     "missing backend service",
+			newCheck: backendsCheck,
+			k8sObjects: []runtime.Object{
+				newService("bookstore", map[string]string{"app": "bookstore"}),
+				newService("bookstore-v1", map[string]string{"app": "bookstore", "version": "v1"}),
+				newEndpoints("bookstore-v1", []string{"10.0.0.1"}, nil),
+			},
+			trafficSplit:  newTrafficSplit("bookstore", map[string]int{"bookstore-v1": 50, "bookstore-v2": 50}),
+			expectedError: ErrBackendServiceNotFound,
+		},
+		{
+			name:     "backend without ready endpoints",
+			newCheck: backendsCheck,
+			k8sObjects: []runtime.Object{
+				newService("bookstore", map[string]string{"app": "bookstore"}),
+				newService("bookstore-v1", map[string]string{"app": "bookstore", "version": "v1"}),
+				newService("bookstore-v2", map[string]string{"app": "bookstore", "version": "v2"}),
+				newEndpoints("bookstore-v1", []string{"10.0.0.1"}, nil),
+				newEndpoints("bookstore-v2", nil, []string{"10.0.0.2"}),
+			},
+			trafficSplit:  newTrafficSplit("bookstore", map[string]int{"bookstore-v1": 50, "bookstore-v2": 50}),
+			expectedError: ErrBackendNoReadyEndpoints,
+		},
+		{
+			name:         "valid weights",
+			newCheck:     weightsCheck,
+			k8sObjects:   healthyObjects,
+			trafficSplit: newTrafficSplit("bookstore", map[string]int{"bookstore-v1": 0, "bookstore-v2": 100}),
+		},
+		{
+			name:          "negative weight",
+			newCheck:      weightsCheck,
+			k8sObjects:    healthyObjects,
+			trafficSplit:  newTrafficSplit("bookstore", map[string]int{"bookstore-v1": -10, "bookstore-v2": 100}),
+			expectedError: ErrNegativeBackendWeight,
+		},
+		{
+			name:          "all weights zero",
+			newCheck:      weightsCheck,
+			k8sObjects:    healthyObjects,
+			trafficSplit:  newTrafficSplit("bookstore", map[string]int{"bookstore-v1": 0, "bookstore-v2": 0}),
+			expectedError: ErrAllBackendWeightsZero,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			client := fake.NewSimpleClientset(testCase.k8sObjects...)
+			splitClient := fakeSmiSplitClient.NewSimpleClientset(testCase.trafficSplit)
+
+			pod := testCase.pod
+			if pod == nil {
+				pod = bookstoreV1Pod
+			}
+			outcome := testCase.newCheck("v0.9", client, pod, splitClient).Run(context.TODO())
+			if testCase.expectedError != nil {
+				assert.ErrorIs(outcome.GetError(), testCase.expectedError)
+			} else {
+				assert.NoError(outcome.GetError())
+			}
+			if testCase.expectedInfo {
+				assert.NotEmpty(outcome.GetDiagnostics())
+			}
+		})
+	}
+}
+
+func TestValidateWeights(t *testing.T) {
+	assert := tassert.New(t)
+	assert.NoError(validateWeights(smi.TrafficSplit{Backends: []smi.TrafficSplitBackend{{Service: "a", Weight: 1}}}))
+	assert.ErrorIs(validateWeights(smi.TrafficSplit{}), ErrAllBackendWeightsZero)
+}
@@ -0,0 +1,85 @@
+package split
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm-health/pkg/common/outcomes"
+	"github.com/openservicemesh/osm-health/pkg/osm/version"
+	"github.com/openservicemesh/osm-health/pkg/runner"
+	"github.com/openservicemesh/osm-health/pkg/smi"
+)
+
+// Verify interface compliance
+var _ runner.Runnable = (*TrafficSplitWeightsCheck)(nil)
+
+// TrafficSplitWeightsCheck implements common.Runnable
+type TrafficSplitWeightsCheck struct {
+	osmVersion  version.ControllerVersion
+	client      kubernetes.Interface
+	pod         *corev1.Pod
+	splitClient smiSplitClient.Interface
+}
+
+// NewTrafficSplitWeightsCheck creates a TrafficSplitWeightsCheck which checks whether the backend weights of every
+// TrafficSplit the pod participates in are non-negative and not all zero
+func NewTrafficSplitWeightsCheck(osmVersion version.ControllerVersion, client kubernetes.Interface, pod *corev1.Pod, splitClient smiSplitClient.Interface) TrafficSplitWeightsCheck {
+	return TrafficSplitWeightsCheck{
+		osmVersion:  osmVersion,
+		client:      client,
+		pod:         pod,
+		splitClient: splitClient,
+	}
+}
+
+// Description implements common.Runnable
+func (check TrafficSplitWeightsCheck) Description() string {
+	return fmt.Sprintf("Checking whether the backend weights of traffic splits for pod %s/%s are valid", check.pod.Namespace, check.pod.Name)
+}
+
+// Run implements common.Runnable
+func (check TrafficSplitWeightsCheck) Run(ctx context.Context) outcomes.Outcome {
+	trafficSplits, err := GetTrafficSplitsForPod(ctx, check.osmVersion, check.client, check.splitClient, check.pod)
+	if err != nil {
+		return outcomes.Fail{Error: err}
+	}
+	if len(trafficSplits) == 0 {
+		return outcomes.Info{Diagnostics: fmt.Sprintf("pod '%s/%s' does not participate in any traffic split", check.pod.Namespace, check.pod.Name)}
+	}
+
+	for _, trafficSplit := range trafficSplits {
+		if err := validateWeights(trafficSplit); err != nil {
+			return outcomes.Fail{Error: err}
+		}
+	}
+	return outcomes.Pass{}
+}
+
+func validateWeights(trafficSplit smi.TrafficSplit) error {
+	totalWeight := 0
+	for _, backend := range trafficSplit.Backends {
+		if backend.Weight < 0 {
+			return errors.Wrapf(ErrNegativeBackendWeight, "backend '%s' of traffic split %s has weight %d", backend.Service, trafficSplit, backend.Weight)
+		}
+		totalWeight += backend.Weight
+	}
+	if totalWeight == 0 {
+		return errors.Wrapf(ErrAllBackendWeightsZero, "traffic split %s", trafficSplit)
+	}
+	return nil
+}
+
+// Suggestion implements common.Runnable
+func (check TrafficSplitWeightsCheck) Suggestion() string {
+	return fmt.Sprintf("Check that every TrafficSplit backend has a non-negative weight and at least one backend has a positive weight. To get TrafficSplits in the namespace, use: \"kubectl get trafficsplit -n %s -o yaml\"", check.pod.Namespace)
+}
+
+// FixIt implements common.Runnable
+func (check TrafficSplitWeightsCheck) FixIt() error {
+	panic("implement me")
+}
@@ -0,0 +1,42 @@
+package v1alpha3
+
+import (
+	"context"
+
+	smiSplitClient "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm-health/pkg/smi"
+)
+
+// GetTrafficSplits returns the TrafficSplits in the given namespace
+func GetTrafficSplits(ctx context.Context, splitClient smiSplitClient.Interface, namespace string) ([]smi.TrafficSplit, error) {
+	trafficSplits, err := splitClient.SplitV1alpha3().TrafficSplits(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Err(err).Msgf("Error getting TrafficSplits for namespace %s", namespace)
+		return nil, err
+	}
+
+	var splits []smi.TrafficSplit
+	for _, trafficSplit := range trafficSplits.Items {
+		split := smi.TrafficSplit{
+			Name:      trafficSplit.Name,
+			Namespace: trafficSplit.Namespace,
+			Service:   trafficSplit.Spec.Service,
+		}
+		for _, backend := range trafficSplit.Spec.Backends {
+			split.Backends = append(split.Backends, smi.TrafficSplitBackend{
+				Service: backend.Service,
+				Weight:  backend.Weight,
+			})
+		}
+		for _, match := range trafficSplit.Spec.Matches {
+			split.Matches = append(split.Matches, smi.TrafficSplitMatch{
+				Kind: match.Kind,
+				Name: match.Name,
+			})
+		}
+		splits = append(splits, split)
+	}
+	return splits, nil
+}
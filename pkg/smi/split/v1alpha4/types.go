@@ -0,0 +1,5 @@
+package v1alpha4
+
+import "github.com/openservicemesh/osm-health/pkg/logger"
+
+var log = logger.New("v1alpha4")
@@ -0,0 +1,117 @@
+package smi
+
+// HTTPRouteMatch is a version-agnostic representation of a single match in an SMI HTTPRouteGroup.
+type HTTPRouteMatch struct {
+	// RouteGroup is the name of the HTTPRouteGroup the match belongs to
+	RouteGroup string
+
+	// Name is the name of the match within the HTTPRouteGroup
+	Name string
+
+	// PathRegex is the regex the request path is matched against
+	PathRegex string
+
+	// Methods is the list of HTTP methods the match allows
+	Methods []string
+
+	// Headers is the map of HTTP header names to the regex their value is matched against
+	Headers map[string]string
+}
+
+func (m HTTPRouteMatch) String() string {
+	return m.RouteGroup + "/" + m.Name
+}
+
+// TrafficSplit is a version-agnostic representation of an SMI TrafficSplit.
+type TrafficSplit struct {
+	// Name is the name of the TrafficSplit
+	Name string
+
+	// Namespace is the namespace of the TrafficSplit, its root service and its backends
+	Namespace string
+
+	// Service is the name of the root service of the TrafficSplit
+	Service string
+
+	// Backends is the list of backend services traffic to the root service is split across
+	Backends []TrafficSplitBackend
+
+	// Matches is the list of routes the TrafficSplit applies to; a TrafficSplit without matches applies to all traffic
+	Matches []TrafficSplitMatch
+}
+
+// TrafficSplitBackend is a version-agnostic representation of a backend of an SMI TrafficSplit.
+type TrafficSplitBackend struct {
+	// Service is the name of the backend service
+	Service string
+
+	// Weight is the share of traffic sent to the backend service, relative to the other backends
+	Weight int
+}
+
+// TrafficSplitMatch is a version-agnostic representation of a route referenced by the matches of an SMI TrafficSplit.
+type TrafficSplitMatch struct {
+	// Kind is the kind of the referenced route, such as HTTPRouteGroup
+	Kind string
+
+	// Name is the name of the referenced route
+	Name string
+}
+
+func (s TrafficSplit) String() string {
+	return s.Namespace + "/" + s.Name
+}
+
+// TrafficTarget is a version-agnostic representation of an SMI TrafficTarget.
+type TrafficTarget struct {
+	// Name is the name of the TrafficTarget
+	Name string
+
+	// Namespace is the namespace of the TrafficTarget
+	Namespace string
+
+	// Destination is the identity traffic is allowed to
+	Destination IdentityBindingSubject
+
+	// Sources is the list of identities traffic is allowed from
+	Sources []IdentityBindingSubject
+
+	// Rules is the list of routes traffic is allowed on
+	Rules []TrafficTargetRule
+}
+
+// IdentityBindingSubject is a version-agnostic representation of an identity referenced by an SMI TrafficTarget.
+type IdentityBindingSubject struct {
+	// Kind is the kind of the identity, such as ServiceAccount
+	Kind string
+
+	// Name is the name of the identity
+	Name string
+
+	// Namespace is the namespace of the identity
+	Namespace string
+}
+
+// TrafficTargetRule is a version-agnostic representation of a rule of an SMI TrafficTarget.
+type TrafficTargetRule struct {
+	// Kind is the kind of the referenced route, such as HTTPRouteGroup or TCPRoute
+	Kind string
+
+	// Name is the name of the referenced route
+	Name string
+
+	// Matches is the list of names of the matches of the referenced route the rule allows
+	Matches []string
+}
+
+// HTTPRouteGroup is a version-agnostic representation of an SMI HTTPRouteGroup.
+type HTTPRouteGroup struct {
+	// Name is the name of the HTTPRouteGroup
+	Name string
+
+	// Namespace is the namespace of the HTTPRouteGroup
+	Namespace string
+
+	// Matches is the list of matches of the HTTPRouteGroup
+	Matches []HTTPRouteMatch
+}
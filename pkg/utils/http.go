@@ -1,19 +1,40 @@
 package utils
 
 import (
+	"context"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
-// CheckHTTPResponseCodeEquals checks whether the returned response from the url matches the status code.
-func CheckHTTPResponseCodeEquals(url string, statusCode int) error {
+// httpTimeout bounds HTTP requests whose context has no deadline, so that an unresponsive server cannot hang a run.
+const httpTimeout = 10 * time.Second
+
+var httpClient = &http.Client{Timeout: httpTimeout}
+
+// get sends a GET request to the url with the given context.
+func get(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Errorf("error creating request to (GET) url %s: %s", url, err)
+	}
 	// #nosec G107: Potential HTTP request made with variable url
-	resp, err := http.Get(url)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Errorf("error fetching (GET) url %s: %s", url, err)
+	}
+	return resp, nil
+}
+
+// CheckHTTPResponseCodeEquals checks whether the returned response from the url matches the status code.
+func CheckHTTPResponseCodeEquals(ctx context.Context, url string, statusCode int) error {
+	resp, err := get(ctx, url)
 	if err != nil {
-		return errors.Errorf("error fetching (GET) url %s: %s", url, err)
+		return err
 	}
+	defer resp.Body.Close() //nolint: errcheck,gosec
 
 	if resp.StatusCode != statusCode {
 		return errors.Errorf("checking for HTTP status code: %d, but url returned HTTP status code: %d", statusCode, resp.StatusCode)
@@ -23,21 +44,31 @@ func CheckHTTPResponseCodeEquals(url string, statusCode int) error {
 }
 
 // GetResponseBody returns the response from the url
-func GetResponseBody(url string) (string, error) {
-	// #nosec G107: Potential HTTP request made with variable url
-	resp, err := http.Get(url)
+func GetResponseBody(ctx context.Context, url string) (string, error) {
+	statusCode, respBody, err := GetResponse(ctx, url)
 	if err != nil {
-		return "", errors.Errorf("error fetching (GET) url %s: %s", url, err)
+		return "", err
+	}
+
+	if statusCode != http.StatusOK {
+		return "", errors.Errorf("url returned HTTP status code: %d", statusCode)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return "", errors.Errorf("url returned HTTP status code: %d", resp.StatusCode)
+	return respBody, nil
+}
+
+// GetResponse returns the status code and body of the response from the url, whatever the status code.
+func GetResponse(ctx context.Context, url string) (int, string, error) {
+	resp, err := get(ctx, url)
+	if err != nil {
+		return 0, "", err
 	}
+	defer resp.Body.Close() //nolint: errcheck,gosec
 
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return "", errors.Errorf("error rendering HTTP response: %s", err)
+		return 0, "", errors.Errorf("error rendering HTTP response: %s", err)
 	}
 
-	return string(respBody), nil
+	return resp.StatusCode, string(respBody), nil
 }
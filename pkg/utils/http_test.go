@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -38,7 +39,7 @@ func TestCheckHTTPResponseCodeEquals(t *testing.T) {
 			}))
 			defer ts.Close()
 
-			err := CheckHTTPResponseCodeEquals(ts.URL, test.argStatusCode)
+			err := CheckHTTPResponseCodeEquals(context.TODO(), ts.URL, test.argStatusCode)
 			assert.Equal(test.expectedError, err != nil)
 		})
 	}
@@ -76,7 +77,7 @@ func TestGetResponseBody(t *testing.T) {
 			}))
 			defer ts.Close()
 
-			respBody, err := GetResponseBody(ts.URL)
+			respBody, err := GetResponseBody(context.TODO(), ts.URL)
 			if test.expectedError != nil {
 				assert.Equal(test.expectedError.Error(), err.Error())
 			} else {